@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -11,7 +15,9 @@ import (
 	_ "github.com/nomad-pixel/imperial/docs"
 	"github.com/nomad-pixel/imperial/internal/di"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/auth"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/booking"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/car"
+	carAvailability "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
 	carCategory "github.com/nomad-pixel/imperial/internal/interfaces/http/car/category"
 	carImage "github.com/nomad-pixel/imperial/internal/interfaces/http/car/image"
 	carMark "github.com/nomad-pixel/imperial/internal/interfaces/http/car/mark"
@@ -20,6 +26,9 @@ import (
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/driver"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/lead"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/pricing"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/public"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/review"
 )
 
 // @title           Imperial API
@@ -42,8 +51,8 @@ import (
 // @description JWT token must be passed with `Bearer ` prefix. Example: "Bearer eyJhbGciOiJIUzI1NiI..."
 
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	// Initialize application with all dependencies
 	app, err := di.InitializeApp(ctx)
@@ -61,31 +70,71 @@ func main() {
 
 	server := gin.New()
 
+	// ClientIP keys the anti-spam limits, so X-Forwarded-For is only read
+	// from the configured proxies. With none configured the peer address is
+	// used as is.
+	if err := server.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("invalid SERVER_TRUSTED_PROXIES: %v", err)
+	}
+
 	server.Use(gin.Logger())
 	server.Use(middleware.Recovery())
 	server.Use(middleware.ErrorHandler())
 
 	server.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// Serve static files from storage path
-	server.Static("/uploads", cfg.Storage.LocalPath)
+	// Serve static files from storage path; with S3 storage images are
+	// served by the bucket itself
+	if cfg.Storage.Type == "local" {
+		server.Static("/uploads", cfg.Storage.LocalPath)
+	}
 
 	apiGroup := server.Group("/api")
 
-	auth.RegisterRoutes(apiGroup, app.AuthHandler)
+	auth.RegisterRoutes(apiGroup, app.AuthHandler, app.TokenService)
 	car.RegisterRoutes(apiGroup, app.CarHandler, app.TokenService)
 	carTag.RegisterRoutes(apiGroup, app.CarTagHandler, app.TokenService)
 	carMark.RegisterRoutes(apiGroup, app.CarMarkHandler, app.TokenService)
 	carCategory.RegisterRoutes(apiGroup, app.CarCategoryHandler, app.TokenService)
 	carImage.RegisterRoutes(apiGroup, app.CarImageHandler, app.TokenService)
+	carAvailability.RegisterRoutes(apiGroup, app.CarAvailabilityHandler, app.TokenService)
 	celebrity.RegisterRoutes(apiGroup, app.CelebrityHandler, app.TokenService)
 	lead.RegisterRoutes(apiGroup, app.LeadHandler, app.TokenService)
 	driver.RegisterRoutes(apiGroup, app.DriverHandler, app.TokenService)
+	public.RegisterRoutes(apiGroup, app.PublicHandler)
+	booking.RegisterRoutes(apiGroup, app.BookingHandler, app.TokenService)
+	pricing.RegisterRoutes(apiGroup, app.PricingHandler, app.TokenService)
+	review.RegisterRoutes(apiGroup, app.ReviewHandler, app.TokenService)
+
+	// Background workers (outbox dispatcher) run until shutdown
+	app.Start(ctx)
+
+	httpServer := &http.Server{
+		Addr:         cfg.GetServerAddress(),
+		Handler:      server,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- httpServer.ListenAndServe()
+	}()
 
 	log.Printf("✅ Server listening on http://localhost:%d", cfg.Server.Port)
 	log.Printf("📚 Swagger documentation: http://localhost:%d/swagger/index.html", cfg.Server.Port)
 
-	if err := server.Run(cfg.GetServerAddress()); err != nil {
-		log.Fatalf("failed to run server: %v", err)
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("❌ Server stopped: %v", err)
+		}
+	case <-ctx.Done():
+		log.Println("🛑 Shutting down...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("❌ Server shutdown: %v", err)
+		}
 	}
 }
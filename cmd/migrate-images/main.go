@@ -0,0 +1,133 @@
+// Command migrate-images copies files from local storage (STORAGE_LOCAL_PATH)
+// into the S3 bucket configured by the STORAGE_S3_* variables. Object keys
+// match the relative paths stored in the database, so switching
+// STORAGE_TYPE to s3 afterwards needs no data migration.
+//
+// Usage:
+//
+//	go run ./cmd/migrate-images [-dry-run] [-overwrite] [-workers 4]
+package main
+
+import (
+	"context"
+	"flag"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nomad-pixel/imperial/internal/config"
+	imageSvc "github.com/nomad-pixel/imperial/internal/infrastructure/image"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "list files that would be uploaded without uploading them")
+	overwrite := flag.Bool("overwrite", false, "upload files even if the object already exists in the bucket")
+	workers := flag.Int("workers", 4, "number of parallel uploads")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if cfg.Storage.S3.Bucket == "" || cfg.Storage.S3.AccessKey == "" || cfg.Storage.S3.SecretKey == "" {
+		log.Fatalf("STORAGE_S3_BUCKET, STORAGE_S3_ACCESS_KEY and STORAGE_S3_SECRET_KEY are required")
+	}
+	if *workers < 1 {
+		*workers = 1
+	}
+
+	client, err := imageSvc.NewS3Client(imageSvc.S3Options{
+		Endpoint:     cfg.Storage.S3.Endpoint,
+		Region:       cfg.Storage.S3.Region,
+		Bucket:       cfg.Storage.S3.Bucket,
+		AccessKey:    cfg.Storage.S3.AccessKey,
+		SecretKey:    cfg.Storage.S3.SecretKey,
+		UsePathStyle: cfg.Storage.S3.UsePathStyle,
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize s3 client: %v", err)
+	}
+
+	log.Printf("Copying %s to bucket %s at %s", cfg.Storage.LocalPath, cfg.Storage.S3.Bucket, cfg.Storage.S3.Endpoint)
+
+	var uploaded, skipped, failed atomic.Int64
+	keys := make(chan string)
+
+	var wg sync.WaitGroup
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				if !*overwrite {
+					exists, err := client.ObjectExists(ctx, key)
+					if err != nil {
+						log.Printf("❌ %s: %v", key, err)
+						failed.Add(1)
+						continue
+					}
+					if exists {
+						skipped.Add(1)
+						continue
+					}
+				}
+				if *dryRun {
+					log.Printf("would upload %s", key)
+					uploaded.Add(1)
+					continue
+				}
+
+				data, err := os.ReadFile(filepath.Join(cfg.Storage.LocalPath, filepath.FromSlash(key)))
+				if err == nil {
+					err = client.PutObject(ctx, key, data, imageSvc.ContentTypeByPath(key))
+				}
+				if err != nil {
+					log.Printf("❌ %s: %v", key, err)
+					failed.Add(1)
+					continue
+				}
+				uploaded.Add(1)
+			}
+		}()
+	}
+
+	walkErr := filepath.WalkDir(cfg.Storage.LocalPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() || d.Name()[0] == '.' {
+			return nil
+		}
+		rel, err := filepath.Rel(cfg.Storage.LocalPath, p)
+		if err != nil {
+			return err
+		}
+		select {
+		case keys <- filepath.ToSlash(rel):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	close(keys)
+	wg.Wait()
+
+	summary := "✅ Uploaded"
+	if *dryRun {
+		summary = "✅ Dry run, would upload"
+	}
+	log.Printf("%s: %d, already in bucket: %d, failed: %d", summary, uploaded.Load(), skipped.Load(), failed.Load())
+	if walkErr != nil {
+		log.Fatalf("failed to read %s: %v", cfg.Storage.LocalPath, walkErr)
+	}
+	if failed.Load() > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,105 @@
+// Command normalize-phones rewrites the phone of leads stored before phone
+// normalization to E.164, reading numbers without a country code as numbers
+// of PHONE_DEFAULT_REGION. The original value stays in phone_raw. Numbers
+// that cannot be parsed are reported and left unchanged.
+//
+// Usage:
+//
+//	go run ./cmd/normalize-phones [-dry-run]
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/config"
+	"github.com/nomad-pixel/imperial/pkg/utils"
+)
+
+type leadPhone struct {
+	id    int64
+	phone string
+	raw   string
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print the changes without saving them")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if !utils.IsSupportedPhoneRegion(cfg.Phone.DefaultRegion) {
+		log.Fatalf("unsupported PHONE_DEFAULT_REGION: %s", cfg.Phone.DefaultRegion)
+	}
+
+	db, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query(ctx, `SELECT id, COALESCE(phone, ''), phone_raw FROM leads WHERE COALESCE(phone, '') NOT LIKE '+%' ORDER BY id`)
+	if err != nil {
+		log.Fatalf("failed to read leads: %v", err)
+	}
+	var leads []leadPhone
+	for rows.Next() {
+		var l leadPhone
+		if err := rows.Scan(&l.id, &l.phone, &l.raw); err != nil {
+			log.Fatalf("failed to read leads: %v", err)
+		}
+		leads = append(leads, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		log.Fatalf("failed to read leads: %v", err)
+	}
+
+	log.Printf("Normalizing %d lead phones (default region %s)", len(leads), cfg.Phone.DefaultRegion)
+
+	var updated, failed int
+	for _, l := range leads {
+		raw := l.raw
+		if strings.TrimSpace(raw) == "" {
+			raw = l.phone
+		}
+
+		normalized, err := utils.NormalizePhone(raw, cfg.Phone.DefaultRegion)
+		if err != nil {
+			log.Printf("❌ lead #%d %q: %v", l.id, raw, err)
+			failed++
+			continue
+		}
+		if *dryRun {
+			log.Printf("lead #%d: %q -> %s", l.id, raw, normalized)
+			updated++
+			continue
+		}
+
+		if _, err := db.Exec(ctx, `UPDATE leads SET phone = $1, phone_raw = $2 WHERE id = $3`, normalized, raw, l.id); err != nil {
+			log.Printf("❌ lead #%d: %v", l.id, err)
+			failed++
+			continue
+		}
+		updated++
+	}
+
+	summary := "✅ Normalized"
+	if *dryRun {
+		summary = "✅ Dry run, would normalize"
+	}
+	log.Printf("%s: %d, failed: %d", summary, updated, failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,72 @@
+// Command set-role grants a role to a registered user. It bootstraps the
+// first admin; after that admins manage roles through PATCH /v1/users/:id/role.
+//
+// Usage:
+//
+//	go run ./cmd/set-role -email admin@example.com [-role admin]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/config"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+func main() {
+	email := flag.String("email", "", "email of the registered user")
+	role := flag.String("role", string(entities.UserRoleAdmin), "role to grant: admin, manager or customer")
+	flag.Parse()
+
+	userEmail := strings.ToLower(strings.TrimSpace(*email))
+	if userEmail == "" {
+		log.Fatal("-email is required")
+	}
+	userRole := entities.UserRole(*role)
+	if !userRole.IsValid() {
+		log.Fatalf("unknown role: %s", *role)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	db, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	var id int64
+	// The role is carried in tokens, so the user's sessions are revoked to
+	// make a demotion stick
+	err = db.QueryRow(ctx, `
+		WITH updated AS (
+			UPDATE users SET role = $1, updated_at = NOW() WHERE email = $2 RETURNING id
+		), revoked AS (
+			UPDATE user_sessions SET revoked_at = NOW(), updated_at = NOW()
+			WHERE user_id IN (SELECT id FROM updated) AND revoked_at IS NULL
+		)
+		SELECT id FROM updated
+	`, userRole, userEmail).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Fatalf("user %s not found, sign up first", userEmail)
+	}
+	if err != nil {
+		log.Fatalf("failed to update role: %v", err)
+	}
+
+	log.Printf("✅ User #%d %s is now %s. Existing sessions were revoked, the role applies from the next sign-in", id, userEmail, userRole)
+}
@@ -57,9 +57,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/refresh-token": {
+        "/v1/auth/forgot-password": {
             "post": {
-                "description": "Обновляет access токен с помощью refresh токена",
+                "description": "Отправляет код сброса пароля на указанный email",
                 "consumes": [
                     "application/json"
                 ],
@@ -69,31 +69,40 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Обновление access токена",
+                "summary": "Запрос сброса пароля",
                 "parameters": [
                     {
-                        "description": "Данные для обновления токена",
+                        "description": "Email для отправки кода сброса пароля",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.RefreshRequest"
+                            "$ref": "#/definitions/auth.ForgotPasswordRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Токен успешно обновлен",
+                        "description": "Код сброса пароля отправлен",
                         "schema": {
-                            "$ref": "#/definitions/auth.RefreshResponse"
+                            "$ref": "#/definitions/auth.ForgotPasswordResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много запросов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/auth/sign-in": {
+        "/v1/auth/logout": {
             "post": {
-                "description": "Аутентификация пользователя с помощью email и пароля",
+                "description": "Отзывает переданный refresh токен. Access токен остается действительным до истечения срока",
                 "consumes": [
                     "application/json"
                 ],
@@ -103,31 +112,56 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Вход пользователя",
+                "summary": "Выход из текущей сессии",
                 "parameters": [
                     {
-                        "description": "Данные для входа",
+                        "description": "Refresh токен текущей сессии",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.SignInRequest"
+                            "$ref": "#/definitions/auth.LogoutRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Пользователь успешно аутентифицирован",
+                        "description": "Сессия завершена",
                         "schema": {
-                            "$ref": "#/definitions/auth.SignInResponse"
+                            "$ref": "#/definitions/auth.LogoutResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/auth/sign-up": {
+        "/v1/auth/logout-all": {
             "post": {
-                "description": "Создает нового пользователя с указанным email и паролем",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отзывает все refresh токены текущего пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Выход со всех устройств",
+                "responses": {
+                    "200": {
+                        "description": "Все сессии завершены",
+                        "schema": {
+                            "$ref": "#/definitions/auth.LogoutResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/refresh-token": {
+            "post": {
+                "description": "Обновляет пару токенов с помощью refresh токена. Старый refresh токен становится недействительным, повторное его использование завершает все сессии этого входа",
                 "consumes": [
                     "application/json"
                 ],
@@ -137,31 +171,31 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Регистрация нового пользователя",
+                "summary": "Обновление access токена",
                 "parameters": [
                     {
-                        "description": "Данные для регистрации",
+                        "description": "Данные для обновления токена",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.SignUpRequest"
+                            "$ref": "#/definitions/auth.RefreshRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Пользователь успешно зарегистрирован",
+                    "200": {
+                        "description": "Токен успешно обновлен",
                         "schema": {
-                            "$ref": "#/definitions/auth.SignUpResponse"
+                            "$ref": "#/definitions/auth.RefreshResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/auth/verify-email": {
+        "/v1/auth/reset-password": {
             "post": {
-                "description": "Отправляет код верификации на указанный email",
+                "description": "Устанавливает новый пароль по коду из email и завершает все активные сессии",
                 "consumes": [
                     "application/json"
                 ],
@@ -171,36 +205,31 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Отправка кода верификации на email",
+                "summary": "Сброс пароля",
                 "parameters": [
                     {
-                        "description": "Email для отправки кода верификации",
+                        "description": "Код сброса и новый пароль",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.VerifyEmailRequest"
+                            "$ref": "#/definitions/auth.ResetPasswordRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Email успешно отправлен",
+                        "description": "Пароль успешно изменен",
                         "schema": {
-                            "$ref": "#/definitions/auth.VerifyEmailResponse"
+                            "$ref": "#/definitions/auth.ResetPasswordResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Возвращает список автомобилей с возможностью фильтрации и пагинации",
+        "/v1/auth/sign-in": {
+            "post": {
+                "description": "Аутентификация пользователя с помощью email и пароля",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,59 +237,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "Auth"
                 ],
-                "summary": "Получение списка автомобилей",
+                "summary": "Вход пользователя",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение для пагинации",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Лимит для пагинации",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по названию автомобиля",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Фильтр по ID марки автомобиля",
-                        "name": "mark_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Фильтр по ID категории автомобиля",
-                        "name": "category_id",
-                        "in": "query"
+                        "description": "Данные для входа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.SignInRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список автомобилей",
+                        "description": "Пользователь успешно аутентифицирован",
                         "schema": {
-                            "$ref": "#/definitions/car.ListCarsResponse"
+                            "$ref": "#/definitions/auth.SignInResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/auth/sign-up": {
             "post": {
-                "security": [
+                "description": "Создает нового пользователя с указанным email и паролем",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Регистрация нового пользователя",
+                "parameters": [
                     {
-                        "BearerAuth": []
+                        "description": "Данные для регистрации",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.SignUpRequest"
+                        }
                     }
                 ],
-                "description": "Создает новый автомобиль с указанными параметрами",
+                "responses": {
+                    "201": {
+                        "description": "Пользователь успешно зарегистрирован",
+                        "schema": {
+                            "$ref": "#/definitions/auth.SignUpResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/verify-email": {
+            "post": {
+                "description": "Отправляет код верификации на указанный email",
                 "consumes": [
                     "application/json"
                 ],
@@ -268,38 +305,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "Auth"
                 ],
-                "summary": "Создание нового автомобиля",
+                "summary": "Отправка кода верификации на email",
                 "parameters": [
                     {
-                        "description": "Данные для создания автомобиля",
+                        "description": "Email для отправки кода верификации",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.CreateCarRequest"
+                            "$ref": "#/definitions/auth.VerifyEmailRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Автомобиль успешно создан",
+                        "description": "Email успешно отправлен",
                         "schema": {
-                            "$ref": "#/definitions/car.CarResponse"
+                            "$ref": "#/definitions/auth.VerifyEmailResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-categories": {
+        "/v1/bookings": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает список всех категорий с поддержкой пагинации",
+                "description": "Get a paginated list of bookings, optionally filtered by status, car, driver or lead",
                 "consumes": [
                     "application/json"
                 ],
@@ -307,30 +344,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Categories"
+                    "Bookings"
                 ],
-                "summary": "Получение списка категорий",
+                "summary": "List bookings",
                 "parameters": [
                     {
                         "type": "integer",
                         "default": 0,
-                        "description": "Смещение для пагинации",
+                        "description": "Offset for pagination",
                         "name": "offset",
                         "in": "query"
                     },
                     {
                         "type": "integer",
                         "default": 20,
-                        "description": "Лимит для пагинации",
+                        "description": "Limit for pagination",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "confirmed",
+                            "active",
+                            "completed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Booking status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by car ID",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by driver ID",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by lead ID",
+                        "name": "lead_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список категорий",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/car.ListCarCategoriesResponse"
+                            "$ref": "#/definitions/booking.ListBookingsResponse"
                         }
                     }
                 }
@@ -341,7 +409,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Создает новую категорию с указанным названием",
+                "description": "Reserve a car for a period. Customer fields and dates default to the referenced lead. Returns 409 if the car is already booked for an overlapping period",
                 "consumes": [
                     "application/json"
                 ],
@@ -349,38 +417,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Categories"
+                    "Bookings"
                 ],
-                "summary": "Создание новой категории машины",
+                "summary": "Create a new booking",
                 "parameters": [
                     {
-                        "description": "Данные для создания категории",
-                        "name": "request",
+                        "description": "Booking data",
+                        "name": "booking",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.CreateCarCategoryRequest"
+                            "$ref": "#/definitions/booking.CreateBookingRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Категория успешно создана",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/car.CarCategoryResponse"
+                            "$ref": "#/definitions/entities.Booking"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-categories/{id}": {
+        "/v1/bookings/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает информацию о категории по указанному ID",
+                "description": "Get detailed information about a booking by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -388,13 +456,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Categories"
+                    "Bookings"
                 ],
-                "summary": "Получение категории по ID",
+                "summary": "Get booking by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID категории",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -402,20 +470,22 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о категории",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/car.CarCategoryResponse"
+                            "$ref": "#/definitions/entities.Booking"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/bookings/{id}/driver": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию о категории по указанному ID",
+                "description": "Set the chauffeur of a booking, or remove it with a null driver_id. Returns 409 if the driver is booked for an overlapping period, off duty or outside working hours",
                 "consumes": [
                     "application/json"
                 ],
@@ -423,43 +493,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Categories"
+                    "Bookings"
                 ],
-                "summary": "Обновление категории",
+                "summary": "Assign a driver to a booking",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID категории",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Новые данные для категории",
-                        "name": "request",
+                        "description": "Driver",
+                        "name": "driver",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.UpdateCarCategoryRequest"
+                            "$ref": "#/definitions/booking.AssignBookingDriverRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Категория успешно обновлена",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/car.CarCategoryResponse"
+                            "$ref": "#/definitions/entities.Booking"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/bookings/{id}/status": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет категорию по указанному ID",
+                "description": "Move a booking through its lifecycle: pending → confirmed → active → completed. Pending and confirmed bookings can be cancelled",
                 "consumes": [
                     "application/json"
                 ],
@@ -467,36 +539,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Categories"
+                    "Bookings"
                 ],
-                "summary": "Удаление категории",
+                "summary": "Change booking status",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID категории",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/booking.UpdateBookingStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Категория успешно удалена",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_car_category.MessageResponse"
+                            "$ref": "#/definitions/entities.Booking"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-marks": {
+        "/v1/cars": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает список всех марок с поддержкой пагинации",
+                "description": "Возвращает список автомобилей с фильтрацией, сортировкой, пагинацией и счетчиками фасетов по текущим фильтрам",
                 "consumes": [
                     "application/json"
                 ],
@@ -504,9 +585,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Marks"
+                    "Cars"
                 ],
-                "summary": "Получение списка марок",
+                "summary": "Получение списка автомобилей",
                 "parameters": [
                     {
                         "type": "integer",
@@ -521,13 +602,169 @@ const docTemplate = `{
                         "description": "Лимит для пагинации",
                         "name": "limit",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Список марок",
-                        "schema": {
-                            "$ref": "#/definitions/car.ListCarMarksResponse"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по названию автомобиля",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по ID марки автомобиля",
+                        "name": "mark_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по ID категории автомобиля",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Свободен с (YYYY-MM-DD или RFC 3339)",
+                        "name": "available_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Свободен до, не включительно (YYYY-MM-DD или RFC 3339)",
+                        "name": "available_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Год выпуска от",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Год выпуска до",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество мест от",
+                        "name": "seats_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество мест до",
+                        "name": "seats_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Мощность (л.с.) от",
+                        "name": "horsepower_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Мощность (л.с.) до",
+                        "name": "horsepower_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Объем двигателя (л) от",
+                        "name": "engine_volume_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Объем двигателя (л) до",
+                        "name": "engine_volume_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Разгон 0–100 км/ч (с) не более",
+                        "name": "acceleration_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Коробка передач через запятую (automatic, manual, robot, cvt)",
+                        "name": "transmission",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тип топлива через запятую (petrol, diesel, hybrid, electric)",
+                        "name": "fuel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Привод через запятую (fwd, rwd, awd)",
+                        "name": "drive_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Цвет",
+                        "name": "color",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Цена за день от",
+                        "name": "price_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Цена за день до",
+                        "name": "price_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только с водителем (true) или без водителя (false)",
+                        "name": "only_with_driver",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID тегов через запятую",
+                        "name": "tag_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Сочетание тегов: any (любой) или all (все)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "price_asc",
+                            "price_desc",
+                            "popular"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Сортировка",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список автомобилей",
+                        "schema": {
+                            "$ref": "#/definitions/car.ListCarsResponse"
                         }
                     }
                 }
@@ -538,7 +775,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Создает новую марку с указанным названием",
+                "description": "Создает новый автомобиль с указанными параметрами",
                 "consumes": [
                     "application/json"
                 ],
@@ -546,38 +783,119 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Marks"
+                    "Cars"
                 ],
-                "summary": "Создание новой марки машины",
+                "summary": "Создание нового автомобиля",
                 "parameters": [
                     {
-                        "description": "Данные для создания марки",
+                        "description": "Данные для создания автомобиля",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.CreateCarMarkRequest"
+                            "$ref": "#/definitions/car.CreateCarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Автомобиль успешно создан",
+                        "schema": {
+                            "$ref": "#/definitions/car.CarResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cars/car-categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает список всех категорий с поддержкой пагинации",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car Categories"
+                ],
+                "summary": "Получение списка категорий",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список категорий",
+                        "schema": {
+                            "$ref": "#/definitions/car.ListCarCategoriesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает новую категорию с указанным названием",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car Categories"
+                ],
+                "summary": "Создание новой категории машины",
+                "parameters": [
+                    {
+                        "description": "Данные для создания категории",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/car.CreateCarCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Марка успешно создана",
+                        "description": "Категория успешно создана",
                         "schema": {
-                            "$ref": "#/definitions/car.CarMarkResponse"
+                            "$ref": "#/definitions/car.CarCategoryResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-marks/{id}": {
+        "/v1/cars/car-categories/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает информацию о марке по указанному ID",
+                "description": "Возвращает информацию о категории по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -585,13 +903,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Marks"
+                    "Car Categories"
                 ],
-                "summary": "Получение марки по ID",
+                "summary": "Получение категории по ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID марки",
+                        "description": "ID категории",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -599,9 +917,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о марке",
+                        "description": "Информация о категории",
                         "schema": {
-                            "$ref": "#/definitions/car.CarMarkResponse"
+                            "$ref": "#/definitions/car.CarCategoryResponse"
                         }
                     }
                 }
@@ -612,7 +930,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию о марке по указанному ID",
+                "description": "Обновляет информацию о категории по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -620,32 +938,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Marks"
+                    "Car Categories"
                 ],
-                "summary": "Обновление марки",
+                "summary": "Обновление категории",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID марки",
+                        "description": "ID категории",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Новые данные для марки",
+                        "description": "Новые данные для категории",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.UpdateCarMarkRequest"
+                            "$ref": "#/definitions/car.UpdateCarCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Марка успешно обновлена",
+                        "description": "Категория успешно обновлена",
                         "schema": {
-                            "$ref": "#/definitions/car.CarMarkResponse"
+                            "$ref": "#/definitions/car.CarCategoryResponse"
                         }
                     }
                 }
@@ -656,7 +974,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет марку по указанному ID",
+                "description": "Удаляет категорию по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -664,13 +982,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Marks"
+                    "Car Categories"
                 ],
-                "summary": "Удаление марки",
+                "summary": "Удаление категории",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID марки",
+                        "description": "ID категории",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -678,17 +996,22 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Марка успешно удалена",
+                        "description": "Категория успешно удалена",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_car_mark.MessageResponse"
+                            "$ref": "#/definitions/internal_interfaces_http_car_category.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-tags": {
+        "/v1/cars/car-marks": {
             "get": {
-                "description": "Возвращает список всех тегов с поддержкой пагинации",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает список всех марок с поддержкой пагинации",
                 "consumes": [
                     "application/json"
                 ],
@@ -696,9 +1019,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Tags"
+                    "Car Marks"
                 ],
-                "summary": "Получение списка тегов",
+                "summary": "Получение списка марок",
                 "parameters": [
                     {
                         "type": "integer",
@@ -717,9 +1040,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список тегов",
+                        "description": "Список марок",
                         "schema": {
-                            "$ref": "#/definitions/car.ListCarTagsResponse"
+                            "$ref": "#/definitions/car.ListCarMarksResponse"
                         }
                     }
                 }
@@ -730,7 +1053,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Создает новый тег с указанным названием",
+                "description": "Создает новую марку с указанным названием",
                 "consumes": [
                     "application/json"
                 ],
@@ -738,33 +1061,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Tags"
+                    "Car Marks"
                 ],
-                "summary": "Создание нового тега для машины",
+                "summary": "Создание новой марки машины",
                 "parameters": [
                     {
-                        "description": "Данные для создания тега",
+                        "description": "Данные для создания марки",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.CreateCarTagRequest"
+                            "$ref": "#/definitions/car.CreateCarMarkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Тег успешно создан",
+                        "description": "Марка успешно создана",
                         "schema": {
-                            "$ref": "#/definitions/car.CarTagResponse"
+                            "$ref": "#/definitions/car.CarMarkResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/car-tags/{id}": {
+        "/v1/cars/car-marks/{id}": {
             "get": {
-                "description": "Возвращает информацию о теге по указанному ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает информацию о марке по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -772,13 +1100,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Tags"
+                    "Car Marks"
                 ],
-                "summary": "Получение тега по ID",
+                "summary": "Получение марки по ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID тега",
+                        "description": "ID марки",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -786,9 +1114,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о теге",
+                        "description": "Информация о марке",
                         "schema": {
-                            "$ref": "#/definitions/car.CarTagResponse"
+                            "$ref": "#/definitions/car.CarMarkResponse"
                         }
                     }
                 }
@@ -799,7 +1127,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию о теге по указанному ID",
+                "description": "Обновляет информацию о марке по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -807,32 +1135,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Tags"
+                    "Car Marks"
                 ],
-                "summary": "Обновление тега",
+                "summary": "Обновление марки",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID тега",
+                        "description": "ID марки",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Новые данные для тега",
+                        "description": "Новые данные для марки",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.UpdateCarTagRequest"
+                            "$ref": "#/definitions/car.UpdateCarMarkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Тег успешно обновлен",
+                        "description": "Марка успешно обновлена",
                         "schema": {
-                            "$ref": "#/definitions/car.CarTagResponse"
+                            "$ref": "#/definitions/car.CarMarkResponse"
                         }
                     }
                 }
@@ -843,7 +1171,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет тег по указанному ID",
+                "description": "Удаляет марку по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -851,13 +1179,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car Tags"
+                    "Car Marks"
                 ],
-                "summary": "Удаление тега",
+                "summary": "Удаление марки",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID тега",
+                        "description": "ID марки",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -865,22 +1193,59 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Тег успешно удален",
+                        "description": "Марка успешно удалена",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_car_tag.MessageResponse"
+                            "$ref": "#/definitions/internal_interfaces_http_car_mark.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/{id}": {
+        "/v1/cars/car-tags": {
             "get": {
+                "description": "Возвращает список всех тегов с поддержкой пагинации",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car Tags"
+                ],
+                "summary": "Получение списка тегов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список тегов",
+                        "schema": {
+                            "$ref": "#/definitions/car.ListCarTagsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает информацию об автомобиле по указанному ID",
+                "description": "Создает новый тег с указанным названием",
                 "consumes": [
                     "application/json"
                 ],
@@ -888,13 +1253,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "Car Tags"
                 ],
-                "summary": "Получение автомобиля по ID",
+                "summary": "Создание нового тега для машины",
+                "parameters": [
+                    {
+                        "description": "Данные для создания тега",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/car.CreateCarTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Тег успешно создан",
+                        "schema": {
+                            "$ref": "#/definitions/car.CarTagResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cars/car-tags/{id}": {
+            "get": {
+                "description": "Возвращает информацию о теге по указанному ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car Tags"
+                ],
+                "summary": "Получение тега по ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID автомобиля для получения",
+                        "description": "ID тега",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -902,9 +1301,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация об автомобиле",
+                        "description": "Информация о теге",
                         "schema": {
-                            "$ref": "#/definitions/car.CarResponse"
+                            "$ref": "#/definitions/car.CarTagResponse"
                         }
                     }
                 }
@@ -915,7 +1314,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Обновляет информацию об автомобиле по указанному ID",
+                "description": "Обновляет информацию о теге по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -923,32 +1322,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "Car Tags"
                 ],
-                "summary": "Обновление автомобиля",
+                "summary": "Обновление тега",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID автомобиля для обновления",
+                        "description": "ID тега",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Данные для обновления автомобиля",
+                        "description": "Новые данные для тега",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/car.UpdateCarRequest"
+                            "$ref": "#/definitions/car.UpdateCarTagRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Автомобиль успешно обновлен",
+                        "description": "Тег успешно обновлен",
                         "schema": {
-                            "$ref": "#/definitions/car.CarResponse"
+                            "$ref": "#/definitions/car.CarTagResponse"
                         }
                     }
                 }
@@ -959,7 +1358,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет автомобиль по указанному ID",
+                "description": "Удаляет тег по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -967,13 +1366,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cars"
+                    "Car Tags"
                 ],
-                "summary": "Удаление автомобиля",
+                "summary": "Удаление тега",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID автомобиля для удаления",
+                        "description": "ID тега",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -981,38 +1380,35 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Автомобиль успешно удален",
+                        "description": "Тег успешно удален",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_car.MessageResponse"
+                            "$ref": "#/definitions/internal_interfaces_http_car_tag.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cars/{id}/images": {
+        "/v1/cars/search": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Возвращает список изображений для указанного автомобиля",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Полнотекстовый поиск по названию, марке, категории, тегам и характеристикам на русском и английском с учетом опечаток. Результаты отсортированы по релевантности, кириллица также ищется в латинском написании. Highlight экранирован как HTML, совпадения выделены тегом \u003cmark\u003e",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Car images"
+                    "Cars"
                 ],
-                "summary": "Получение списка изображений автомобиля",
+                "summary": "Поиск автомобилей",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID автомобиля",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Поисковый запрос",
+                        "name": "q",
+                        "in": "query",
                         "required": true
                     },
                     {
@@ -1032,64 +1428,57 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список изображений успешно получен",
+                        "description": "Результаты поиска",
                         "schema": {
-                            "$ref": "#/definitions/car.ListCarImagesResponse"
+                            "$ref": "#/definitions/car.SearchCarsResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/cars/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Загружает изображение для указанного автомобиля",
+                "description": "Возвращает информацию об автомобиле по указанному ID",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Car images"
+                    "Cars"
                 ],
-                "summary": "Загрузка изображения автомобиля",
+                "summary": "Получение автомобиля по ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID автомобиля",
+                        "description": "ID автомобиля для получения",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Изображение автомобиля",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Марка успешно создана",
+                    "200": {
+                        "description": "Информация об автомобиле",
                         "schema": {
-                            "$ref": "#/definitions/car.CarImageResponse"
+                            "$ref": "#/definitions/car.CarResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/cars/{id}/images/{image_id}": {
-            "delete": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаляет изображение автомобиля по указанному пути",
+                "description": "Обновляет информацию об автомобиле по указанному ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1097,85 +1486,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Car images"
+                    "Cars"
                 ],
-                "summary": "Удаление изображения автомобиля",
+                "summary": "Обновление автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID автомобиля",
+                        "description": "ID автомобиля для обновления",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID изображения",
-                        "name": "image_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Данные для обновления автомобиля",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/car.UpdateCarRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Изображение успешно удалено",
+                        "description": "Автомобиль успешно обновлен",
                         "schema": {
-                            "$ref": "#/definitions/internal_interfaces_http_car_image.MessageResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/celebrities": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a paginated list of celebrities",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Celebrities"
-                ],
-                "summary": "List celebrities",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Limit for pagination",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/celebrity.ListCelebritiesResponse"
+                            "$ref": "#/definitions/car.CarResponse"
                         }
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new celebrity with the provided details",
+                "description": "Удаляет автомобиль по указанному ID. Автомобиль с бронированиями удалить нельзя (409)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1183,73 +1530,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Celebrities"
+                    "Cars"
                 ],
-                "summary": "Create a new celebrity",
+                "summary": "Удаление автомобиля",
                 "parameters": [
                     {
-                        "description": "Celebrity data",
-                        "name": "celebrity",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/celebrity.CreateCelebrityRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID автомобиля для удаления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "Автомобиль успешно удален",
                         "schema": {
-                            "$ref": "#/definitions/entities.Celebrity"
+                            "$ref": "#/definitions/internal_interfaces_http_car.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/celebrities/{id}": {
+        "/v1/cars/{id}/calendar": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a celebrity by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает занятые интервалы (бронирования и обслуживание) автомобиля за месяц",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Celebrities"
+                    "Car availability"
                 ],
-                "summary": "Get celebrity by ID",
+                "summary": "Календарь занятости автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Celebrity ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Месяц в формате YYYY-MM, по умолчанию текущий",
+                        "name": "month",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Календарь занятости",
                         "schema": {
-                            "$ref": "#/definitions/entities.Celebrity"
+                            "$ref": "#/definitions/entities.CarCalendar"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/cars/{id}/celebrities/order": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update celebrity information by ID",
+                "description": "Set the order of the \"Rented by\" list of a car. celebrity_ids must contain every celebrity linked to the car exactly once",
                 "consumes": [
                     "application/json"
                 ],
@@ -1259,22 +1609,22 @@ const docTemplate = `{
                 "tags": [
                     "Celebrities"
                 ],
-                "summary": "Update celebrity",
+                "summary": "Reorder the celebrities of a car",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Celebrity ID",
+                        "description": "Car ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Celebrity data",
-                        "name": "celebrity",
+                        "description": "Celebrity IDs in display order",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/celebrity.UpdateCelebrityRequest"
+                            "$ref": "#/definitions/celebrity.ReorderCarCelebritiesRequest"
                         }
                     }
                 ],
@@ -1282,18 +1632,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.Celebrity"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.CarRenter"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/cars/{id}/images": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a celebrity by ID",
+                "description": "Возвращает список изображений для указанного автомобиля",
                 "consumes": [
                     "application/json"
                 ],
@@ -1301,36 +1656,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Celebrities"
+                    "Car images"
                 ],
-                "summary": "Delete celebrity",
+                "summary": "Получение списка изображений автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Celebrity ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Список изображений успешно получен",
                         "schema": {
-                            "$ref": "#/definitions/celebrity.MessageResponse"
+                            "$ref": "#/definitions/car.ListCarImagesResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/celebrities/{id}/image": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload an image file for the specified celebrity",
+                "description": "Загружает изображение для указанного автомобиля",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -1338,43 +1705,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Celebrities"
+                    "Car images"
                 ],
-                "summary": "Upload an image for a celebrity",
+                "summary": "Загрузка изображения автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Celebrity ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "file",
-                        "description": "Image file",
+                        "description": "Изображение автомобиля",
                         "name": "image",
                         "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Марка успешно создана",
                         "schema": {
-                            "$ref": "#/definitions/entities.Celebrity"
+                            "$ref": "#/definitions/car.CarImageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/drivers": {
-            "get": {
+        "/v1/cars/{id}/images/order": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of drivers",
+                "description": "Сохраняет порядок галереи автомобиля. Список должен содержать все изображения автомобиля ровно по одному разу",
                 "consumes": [
                     "application/json"
                 ],
@@ -1382,41 +1749,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Car images"
                 ],
-                "summary": "List drivers",
+                "summary": "Изменение порядка изображений автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "ID автомобиля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Limit for pagination",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "ID изображений в новом порядке",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/car.ReorderCarImagesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Порядок изображений сохранен",
                         "schema": {
-                            "$ref": "#/definitions/driver.ListDriversResponse"
+                            "$ref": "#/definitions/car.ListCarImagesResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/cars/{id}/images/{image_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new driver with the provided details",
+                "description": "Удаляет изображение автомобиля по указанному пути",
                 "consumes": [
                     "application/json"
                 ],
@@ -1424,38 +1795,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Car images"
                 ],
-                "summary": "Create a new driver",
+                "summary": "Удаление изображения автомобиля",
                 "parameters": [
                     {
-                        "description": "Driver data",
-                        "name": "driver",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/driver.CreateDriverRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID автомобиля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID изображения",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "Изображение успешно удалено",
                         "schema": {
-                            "$ref": "#/definitions/entities.Driver"
+                            "$ref": "#/definitions/internal_interfaces_http_car_image.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/drivers/{id}": {
-            "get": {
+        "/v1/cars/{id}/images/{image_id}/cover": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a driver by ID",
+                "description": "Делает изображение главным в карточке автомобиля",
                 "consumes": [
                     "application/json"
                 ],
@@ -1463,34 +1839,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Car images"
                 ],
-                "summary": "Get driver by ID",
+                "summary": "Выбор обложки автомобиля",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID изображения",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Обложка успешно изменена",
                         "schema": {
-                            "$ref": "#/definitions/entities.Driver"
+                            "$ref": "#/definitions/car.CarImageResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/cars/{id}/maintenance-blocks": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update driver information by ID",
+                "description": "Делает автомобиль недоступным для бронирования на период [start_date, end_date)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1498,65 +1883,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Car availability"
                 ],
-                "summary": "Update driver",
+                "summary": "Блокировка автомобиля на обслуживание",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Driver data",
-                        "name": "driver",
+                        "description": "Период обслуживания",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/driver.UpdateDriverRequest"
+                            "$ref": "#/definitions/car.CreateMaintenanceBlockRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Период обслуживания создан",
                         "schema": {
-                            "$ref": "#/definitions/entities.Driver"
+                            "$ref": "#/definitions/entities.CarMaintenanceBlock"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/cars/{id}/maintenance-blocks/{block_id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a driver by ID",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Car availability"
                 ],
-                "summary": "Delete driver",
+                "summary": "Снятие блокировки обслуживания",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
+                        "description": "ID автомобиля",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID периода обслуживания",
+                        "name": "block_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Период обслуживания удален",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1567,58 +1957,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/drivers/{id}/photo": {
-            "put": {
+        "/v1/celebrities": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a photo file for the specified driver",
+                "description": "Get a paginated list of celebrities",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Drivers"
+                    "Celebrities"
                 ],
-                "summary": "Upload a photo for a driver",
+                "summary": "List celebrities",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Driver ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "Photo file",
-                        "name": "photo",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.Driver"
+                            "$ref": "#/definitions/celebrity.ListCelebritiesResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/leads": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of leads",
+                "description": "Create a new celebrity with the provided details. A featured celebrity is placed last in the homepage carousel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1626,41 +2014,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "Celebrities"
                 ],
-                "summary": "List leads",
+                "summary": "Create a new celebrity",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Limit for pagination",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Celebrity data",
+                        "name": "celebrity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.CreateCelebrityRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/lead.ListLeadsResponse"
+                            "$ref": "#/definitions/entities.Celebrity"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/celebrities/featured/order": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new lead with the provided details",
+                "description": "Set the order of the homepage carousel. celebrity_ids must contain every featured celebrity exactly once",
                 "consumes": [
                     "application/json"
                 ],
@@ -1668,38 +2053,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "Celebrities"
                 ],
-                "summary": "Create a new lead",
+                "summary": "Reorder the featured celebrities",
                 "parameters": [
                     {
-                        "description": "Lead data",
-                        "name": "lead",
+                        "description": "Celebrity IDs in display order",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/lead.CreateLeadRequest"
+                            "$ref": "#/definitions/celebrity.ReorderFeaturedCelebritiesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.Lead"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.Celebrity"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/leads/{id}": {
+        "/v1/celebrities/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a lead by ID",
+                "description": "Get detailed information about a celebrity by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1707,13 +2095,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "Celebrities"
                 ],
-                "summary": "Get lead by ID",
+                "summary": "Get celebrity by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Lead ID",
+                        "description": "Celebrity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1723,18 +2111,18 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.Lead"
+                            "$ref": "#/definitions/entities.Celebrity"
                         }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a lead by ID",
+                "description": "Update celebrity information by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1742,214 +2130,5205 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Leads"
+                    "Celebrities"
                 ],
-                "summary": "Delete lead",
+                "summary": "Update celebrity",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Lead ID",
+                        "description": "Celebrity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Celebrity data",
+                        "name": "celebrity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.UpdateCelebrityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/entities.Celebrity"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "auth.ConfirmEmailRequest": {
-            "type": "object",
-            "required": [
-                "code",
-                "email"
-            ],
-            "properties": {
-                "code": {
-                    "type": "string",
-                    "example": "123456"
-                },
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
-                }
-            }
-        },
-        "auth.ConfirmEmailResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "Email успешно подтвержден"
-                }
-            }
-        },
-        "auth.RefreshRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string",
-                    "example": "\u003crefresh_token\u003e"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a celebrity by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Delete celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.MessageResponse"
+                        }
+                    }
                 }
             }
         },
-        "auth.RefreshResponse": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string",
-                    "example": "\u003caccess_token\u003e"
-                },
-                "refresh_token": {
-                    "type": "string",
-                    "example": "\u003crefresh_token\u003e"
+        "/v1/celebrities/{id}/cars": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Link a car the celebrity rented, with an optional quote and rental date. The car is listed last on the celebrity page and the celebrity last on the car page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Add a rented car to a celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rented car",
+                        "name": "car",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.CelebrityCarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CelebrityCar"
+                        }
+                    }
                 }
             }
         },
-        "auth.SignInRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
+        "/v1/celebrities/{id}/cars/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the order of the \"Their choice\" list. car_ids must contain every car of the celebrity exactly once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Reorder the cars of a celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Car IDs in display order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.ReorderCelebrityCarsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.RentedCar"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/celebrities/{id}/cars/{car_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the quote and rental date of a car the celebrity rented",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Update a rented car of a celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quote and rental date",
+                        "name": "car",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.UpdateCelebrityCarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CelebrityCar"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unlink a car from the celebrity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Remove a rented car from a celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "car_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/celebrities/{id}/image": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the main photo of the celebrity. The previous photo is deleted only after the new one is stored",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Upload an image for a celebrity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Celebrity"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/celebrities/{id}/images": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a photo; it is placed last in the gallery",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Add a photo to the celebrity gallery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CelebrityImage"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/celebrities/{id}/images/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the order of the gallery. image_ids must contain every photo of the celebrity exactly once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Reorder the celebrity gallery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Image IDs in display order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.ReorderCelebrityImagesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.CelebrityImage"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/celebrities/{id}/images/{image_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a gallery photo and its files",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Celebrities"
+                ],
+                "summary": "Delete a photo from the celebrity gallery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Celebrity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/celebrity.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of drivers, optionally filtered by qualifications",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "List drivers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum driving experience in years",
+                        "name": "min_experience",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated ISO 639-1 codes the driver must all speak, e.g. en,ru",
+                        "name": "languages",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "A",
+                            "B",
+                            "BE",
+                            "C",
+                            "CE",
+                            "D",
+                            "DE",
+                            "A1",
+                            "B1",
+                            "C1",
+                            "C1E",
+                            "D1",
+                            "D1E"
+                        ],
+                        "type": "string",
+                        "description": "License category the driver holds and that has not expired",
+                        "name": "license",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Certified for this car directly or through its category",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Certified for this car category",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/driver.ListDriversResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new driver with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "Create a new driver",
+                "parameters": [
+                    {
+                        "description": "Driver data",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/driver.CreateDriverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Driver"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/available": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Drivers who work during [start_date, end_date) and have no booking or day off overlapping it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "List available drivers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Period start (YYYY-MM-DD or RFC 3339)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period end (YYYY-MM-DD or RFC 3339)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/driver.ListAvailableDriversResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed information about a driver by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "Get driver by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Driver"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update driver information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "Update driver",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Driver data",
+                        "name": "driver",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/driver.UpdateDriverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Driver"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a driver by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "Delete driver",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Shifts, bookings and days off of the driver within a month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "Get driver calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month as YYYY-MM, defaults to the current one",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DriverCalendar"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}/days-off": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take the driver off duty for [start_date, end_date). Returns 409 if the driver is booked during that period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "Add driver day off",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Day off period",
+                        "name": "day_off",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/driver.CreateDriverDayOffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DriverDayOff"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}/days-off/{day_off_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "Delete driver day off",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Day off ID",
+                        "name": "day_off_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}/photo": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a photo file for the specified driver",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drivers"
+                ],
+                "summary": "Upload a photo for a driver",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photo file",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Driver"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/drivers/{id}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Weekly working hours of the driver. An empty list means no schedule is set and the driver counts as working every day",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "Get driver schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DriverSchedule"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the weekly working hours of the driver, one shift per weekday (0 = Sunday). Times are HH:MM in the business timezone (APP_TIMEZONE)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Driver availability"
+                ],
+                "summary": "Set driver schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Driver ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shifts",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/driver.SetDriverScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DriverSchedule"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of leads, newest first, optionally filtered by status, assignee and creation date. Leads merged into another lead are not listed; likely duplicates carry duplicate_of_id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "List leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "new",
+                            "contacted",
+                            "quoted",
+                            "won",
+                            "lost"
+                        ],
+                        "type": "string",
+                        "description": "Lead status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by assigned user ID; 0 lists unassigned leads",
+                        "name": "assignee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC 3339) or on (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only leads flagged as likely duplicates",
+                        "name": "duplicates",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lead.ListLeadsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new lead with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Create a new lead",
+                "parameters": [
+                    {
+                        "description": "Lead data",
+                        "name": "lead",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.CreateLeadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Lead"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed information about a lead by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Get lead by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Lead"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a lead by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Delete lead",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/assignee": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Assign a lead to an admin or manager, or unassign it with a null assignee_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Assign lead",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignee",
+                        "name": "assignee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.AssignLeadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Lead"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the other leads of the duplicate group of a lead: the lead it duplicates and the leads flagged against the same one, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "List likely duplicates of a lead",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lead.ListLeadDuplicatesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every status change of a lead and of the leads merged into it, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Get lead status history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lead.ListLeadHistoryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Merge the source leads into the lead. The lead keeps its data and takes the car and assignee from a source when it has none. Sources leave the lead list; their status history and notes are shown on the lead, and a note records the merge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Merge leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leads to merge",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.MergeLeadsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Lead"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/notes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List notes left on a lead and on the leads merged into it, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "List lead notes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lead.ListLeadNotesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Leave a timestamped note on a lead on behalf of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Add lead note",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.CreateLeadNoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.LeadNote"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leads/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a lead through the pipeline: new → contacted → quoted → won/lost. A lead can be lost from any open status, which requires a reason. Every change is recorded in the lead history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Change lead status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.UpdateLeadStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Lead"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/car-categories": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список категорий",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список категорий",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCarCategoriesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/car-marks": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список марок",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список марок",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCarMarksResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/car-tags": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список тегов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список тегов",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCarTagsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/cars": {
+            "get": {
+                "description": "Возвращает каталог автомобилей без авторизации",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список автомобилей",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по названию автомобиля",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по ID марки автомобиля",
+                        "name": "mark_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по ID категории автомобиля",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Свободен с (YYYY-MM-DD или RFC 3339)",
+                        "name": "available_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Свободен до, не включительно (YYYY-MM-DD или RFC 3339)",
+                        "name": "available_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Год выпуска от",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Год выпуска до",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество мест от",
+                        "name": "seats_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество мест до",
+                        "name": "seats_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Мощность (л.с.) от",
+                        "name": "horsepower_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Мощность (л.с.) до",
+                        "name": "horsepower_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Объем двигателя (л) от",
+                        "name": "engine_volume_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Объем двигателя (л) до",
+                        "name": "engine_volume_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Разгон 0–100 км/ч (с) не более",
+                        "name": "acceleration_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Коробка передач через запятую (automatic, manual, robot, cvt)",
+                        "name": "transmission",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тип топлива через запятую (petrol, diesel, hybrid, electric)",
+                        "name": "fuel_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Привод через запятую (fwd, rwd, awd)",
+                        "name": "drive_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Цвет",
+                        "name": "color",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Цена за день от",
+                        "name": "price_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Цена за день до",
+                        "name": "price_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только с водителем (true) или без водителя (false)",
+                        "name": "only_with_driver",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID тегов через запятую",
+                        "name": "tag_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Сочетание тегов: any (любой) или all (все)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "price_asc",
+                            "price_desc",
+                            "popular"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Сортировка",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список автомобилей",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCarsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/cars/search": {
+            "get": {
+                "description": "Полнотекстовый поиск по каталогу на русском и английском с учетом опечаток. Кириллица также ищется в латинском написании. Highlight экранирован как HTML, совпадения выделены тегом \u003cmark\u003e",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный поиск автомобилей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Поисковый запрос",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результаты поиска",
+                        "schema": {
+                            "$ref": "#/definitions/public.SearchCarsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/cars/{id}": {
+            "get": {
+                "description": "Возвращает автомобиль с изображениями, тегами, маркой и категорией",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичная карточка автомобиля",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID автомобиля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация об автомобиле",
+                        "schema": {
+                            "$ref": "#/definitions/public.CarResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/cars/{id}/calendar": {
+            "get": {
+                "description": "Возвращает занятые интервалы автомобиля за месяц для выбора дат на сайте",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный календарь занятости автомобиля",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID автомобиля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Месяц в формате YYYY-MM, по умолчанию текущий",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Календарь занятости",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CarCalendar"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/cars/{id}/quote": {
+            "get": {
+                "description": "Возвращает детализированную стоимость аренды автомобиля за период [start_date, end_date)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Расчет стоимости аренды",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID автомобиля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало аренды (YYYY-MM-DD или RFC 3339)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Окончание аренды (YYYY-MM-DD или RFC 3339)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "С водителем",
+                        "name": "with_driver",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Расчет стоимости",
+                        "schema": {
+                            "$ref": "#/definitions/entities.PriceQuote"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/celebrities": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список знаменитостей",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список знаменитостей",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCelebritiesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/celebrities/featured": {
+            "get": {
+                "description": "Только отмеченные для главной страницы, в заданном вручную порядке",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Знаменитости для карусели на главной",
+                "responses": {
+                    "200": {
+                        "description": "Список знаменитостей",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListCelebritiesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/celebrities/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный профиль знаменитости",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID знаменитости",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о знаменитости",
+                        "schema": {
+                            "$ref": "#/definitions/public.CelebrityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/drivers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный список водителей",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальный стаж вождения, лет",
+                        "name": "min_experience",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Языки через запятую (ISO 639-1), водитель должен владеть всеми, например en,ru",
+                        "name": "languages",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "A",
+                            "B",
+                            "BE",
+                            "C",
+                            "CE",
+                            "D",
+                            "DE",
+                            "A1",
+                            "B1",
+                            "C1",
+                            "C1E",
+                            "D1",
+                            "D1E"
+                        ],
+                        "type": "string",
+                        "description": "Действующая категория прав",
+                        "name": "license",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Допущен к автомобилю напрямую или через его категорию",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Допущен к категории автомобилей",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список водителей",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListDriversResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/drivers/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Публичный профиль водителя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID водителя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о водителе",
+                        "schema": {
+                            "$ref": "#/definitions/public.DriverResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/leads": {
+            "post": {
+                "description": "Anonymous lead submission for the website form. Protected by captcha, a honeypot field and per-IP/per-phone rate limits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leads"
+                ],
+                "summary": "Submit a rental inquiry",
+                "parameters": [
+                    {
+                        "description": "Inquiry data",
+                        "name": "lead",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lead.SubmitLeadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/lead.SubmitLeadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/reviews": {
+            "get": {
+                "description": "Только прошедшие модерацию отзывы, новые первыми",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Одобренные отзывы клиентов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Отзывы об автомобиле",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Отзывы о водителе",
+                        "name": "driver_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список отзывов",
+                        "schema": {
+                            "$ref": "#/definitions/public.ListReviewsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Anonymous feedback on a completed booking, identified by the booking ID and the customer phone. Up to 5 photos can be attached. The review is published once a manager approves it. Protected by captcha, a honeypot field and rate limits per IP and per booking",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Submit a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "booking_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Customer phone from the booking",
+                        "name": "phone",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name shown with the review, defaults to the booking customer name",
+                        "name": "author_name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating of the rental and the car, 1-5",
+                        "name": "rating",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating of the driver, 1-5; only for bookings with a driver",
+                        "name": "driver_rating",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review text",
+                        "name": "text",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Captcha token",
+                        "name": "captcha_token",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Honeypot, leave empty",
+                        "name": "website",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photos, repeat the field for each file",
+                        "name": "photos",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/review.SubmitReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/rate-plans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Список тарифов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит для пагинации",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список тарифов",
+                        "schema": {
+                            "$ref": "#/definitions/pricing.ListRatePlansResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает тариф для автомобиля (car_id) или категории (category_id). Тариф автомобиля приоритетнее тарифа категории",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Создание тарифа",
+                "parameters": [
+                    {
+                        "description": "Параметры тарифа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricing.CreateRatePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Тариф создан",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RatePlan"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/rate-plans/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Получение тарифа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID тарифа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тариф",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RatePlan"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет ставки и сезоны тарифа. Привязку к автомобилю или категории изменить нельзя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Обновление тарифа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID тарифа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры тарифа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricing.UpdateRatePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тариф обновлен",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RatePlan"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Удаление тарифа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID тарифа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тариф удален",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of reviews, newest first, optionally filtered by moderation status, car or driver",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "Review status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by car ID",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by driver ID",
+                        "name": "driver_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/review.ListReviewsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reviews/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a review with its moderation details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Get review by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Review"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a review and its photos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Delete review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reviews/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve or reject a review. Rejecting requires a reason, which is never shown publicly. Approved reviews count towards the car and driver rating",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Moderate review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderation decision",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.ModerateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Review"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/role": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Доступно только администратору. Свою роль изменить нельзя. Сессии пользователя отзываются, новая роль действует после повторного входа",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Изменение роли пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новая роль",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.ChangeUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роль изменена",
+                        "schema": {
+                            "$ref": "#/definitions/auth.UserResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.ChangeUserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "manager",
+                        "customer"
+                    ],
+                    "example": "manager"
+                }
+            }
+        },
+        "auth.ConfirmEmailRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "email"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                }
+            }
+        },
+        "auth.ConfirmEmailResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Email успешно подтвержден"
+                }
+            }
+        },
+        "auth.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                }
+            }
+        },
+        "auth.ForgotPasswordResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Если email зарегистрирован, код сброса пароля отправлен"
+                }
+            }
+        },
+        "auth.LogoutRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "\u003crefresh_token\u003e"
+                }
+            }
+        },
+        "auth.LogoutResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Сессия завершена"
+                }
+            }
+        },
+        "auth.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "\u003crefresh_token\u003e"
+                }
+            }
+        },
+        "auth.RefreshResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "\u003caccess_token\u003e"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "\u003crefresh_token\u003e"
+                }
+            }
+        },
+        "auth.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "email",
+                "new_password"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "newpassword123"
+                }
+            }
+        },
+        "auth.ResetPasswordResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Пароль успешно изменен"
+                }
+            }
+        },
+        "auth.SignInRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password123"
+                }
+            }
+        },
+        "auth.SignInResponse": {
+            "type": "object",
+            "properties": {
+                "tokens": {
+                    "$ref": "#/definitions/entities.Tokens"
+                },
+                "user": {
+                    "$ref": "#/definitions/auth.SignUpResponse"
+                }
+            }
+        },
+        "auth.SignUpRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "password123"
+                }
+            }
+        },
+        "auth.SignUpResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "is_verified": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "role": {
+                    "type": "string",
+                    "example": "customer"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "auth.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "is_verified": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "role": {
+                    "type": "string",
+                    "example": "manager"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "auth.VerifyEmailRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                }
+            }
+        },
+        "auth.VerifyEmailResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Email успешно отправлен"
+                }
+            }
+        },
+        "booking.AssignBookingDriverRequest": {
+            "type": "object",
+            "properties": {
+                "driver_id": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "booking.CreateBookingRequest": {
+            "type": "object",
+            "required": [
+                "car_id"
+            ],
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "customer_phone": {
+                    "type": "string"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "lead_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "booking.ListBookingsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "booking.UpdateBookingStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "confirmed",
+                        "active",
+                        "completed",
+                        "cancelled"
+                    ],
+                    "example": "confirmed"
+                }
+            }
+        },
+        "car.CarCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.CarImageResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_path": {
+                    "type": "string"
+                },
+                "is_cover": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                }
+            }
+        },
+        "car.CarMarkResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.CarResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entities.CarCategory"
+                },
+                "cover": {
+                    "$ref": "#/definitions/entities.CarImage"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "effective_price_per_day": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarImage"
+                    }
+                },
+                "mark": {
+                    "$ref": "#/definitions/entities.CarMark"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "only_with_driver": {
+                    "type": "boolean"
+                },
+                "price_per_day": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "$ref": "#/definitions/entities.RatingSummary"
+                },
+                "rented_by": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarRenter"
+                    }
+                },
+                "specs": {
+                    "$ref": "#/definitions/entities.CarSpecs"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarTag"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.CarTagResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.CreateCarCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.CreateCarMarkRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Toyota"
+                }
+            }
+        },
+        "car.CreateCarRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "mark_id",
+                "name",
+                "price_per_day",
+                "tags_ids"
+            ],
+            "properties": {
+                "acceleration": {
+                    "type": "number",
+                    "example": 3.8
+                },
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                },
+                "color": {
+                    "type": "string",
+                    "example": "black"
+                },
+                "drive_type": {
+                    "type": "string",
+                    "enum": [
+                        "fwd",
+                        "rwd",
+                        "awd"
+                    ],
+                    "example": "awd"
+                },
+                "engine_volume": {
+                    "type": "number",
+                    "example": 4
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "enum": [
+                        "petrol",
+                        "diesel",
+                        "hybrid",
+                        "electric"
+                    ],
+                    "example": "petrol"
+                },
+                "horsepower": {
+                    "type": "integer",
+                    "example": 585
+                },
+                "mark_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Toyota"
+                },
+                "only_with_driver": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "price_per_day": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "tags_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "transmission": {
+                    "type": "string",
+                    "enum": [
+                        "automatic",
+                        "manual",
+                        "robot",
+                        "cvt"
+                    ],
+                    "example": "automatic"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2023
+                }
+            }
+        },
+        "car.CreateCarTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Sedan"
+                }
+            }
+        },
+        "car.CreateMaintenanceBlockRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2026-10-14T00:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Плановое ТО"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-10-10T00:00:00Z"
+                }
+            }
+        },
+        "car.ListCarCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarCategory"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.ListCarImagesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarImage"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.ListCarMarksResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarMark"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.ListCarTagsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarTag"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.ListCarsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Car"
+                    }
+                },
+                "facets": {
+                    "$ref": "#/definitions/entities.CarFacets"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.ReorderCarImagesRequest": {
+            "type": "object",
+            "required": [
+                "image_ids"
+            ],
+            "properties": {
+                "image_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "car.SearchCarsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarSearchResult"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "car.UpdateCarCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "car.UpdateCarMarkRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Toyota"
+                }
+            }
+        },
+        "car.UpdateCarRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "mark_id",
+                "name",
+                "price_per_day",
+                "tags_ids"
+            ],
+            "properties": {
+                "acceleration": {
+                    "type": "number",
+                    "example": 3.8
+                },
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                },
+                "color": {
+                    "type": "string",
+                    "example": "black"
+                },
+                "drive_type": {
+                    "type": "string",
+                    "enum": [
+                        "fwd",
+                        "rwd",
+                        "awd"
+                    ],
+                    "example": "awd"
+                },
+                "engine_volume": {
+                    "type": "number",
+                    "example": 4
+                },
+                "fuel_type": {
+                    "type": "string",
+                    "enum": [
+                        "petrol",
+                        "diesel",
+                        "hybrid",
+                        "electric"
+                    ],
+                    "example": "petrol"
+                },
+                "horsepower": {
+                    "type": "integer",
+                    "example": 585
+                },
+                "mark_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Toyota"
+                },
+                "only_with_driver": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "price_per_day": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "tags_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "transmission": {
+                    "type": "string",
+                    "enum": [
+                        "automatic",
+                        "manual",
+                        "robot",
+                        "cvt"
+                    ],
+                    "example": "automatic"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2023
+                }
+            }
+        },
+        "car.UpdateCarTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Sedan"
+                }
+            }
+        },
+        "celebrity.CelebrityCarRequest": {
+            "type": "object",
+            "required": [
+                "car_id"
+            ],
+            "properties": {
+                "car_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quote": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Лучший автомобиль для деловых встреч"
+                },
+                "rented_at": {
+                    "description": "RentedAt is the rental date; only the date part is kept",
+                    "type": "string",
+                    "example": "2024-05-01T00:00:00Z"
+                }
+            }
+        },
+        "celebrity.CreateCelebrityRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Народный артист, постоянный клиент с 2019 года"
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://example.com/celebrity.jpg"
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "John Doe"
+                },
+                "profession": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Актёр"
+                },
+                "social_links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/entities.SocialLink"
+                    }
+                }
+            }
+        },
+        "celebrity.ListCelebritiesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Celebrity"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "celebrity.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "celebrity.ReorderCarCelebritiesRequest": {
+            "type": "object",
+            "required": [
+                "celebrity_ids"
+            ],
+            "properties": {
+                "celebrity_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "celebrity.ReorderCelebrityCarsRequest": {
+            "type": "object",
+            "required": [
+                "car_ids"
+            ],
+            "properties": {
+                "car_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "celebrity.ReorderCelebrityImagesRequest": {
+            "type": "object",
+            "required": [
+                "image_ids"
+            ],
+            "properties": {
+                "image_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "celebrity.ReorderFeaturedCelebritiesRequest": {
+            "type": "object",
+            "required": [
+                "celebrity_ids"
+            ],
+            "properties": {
+                "celebrity_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "celebrity.UpdateCelebrityCarRequest": {
+            "type": "object",
+            "properties": {
+                "quote": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Лучший автомобиль для деловых встреч"
+                },
+                "rented_at": {
+                    "type": "string",
+                    "example": "2024-05-01T00:00:00Z"
+                }
+            }
+        },
+        "celebrity.UpdateCelebrityRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Народный артист, постоянный клиент с 2019 года"
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://example.com/celebrity.jpg"
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "John Doe"
+                },
+                "profession": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Актёр"
+                },
+                "social_links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/entities.SocialLink"
+                    }
+                }
+            }
+        },
+        "driver.CreateDriverDayOffRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2026-10-14T00:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Отпуск"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-10-10T00:00:00Z"
+                }
+            }
+        },
+        "driver.CreateDriverRequest": {
+            "type": "object",
+            "required": [
+                "about",
+                "experience_years",
+                "full_name"
+            ],
+            "properties": {
+                "about": {
+                    "type": "string"
+                },
+                "car_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "driving_experience": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "experience_years": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "kk",
+                        "en"
+                    ]
+                },
+                "licenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverLicense"
+                    }
+                }
+            }
+        },
+        "driver.ListAvailableDriversResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Driver"
+                    }
+                }
+            }
+        },
+        "driver.ListDriversResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "driver.SetDriverScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverShift"
+                    }
+                }
+            }
+        },
+        "driver.UpdateDriverRequest": {
+            "type": "object",
+            "required": [
+                "about",
+                "experience_years",
+                "full_name"
+            ],
+            "properties": {
+                "about": {
+                    "type": "string"
+                },
+                "car_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "driving_experience": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "experience_years": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "kk",
+                        "en"
+                    ]
+                },
+                "licenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverLicense"
+                    }
+                }
+            }
+        },
+        "entities.Booking": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "customer_phone": {
+                    "type": "string"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lead_id": {
+                    "type": "integer"
+                },
+                "price_quote": {
+                    "$ref": "#/definitions/entities.PriceQuote"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.BookingStatus"
+                },
+                "total_price": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.BookingStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "confirmed",
+                "active",
+                "completed",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "BookingStatusPending",
+                "BookingStatusConfirmed",
+                "BookingStatusActive",
+                "BookingStatusCompleted",
+                "BookingStatusCancelled"
+            ]
+        },
+        "entities.BusyInterval": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/entities.BusyIntervalKind"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.BusyIntervalKind": {
+            "type": "string",
+            "enum": [
+                "booking",
+                "maintenance",
+                "day_off"
+            ],
+            "x-enum-varnames": [
+                "BusyIntervalKindBooking",
+                "BusyIntervalKindMaintenance",
+                "BusyIntervalKindDayOff"
+            ]
+        },
+        "entities.Car": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entities.CarCategory"
+                },
+                "cover": {
+                    "$ref": "#/definitions/entities.CarImage"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "effective_price_per_day": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarImage"
+                    }
+                },
+                "mark": {
+                    "$ref": "#/definitions/entities.CarMark"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "only_with_driver": {
+                    "type": "boolean"
+                },
+                "price_per_day": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "$ref": "#/definitions/entities.RatingSummary"
+                },
+                "rented_by": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarRenter"
+                    }
+                },
+                "specs": {
+                    "$ref": "#/definitions/entities.CarSpecs"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CarTag"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarCalendar": {
+            "type": "object",
+            "properties": {
+                "busy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.BusyInterval"
+                    }
+                },
+                "car_id": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarCategory": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarDriveType": {
+            "type": "string",
+            "enum": [
+                "fwd",
+                "rwd",
+                "awd"
+            ],
+            "x-enum-varnames": [
+                "CarDriveTypeFWD",
+                "CarDriveTypeRWD",
+                "CarDriveTypeAWD"
+            ]
+        },
+        "entities.CarFacets": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.FacetCount"
+                    }
+                },
+                "driver": {
+                    "$ref": "#/definitions/entities.DriverFacet"
+                },
+                "marks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.FacetCount"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.PriceRange"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.FacetCount"
+                    }
+                }
+            }
+        },
+        "entities.CarFuelType": {
+            "type": "string",
+            "enum": [
+                "petrol",
+                "diesel",
+                "hybrid",
+                "electric"
+            ],
+            "x-enum-varnames": [
+                "CarFuelTypePetrol",
+                "CarFuelTypeDiesel",
+                "CarFuelTypeHybrid",
+                "CarFuelTypeElectric"
+            ]
+        },
+        "entities.CarImage": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_path": {
+                    "type": "string"
+                },
+                "is_cover": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                }
+            }
+        },
+        "entities.CarMaintenanceBlock": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarMark": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarRenter": {
+            "type": "object",
+            "properties": {
+                "celebrity_id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "image_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "quote": {
+                    "type": "string"
+                },
+                "rented_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarSearchResult": {
+            "type": "object",
+            "properties": {
+                "car": {
+                    "$ref": "#/definitions/entities.Car"
+                },
+                "highlight": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "number"
+                }
+            }
+        },
+        "entities.CarSpecs": {
+            "type": "object",
+            "properties": {
+                "acceleration": {
+                    "type": "number",
+                    "example": 3.8
+                },
+                "color": {
+                    "type": "string",
+                    "example": "black"
+                },
+                "drive_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CarDriveType"
+                        }
+                    ],
+                    "example": "awd"
+                },
+                "engine_volume": {
+                    "type": "number",
+                    "example": 4
+                },
+                "fuel_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CarFuelType"
+                        }
+                    ],
+                    "example": "petrol"
+                },
+                "horsepower": {
+                    "type": "integer",
+                    "example": 585
+                },
+                "seats": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "transmission": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CarTransmission"
+                        }
+                    ],
+                    "example": "automatic"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2023
+                }
+            }
+        },
+        "entities.CarTag": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CarTransmission": {
+            "type": "string",
+            "enum": [
+                "automatic",
+                "manual",
+                "robot",
+                "cvt"
+            ],
+            "x-enum-varnames": [
+                "CarTransmissionAutomatic",
+                "CarTransmissionManual",
+                "CarTransmissionRobot",
+                "CarTransmissionCVT"
+            ]
+        },
+        "entities.Celebrity": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string"
+                },
+                "cars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.RentedCar"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "image_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CelebrityImage"
+                    }
+                },
+                "is_featured": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profession": {
+                    "type": "string"
+                },
+                "social_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SocialLink"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CelebrityCar": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "celebrity_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "quote": {
+                    "type": "string"
+                },
+                "rented_at": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CelebrityImage": {
+            "type": "object",
+            "properties": {
+                "celebrity_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_path": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                }
+            }
+        },
+        "entities.Driver": {
+            "type": "object",
+            "properties": {
+                "about": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "experience_years": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "photo_url": {
+                    "type": "string"
+                },
+                "photo_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
+                },
+                "qualifications": {
+                    "$ref": "#/definitions/entities.DriverQualifications"
+                },
+                "rating": {
+                    "$ref": "#/definitions/entities.RatingSummary"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DriverCalendar": {
+            "type": "object",
+            "properties": {
+                "busy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.BusyInterval"
+                    }
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverShift"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DriverDayOff": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DriverFacet": {
+            "type": "object",
+            "properties": {
+                "self_drive": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "with_driver": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "entities.DriverLicense": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.DriverLicenseCategory"
+                        }
+                    ],
+                    "example": "B"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2030-05-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.DriverLicenseCategory": {
+            "type": "string",
+            "enum": [
+                "A",
+                "B",
+                "BE",
+                "C",
+                "CE",
+                "D",
+                "DE",
+                "A1",
+                "B1",
+                "C1",
+                "C1E",
+                "D1",
+                "D1E"
+            ],
+            "x-enum-varnames": [
+                "DriverLicenseCategoryA",
+                "DriverLicenseCategoryB",
+                "DriverLicenseCategoryBE",
+                "DriverLicenseCategoryC",
+                "DriverLicenseCategoryCE",
+                "DriverLicenseCategoryD",
+                "DriverLicenseCategoryDE",
+                "DriverLicenseCategoryA1",
+                "DriverLicenseCategoryB1",
+                "DriverLicenseCategoryC1",
+                "DriverLicenseCategoryC1E",
+                "DriverLicenseCategoryD1",
+                "DriverLicenseCategoryD1E"
+            ]
+        },
+        "entities.DriverQualifications": {
+            "type": "object",
+            "properties": {
+                "car_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "driving_experience": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "kk",
+                        "en"
+                    ]
+                },
+                "licenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverLicense"
+                    }
+                }
+            }
+        },
+        "entities.DriverSchedule": {
+            "type": "object",
+            "properties": {
+                "driver_id": {
+                    "type": "integer"
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DriverShift"
+                    }
+                }
+            }
+        },
+        "entities.DriverShift": {
+            "type": "object",
+            "properties": {
+                "end_time": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "21:00"
                 },
-                "password": {
+                "start_time": {
                     "type": "string",
-                    "example": "password123"
+                    "example": "09:00"
+                },
+                "weekday": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "auth.SignInResponse": {
+        "entities.FacetCount": {
             "type": "object",
             "properties": {
-                "tokens": {
-                    "$ref": "#/definitions/entities.Tokens"
+                "count": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "user": {
-                    "$ref": "#/definitions/auth.SignUpResponse"
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mercedes-Benz"
                 }
             }
         },
-        "auth.SignUpRequest": {
+        "entities.ImageVariant": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "format": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "jpeg"
                 },
-                "password": {
+                "height": {
+                    "type": "integer",
+                    "example": 533
+                },
+                "name": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ImageVariantName"
+                        }
+                    ],
+                    "example": "card"
+                },
+                "path": {
                     "type": "string",
-                    "minLength": 8,
-                    "example": "password123"
+                    "example": "cars/1700000000_s-class_card.jpg"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 800
                 }
             }
         },
-        "auth.SignUpResponse": {
+        "entities.ImageVariantName": {
+            "type": "string",
+            "enum": [
+                "thumb",
+                "card",
+                "full"
+            ],
+            "x-enum-varnames": [
+                "ImageVariantThumb",
+                "ImageVariantCard",
+                "ImageVariantFull"
+            ]
+        },
+        "entities.Lead": {
             "type": "object",
             "properties": {
+                "assignee_id": {
+                    "type": "integer"
+                },
+                "car_id": {
+                    "type": "integer"
+                },
                 "created_at": {
-                    "type": "string",
-                    "example": "2023-01-01T00:00:00Z"
+                    "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
+                "duplicate_of_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
                 },
                 "id": {
-                    "type": "integer",
-                    "example": 123
+                    "type": "integer"
                 },
-                "is_verified": {
-                    "type": "boolean",
-                    "example": false
+                "lost_reason": {
+                    "type": "string"
+                },
+                "merged_into_id": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "phone_raw": {
+                    "type": "string"
+                },
+                "price_quote": {
+                    "$ref": "#/definitions/entities.PriceQuote"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.LeadStatus"
                 },
                 "updated_at": {
-                    "type": "string",
-                    "example": "2023-01-01T00:00:00Z"
+                    "type": "string"
+                },
+                "with_driver": {
+                    "type": "boolean"
                 }
             }
         },
-        "auth.VerifyEmailRequest": {
+        "entities.LeadNote": {
             "type": "object",
-            "required": [
-                "email"
+            "properties": {
+                "author_id": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lead_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.LeadStatus": {
+            "type": "string",
+            "enum": [
+                "new",
+                "contacted",
+                "quoted",
+                "won",
+                "lost"
             ],
+            "x-enum-varnames": [
+                "LeadStatusNew",
+                "LeadStatusContacted",
+                "LeadStatusQuoted",
+                "LeadStatusWon",
+                "LeadStatusLost"
+            ]
+        },
+        "entities.LeadStatusChange": {
+            "type": "object",
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
+                "changed_by": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "$ref": "#/definitions/entities.LeadStatus"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lead_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/entities.LeadStatus"
                 }
             }
         },
-        "auth.VerifyEmailResponse": {
+        "entities.PriceItemCode": {
+            "type": "string",
+            "enum": [
+                "base",
+                "weekend",
+                "season",
+                "chauffeur"
+            ],
+            "x-enum-varnames": [
+                "PriceItemBase",
+                "PriceItemWeekend",
+                "PriceItemSeason",
+                "PriceItemChauffeur"
+            ]
+        },
+        "entities.PriceLineItem": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "Email успешно отправлен"
+                "amount": {
+                    "type": "integer"
+                },
+                "code": {
+                    "$ref": "#/definitions/entities.PriceItemCode"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "integer"
                 }
             }
         },
-        "car.CarCategoryResponse": {
+        "entities.PriceQuote": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "daily_rate": {
+                    "type": "integer"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.PriceLineItem"
+                    }
+                },
+                "rate_plan_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "with_driver": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entities.PriceRange": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "integer",
+                    "example": 250000
+                },
+                "min": {
+                    "type": "integer",
+                    "example": 15000
+                }
+            }
+        },
+        "entities.RatePlan": {
             "type": "object",
             "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "chauffeur_daily_rate": {
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "long_daily_rate": {
+                    "type": "integer"
+                },
+                "medium_daily_rate": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SeasonalRate"
+                    }
+                },
+                "short_daily_rate": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "weekend_surcharge_percent": {
+                    "type": "integer"
                 }
             }
         },
-        "car.CarImageResponse": {
+        "entities.RatingSummary": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "example": 4.75
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "entities.RentedCar": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "type": "integer"
+                },
+                "cover": {
+                    "$ref": "#/definitions/entities.CarImage"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "quote": {
+                    "type": "string"
+                },
+                "rented_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Review": {
             "type": "object",
             "properties": {
+                "author_name": {
+                    "type": "string"
+                },
+                "booking_id": {
+                    "type": "integer"
+                },
                 "car_id": {
                     "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "driver_id": {
+                    "type": "integer"
+                },
+                "driver_rating": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "image_path": {
-                    "type": "string"
+                "moderated_at": {
+                    "type": "string"
+                },
+                "moderated_by": {
+                    "type": "integer"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ReviewPhoto"
+                    }
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.ReviewStatus"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ReviewPhoto": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "type": "string",
+                    "example": "reviews/1700000000_photo_full.jpg"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ImageVariant"
+                    }
                 }
             }
         },
-        "car.CarMarkResponse": {
+        "entities.ReviewStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "ReviewStatusPending",
+                "ReviewStatusApproved",
+                "ReviewStatusRejected"
+            ]
+        },
+        "entities.SeasonalRate": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "end_date": {
                     "type": "string"
                 },
                 "id": {
@@ -1958,210 +7337,316 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "start_date": {
                     "type": "string"
+                },
+                "surcharge_percent": {
+                    "type": "integer"
                 }
             }
         },
-        "car.CarResponse": {
+        "entities.SocialLink": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/entities.CarCategory"
+                "network": {
+                    "type": "string",
+                    "example": "instagram"
                 },
-                "created_at": {
+                "url": {
+                    "type": "string",
+                    "example": "https://instagram.com/johndoe"
+                }
+            }
+        },
+        "entities.Tokens": {
+            "type": "object",
+            "properties": {
+                "access_token": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.CarImage"
-                    }
-                },
-                "mark": {
-                    "$ref": "#/definitions/entities.CarMark"
-                },
-                "name": {
+                "refresh_token": {
                     "type": "string"
-                },
-                "only_with_driver": {
-                    "type": "boolean"
-                },
-                "price_per_day": {
-                    "type": "integer"
-                },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.CarTag"
-                    }
-                },
-                "updated_at": {
+                }
+            }
+        },
+        "internal_interfaces_http_car.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "car.CarTagResponse": {
+        "internal_interfaces_http_car_category.MessageResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "message": {
                     "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
+                }
+            }
+        },
+        "internal_interfaces_http_car_image.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
-                },
-                "updated_at": {
+                }
+            }
+        },
+        "internal_interfaces_http_car_mark.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "car.CreateCarCategoryRequest": {
+        "internal_interfaces_http_car_tag.MessageResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "car.CreateCarMarkRequest": {
+        "lead.AssignLeadRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeID is the admin or manager to assign; null unassigns the lead",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "lead.CreateLeadNoteRequest": {
             "type": "object",
             "required": [
-                "name"
+                "body"
             ],
             "properties": {
-                "name": {
+                "body": {
                     "type": "string",
-                    "example": "Toyota"
+                    "example": "Перезвонить завтра после 12:00"
                 }
             }
         },
-        "car.CreateCarRequest": {
+        "lead.CreateLeadRequest": {
             "type": "object",
             "required": [
-                "category_id",
-                "mark_id",
-                "name",
-                "price_per_day",
-                "tags_ids"
+                "end_date",
+                "full_name",
+                "phone",
+                "start_date"
             ],
             "properties": {
-                "category_id": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 2
+                "car_id": {
+                    "type": "integer"
                 },
-                "mark_id": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
+                "end_date": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "Toyota"
+                "full_name": {
+                    "type": "string"
                 },
-                "only_with_driver": {
-                    "type": "boolean",
-                    "example": false
+                "phone": {
+                    "type": "string"
                 },
-                "price_per_day": {
-                    "type": "integer",
-                    "minimum": 0,
-                    "example": 100
+                "start_date": {
+                    "type": "string"
                 },
-                "tags_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "with_driver": {
+                    "type": "boolean"
                 }
             }
         },
-        "car.CreateCarTagRequest": {
+        "lead.ListLeadDuplicatesResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Sedan"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Lead"
+                    }
                 }
             }
         },
-        "car.ListCarCategoriesResponse": {
+        "lead.ListLeadHistoryResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.CarCategory"
+                        "$ref": "#/definitions/entities.LeadStatusChange"
                     }
-                },
-                "total": {
-                    "type": "integer"
                 }
             }
         },
-        "car.ListCarImagesResponse": {
+        "lead.ListLeadNotesResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.CarImage"
+                        "$ref": "#/definitions/entities.LeadNote"
                     }
-                },
+                }
+            }
+        },
+        "lead.ListLeadsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
                 "total": {
                     "type": "integer"
                 }
             }
         },
-        "car.ListCarMarksResponse": {
+        "lead.MergeLeadsRequest": {
             "type": "object",
+            "required": [
+                "source_ids"
+            ],
             "properties": {
-                "data": {
+                "source_ids": {
                     "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/entities.CarMark"
-                    }
+                        "type": "integer"
+                    },
+                    "example": [
+                        12,
+                        15
+                    ]
+                }
+            }
+        },
+        "lead.SubmitLeadRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "full_name",
+                "phone",
+                "start_date"
+            ],
+            "properties": {
+                "captcha_token": {
+                    "type": "string"
                 },
-                "total": {
+                "car_id": {
                     "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "website": {
+                    "description": "Website is a honeypot: the form hides it, so only bots fill it in",
+                    "type": "string"
+                },
+                "with_driver": {
+                    "type": "boolean"
                 }
             }
         },
-        "car.ListCarTagsResponse": {
+        "lead.SubmitLeadResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "lead.UpdateLeadStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "description": "Reason is required when the lead is lost",
+                    "type": "string",
+                    "example": "Выбрал другую компанию"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "new",
+                        "contacted",
+                        "quoted",
+                        "won",
+                        "lost"
+                    ],
+                    "example": "contacted"
+                }
+            }
+        },
+        "pricing.CreateRatePlanRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "car_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "chauffeur_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "long_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 11000
+                },
+                "medium_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 13000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Бизнес-класс"
+                },
+                "seasons": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.CarTag"
+                        "$ref": "#/definitions/pricing.SeasonalRateRequest"
                     }
                 },
-                "total": {
-                    "type": "integer"
+                "short_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15000
+                },
+                "weekend_surcharge_percent": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
                 }
             }
         },
-        "car.ListCarsResponse": {
+        "pricing.ListRatePlansResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.Car"
+                        "$ref": "#/definitions/entities.RatePlan"
                     }
                 },
                 "total": {
@@ -2169,447 +7654,660 @@ const docTemplate = `{
                 }
             }
         },
-        "car.UpdateCarCategoryRequest": {
+        "pricing.SeasonalRateRequest": {
             "type": "object",
             "required": [
-                "name"
+                "end_date",
+                "name",
+                "start_date"
             ],
             "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2027-01-08T00:00:00Z"
+                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Новогодние праздники"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-12-28T00:00:00Z"
+                },
+                "surcharge_percent": {
+                    "type": "integer",
+                    "example": 30
                 }
             }
         },
-        "car.UpdateCarMarkRequest": {
+        "pricing.UpdateRatePlanRequest": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
+                "chauffeur_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "long_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 11000
+                },
+                "medium_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 13000
+                },
                 "name": {
                     "type": "string",
-                    "example": "Toyota"
+                    "example": "Бизнес-класс"
+                },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricing.SeasonalRateRequest"
+                    }
+                },
+                "short_daily_rate": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15000
+                },
+                "weekend_surcharge_percent": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
                 }
             }
         },
-        "car.UpdateCarRequest": {
+        "public.CarCategoryResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "mark_id",
-                "name",
-                "price_per_day",
-                "tags_ids"
-            ],
             "properties": {
-                "category_id": {
+                "id": {
                     "type": "integer",
-                    "minimum": 1,
-                    "example": 2
+                    "example": 1
                 },
-                "mark_id": {
+                "name": {
+                    "type": "string",
+                    "example": "Business"
+                }
+            }
+        },
+        "public.CarImageResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 1
                 },
-                "name": {
+                "image_path": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "Toyota"
+                    "example": "cars/1700000000_s-class_full.jpg"
                 },
-                "only_with_driver": {
+                "image_url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/cars/1700000000_s-class_full.jpg"
+                },
+                "is_cover": {
                     "type": "boolean",
-                    "example": false
+                    "example": true
                 },
-                "price_per_day": {
+                "position": {
                     "type": "integer",
-                    "minimum": 0,
-                    "example": 100
+                    "example": 0
                 },
-                "tags_ids": {
+                "variants": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/public.ImageVariantResponse"
                     }
                 }
             }
         },
-        "car.UpdateCarTagRequest": {
+        "public.CarMarkResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "name": {
                     "type": "string",
-                    "example": "Sedan"
+                    "example": "Mercedes-Benz"
                 }
             }
         },
-        "celebrity.CreateCelebrityRequest": {
+        "public.CarRenterResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "image": {
+                "celebrity_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image_url": {
                     "type": "string",
-                    "example": "https://example.com/celebrity.jpg"
+                    "example": "http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"
+                },
+                "image_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ImageVariantResponse"
+                    }
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
                     "example": "John Doe"
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "Лучший автомобиль для деловых встреч"
+                },
+                "rented_at": {
+                    "type": "string"
                 }
             }
         },
-        "celebrity.ListCelebritiesResponse": {
+        "public.CarResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "category": {
+                    "$ref": "#/definitions/public.CarCategoryResponse"
+                },
+                "cover": {
+                    "$ref": "#/definitions/public.CarImageResponse"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "images": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.Celebrity"
+                        "$ref": "#/definitions/public.CarImageResponse"
                     }
                 },
-                "total": {
-                    "type": "integer"
+                "mark": {
+                    "$ref": "#/definitions/public.CarMarkResponse"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mercedes-Benz S-Class"
+                },
+                "only_with_driver": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "price_per_day": {
+                    "type": "integer",
+                    "example": 150000
+                },
+                "rating": {
+                    "$ref": "#/definitions/entities.RatingSummary"
+                },
+                "rented_by": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarRenterResponse"
+                    }
+                },
+                "specs": {
+                    "$ref": "#/definitions/entities.CarSpecs"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarTagResponse"
+                    }
                 }
             }
         },
-        "celebrity.MessageResponse": {
+        "public.CarSearchResultResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "car": {
+                    "$ref": "#/definitions/public.CarResponse"
+                },
+                "highlight": {
+                    "type": "string",
+                    "example": "\u003cmark\u003eMercedes\u003c/mark\u003e-Benz S-Class"
+                },
+                "rank": {
+                    "type": "number",
+                    "example": 0.82
                 }
             }
         },
-        "celebrity.UpdateCelebrityRequest": {
+        "public.CarTagResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "image": {
-                    "type": "string",
-                    "example": "https://example.com/celebrity.jpg"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "John Doe"
+                    "example": "Panoramic roof"
                 }
             }
         },
-        "driver.CreateDriverRequest": {
+        "public.CelebrityImageResponse": {
             "type": "object",
-            "required": [
-                "about",
-                "experience_years",
-                "full_name"
-            ],
             "properties": {
-                "about": {
-                    "type": "string"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "experience_years": {
-                    "type": "string"
+                "image_path": {
+                    "type": "string",
+                    "example": "celebrities/1700000000_john_full.jpg"
                 },
-                "full_name": {
-                    "type": "string"
-                }
-            }
-        },
-        "driver.ListDriversResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "total": {
-                    "type": "integer"
+                "image_url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ImageVariantResponse"
+                    }
                 }
             }
         },
-        "driver.UpdateDriverRequest": {
+        "public.CelebrityResponse": {
             "type": "object",
-            "required": [
-                "about",
-                "experience_years",
-                "full_name"
-            ],
             "properties": {
-                "about": {
-                    "type": "string"
+                "bio": {
+                    "type": "string",
+                    "example": "Народный артист, постоянный клиент с 2019 года"
                 },
-                "experience_years": {
-                    "type": "string"
+                "cars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.RentedCarResponse"
+                    }
                 },
-                "full_name": {
-                    "type": "string"
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image": {
+                    "type": "string",
+                    "example": "celebrities/1700000000_john_full.jpg"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"
+                },
+                "image_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ImageVariantResponse"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CelebrityImageResponse"
+                    }
+                },
+                "is_featured": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "profession": {
+                    "type": "string",
+                    "example": "Актёр"
+                },
+                "social_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SocialLink"
+                    }
                 }
             }
         },
-        "entities.Car": {
+        "public.DriverResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/entities.CarCategory"
-                },
-                "created_at": {
-                    "type": "string"
+                "about": {
+                    "type": "string",
+                    "example": "Professional chauffeur"
                 },
-                "id": {
-                    "type": "integer"
+                "car_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "images": {
+                "category_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.CarImage"
+                        "type": "integer"
                     }
                 },
-                "mark": {
-                    "$ref": "#/definitions/entities.CarMark"
+                "driving_experience": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "name": {
-                    "type": "string"
+                "experience_years": {
+                    "type": "string",
+                    "example": "10 лет"
                 },
-                "only_with_driver": {
-                    "type": "boolean"
+                "full_name": {
+                    "type": "string",
+                    "example": "Ivan Petrov"
                 },
-                "price_per_day": {
-                    "type": "integer"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "tags": {
+                "languages": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.CarTag"
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "en"
+                    ]
+                },
+                "photo_link": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/drivers/1700000000_ivan_full.jpg"
+                },
+                "photo_url": {
+                    "type": "string",
+                    "example": "drivers/1700000000_ivan_full.jpg"
+                },
+                "photo_variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ImageVariantResponse"
                     }
                 },
-                "updated_at": {
-                    "type": "string"
+                "rating": {
+                    "$ref": "#/definitions/entities.RatingSummary"
                 }
             }
         },
-        "entities.CarCategory": {
+        "public.ImageVariantResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "format": {
+                    "type": "string",
+                    "example": "jpeg"
                 },
-                "id": {
-                    "type": "integer"
+                "height": {
+                    "type": "integer",
+                    "example": 533
                 },
                 "name": {
-                    "type": "string"
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ImageVariantName"
+                        }
+                    ],
+                    "example": "card"
                 },
-                "updated_at": {
-                    "type": "string"
+                "path": {
+                    "type": "string",
+                    "example": "cars/1700000000_s-class_card.jpg"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/cars/1700000000_s-class_card.jpg"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 800
                 }
             }
         },
-        "entities.CarImage": {
+        "public.ListCarCategoriesResponse": {
             "type": "object",
             "properties": {
-                "car_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarCategoryResponse"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "image_path": {
-                    "type": "string"
                 }
             }
         },
-        "entities.CarMark": {
+        "public.ListCarMarksResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarMarkResponse"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "entities.CarTag": {
+        "public.ListCarTagsResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarTagResponse"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "entities.Celebrity": {
+        "public.ListCarsResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "image": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarResponse"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "facets": {
+                    "$ref": "#/definitions/entities.CarFacets"
                 },
-                "updated_at": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.Driver": {
+        "public.ListCelebritiesResponse": {
             "type": "object",
             "properties": {
-                "about": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "experience_years": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CelebrityResponse"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "photo_url": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "entities.Lead": {
+        "public.ListDriversResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "end_date": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.DriverResponse"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "phone": {
-                    "type": "string"
-                },
-                "start_date": {
-                    "type": "string"
                 }
             }
         },
-        "entities.Tokens": {
+        "public.ListReviewsResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ReviewResponse"
+                    }
                 },
-                "refresh_token": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "internal_interfaces_http_car.MessageResponse": {
+        "public.RentedCarResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "car_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "cover": {
+                    "$ref": "#/definitions/public.CarImageResponse"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mercedes-Benz S-Class"
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "Лучший автомобиль для деловых встреч"
+                },
+                "rented_at": {
                     "type": "string"
                 }
             }
         },
-        "internal_interfaces_http_car_category.MessageResponse": {
+        "public.ReviewPhotoResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "path": {
+                    "type": "string",
+                    "example": "reviews/1700000000_photo_full.jpg"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/uploads/reviews/1700000000_photo_full.jpg"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ImageVariantResponse"
+                    }
                 }
             }
         },
-        "internal_interfaces_http_car_image.MessageResponse": {
+        "public.ReviewResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "author_name": {
+                    "type": "string",
+                    "example": "Айгерим"
+                },
+                "car_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
                     "type": "string"
+                },
+                "driver_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "driver_rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.ReviewPhotoResponse"
+                    }
+                },
+                "rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Отличная машина и вежливый водитель"
                 }
             }
         },
-        "internal_interfaces_http_car_mark.MessageResponse": {
+        "public.SearchCarsResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/public.CarSearchResultResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "internal_interfaces_http_car_tag.MessageResponse": {
+        "review.ListReviewsResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "data": {},
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "lead.CreateLeadRequest": {
+        "review.ModerateReviewRequest": {
             "type": "object",
             "required": [
-                "end_date",
-                "full_name",
-                "phone",
-                "start_date"
+                "status"
             ],
             "properties": {
-                "end_date": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
-                },
-                "phone": {
-                    "type": "string"
+                "reason": {
+                    "description": "Reason is required when the review is rejected",
+                    "type": "string",
+                    "example": "Нецензурная лексика"
                 },
-                "start_date": {
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "approved",
+                        "rejected"
+                    ],
+                    "example": "approved"
                 }
             }
         },
-        "lead.ListLeadsResponse": {
+        "review.SubmitReviewResponse": {
             "type": "object",
             "properties": {
-                "data": {},
-                "total": {
-                    "type": "integer"
+                "message": {
+                    "type": "string"
                 }
             }
         }
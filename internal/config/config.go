@@ -2,19 +2,32 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	// Embedded so APP_TIMEZONE resolves on hosts without a zoneinfo database
+	_ "time/tzdata"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/nomad-pixel/imperial/pkg/utils"
 )
 
 // Config holds all application configuration
 type Config struct {
-	App      AppConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Email    EmailConfig
-	Storage  StorageConfig
-	Server   ServerConfig
+	App           AppConfig
+	Database      DatabaseConfig
+	JWT           JWTConfig
+	Email         EmailConfig
+	Storage       StorageConfig
+	Image         ImageConfig
+	Upload        UploadConfig
+	Server        ServerConfig
+	Captcha       CaptchaConfig
+	LeadSpam      LeadSpamConfig
+	ReviewSpam    ReviewSpamConfig
+	PasswordReset PasswordResetConfig
+	Phone         PhoneConfig
+	Notify        NotifyConfig
+	Outbox        OutboxConfig
 }
 
 // AppConfig contains general application settings
@@ -22,6 +35,9 @@ type AppConfig struct {
 	Name        string `envconfig:"APP_NAME" default:"Imperial"`
 	Environment string `envconfig:"APP_ENV" default:"development"`
 	Debug       bool   `envconfig:"APP_DEBUG" default:"false"`
+	// Timezone is where the business operates. Rental days, weekends,
+	// seasons and driver shifts are read in this location.
+	Timezone string `envconfig:"APP_TIMEZONE" default:"Asia/Almaty"`
 }
 
 // DatabaseConfig contains database connection settings
@@ -58,9 +74,40 @@ type SMTPConfig struct {
 
 // StorageConfig contains file storage settings
 type StorageConfig struct {
-	Type      string `envconfig:"STORAGE_TYPE" default:"local"`
-	LocalPath string `envconfig:"STORAGE_LOCAL_PATH" default:"./uploads"`
-	BaseURL   string `envconfig:"STORAGE_BASE_URL" default:"http://localhost:8080/uploads"`
+	Type      string   `envconfig:"STORAGE_TYPE" default:"local"`
+	LocalPath string   `envconfig:"STORAGE_LOCAL_PATH" default:"./uploads"`
+	BaseURL   string   `envconfig:"STORAGE_BASE_URL" default:"http://localhost:8080/uploads"`
+	S3        S3Config `envconfig:"S3"`
+}
+
+// S3Config contains settings for S3-compatible object storage (AWS S3, MinIO)
+type S3Config struct {
+	Endpoint     string `envconfig:"STORAGE_S3_ENDPOINT" default:"http://localhost:9000"`
+	Region       string `envconfig:"STORAGE_S3_REGION" default:"us-east-1"`
+	Bucket       string `envconfig:"STORAGE_S3_BUCKET"`
+	AccessKey    string `envconfig:"STORAGE_S3_ACCESS_KEY"`
+	SecretKey    string `envconfig:"STORAGE_S3_SECRET_KEY"`
+	UsePathStyle bool   `envconfig:"STORAGE_S3_USE_PATH_STYLE" default:"true"`
+	// PublicURL is the base URL of a public bucket or CDN; presigned URLs
+	// are returned when it is empty
+	PublicURL     string        `envconfig:"STORAGE_S3_PUBLIC_URL"`
+	PresignExpiry time.Duration `envconfig:"STORAGE_S3_PRESIGN_EXPIRY" default:"24h"`
+}
+
+// ImageConfig contains settings for processing uploaded images
+type ImageConfig struct {
+	JPEGQuality int `envconfig:"IMAGE_JPEG_QUALITY" default:"85"`
+	// WebPEncoder is the cwebp binary; WebP variants are skipped when empty
+	WebPEncoder string `envconfig:"IMAGE_WEBP_ENCODER"`
+	WebPQuality int    `envconfig:"IMAGE_WEBP_QUALITY" default:"80"`
+}
+
+// UploadConfig contains limits for uploaded images
+type UploadConfig struct {
+	MaxBytes       int64    `envconfig:"UPLOAD_MAX_BYTES" default:"15728640"` // 15 MB
+	MaxPixels      int64    `envconfig:"UPLOAD_MAX_PIXELS" default:"50000000"`
+	MaxDimension   int      `envconfig:"UPLOAD_MAX_DIMENSION" default:"12000"`
+	AllowedFormats []string `envconfig:"UPLOAD_ALLOWED_FORMATS" default:"jpeg,png"`
 }
 
 // ServerConfig contains HTTP server settings
@@ -69,6 +116,71 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `envconfig:"SERVER_READ_TIMEOUT" default:"10s"`
 	WriteTimeout    time.Duration `envconfig:"SERVER_WRITE_TIMEOUT" default:"10s"`
 	ShutdownTimeout time.Duration `envconfig:"SERVER_SHUTDOWN_TIMEOUT" default:"5s"`
+	// TrustedProxies lists the IPs or CIDRs of the reverse proxies allowed
+	// to set X-Forwarded-For. Empty means the app is not behind a proxy.
+	TrustedProxies []string `envconfig:"SERVER_TRUSTED_PROXIES"`
+}
+
+// CaptchaConfig contains captcha verification settings
+type CaptchaConfig struct {
+	Provider  string `envconfig:"CAPTCHA_PROVIDER" default:"noop"`
+	Secret    string `envconfig:"CAPTCHA_SECRET"`
+	VerifyURL string `envconfig:"CAPTCHA_VERIFY_URL" default:"https://challenges.cloudflare.com/turnstile/v0/siteverify"`
+}
+
+// LeadSpamConfig contains rate limits for public lead submission
+type LeadSpamConfig struct {
+	IPLimit    int           `envconfig:"LEAD_RATE_LIMIT_IP" default:"5"`
+	PhoneLimit int           `envconfig:"LEAD_RATE_LIMIT_PHONE" default:"3"`
+	Window     time.Duration `envconfig:"LEAD_RATE_LIMIT_WINDOW" default:"1h"`
+}
+
+// PasswordResetConfig limits password reset attempts per email and how
+// often reset codes can be requested per email and per client IP
+type PasswordResetConfig struct {
+	AttemptLimit      int           `envconfig:"PASSWORD_RESET_ATTEMPT_LIMIT" default:"5"`
+	Window            time.Duration `envconfig:"PASSWORD_RESET_ATTEMPT_WINDOW" default:"15m"`
+	RequestEmailLimit int           `envconfig:"PASSWORD_RESET_REQUEST_LIMIT_EMAIL" default:"3"`
+	RequestIPLimit    int           `envconfig:"PASSWORD_RESET_REQUEST_LIMIT_IP" default:"10"`
+	RequestWindow     time.Duration `envconfig:"PASSWORD_RESET_REQUEST_WINDOW" default:"1h"`
+}
+
+// ReviewSpamConfig contains rate limits for public review submission
+type ReviewSpamConfig struct {
+	IPLimit      int           `envconfig:"REVIEW_RATE_LIMIT_IP" default:"5"`
+	BookingLimit int           `envconfig:"REVIEW_RATE_LIMIT_BOOKING" default:"5"`
+	Window       time.Duration `envconfig:"REVIEW_RATE_LIMIT_WINDOW" default:"1h"`
+}
+
+// PhoneConfig contains phone number parsing settings
+type PhoneConfig struct {
+	// DefaultRegion is the ISO 3166 code assumed for numbers typed without
+	// a country code
+	DefaultRegion string `envconfig:"PHONE_DEFAULT_REGION" default:"KZ"`
+}
+
+// NotifyConfig contains staff notification settings. Channels lists the
+// enabled routes: console, email, webhook and telegram.
+type NotifyConfig struct {
+	Channels        []string `envconfig:"NOTIFY_CHANNELS" default:"console"`
+	EmailRecipients []string `envconfig:"NOTIFY_EMAIL_RECIPIENTS"`
+	WebhookURLs     []string `envconfig:"NOTIFY_WEBHOOK_URLS"`
+	WebhookSecret   string   `envconfig:"NOTIFY_WEBHOOK_SECRET"`
+	TelegramToken   string   `envconfig:"NOTIFY_TELEGRAM_BOT_TOKEN"`
+	TelegramChatIDs []string `envconfig:"NOTIFY_TELEGRAM_CHAT_IDS"`
+	TelegramAPIURL  string   `envconfig:"NOTIFY_TELEGRAM_API_URL" default:"https://api.telegram.org"`
+}
+
+// OutboxConfig contains settings of the background dispatcher that delivers
+// outbox messages (emails, staff notifications)
+type OutboxConfig struct {
+	PollInterval   time.Duration `envconfig:"OUTBOX_POLL_INTERVAL" default:"1s"`
+	BatchSize      int           `envconfig:"OUTBOX_BATCH_SIZE" default:"20"`
+	MaxAttempts    int           `envconfig:"OUTBOX_MAX_ATTEMPTS" default:"10"`
+	BackoffBase    time.Duration `envconfig:"OUTBOX_BACKOFF_BASE" default:"10s"`
+	BackoffMax     time.Duration `envconfig:"OUTBOX_BACKOFF_MAX" default:"1h"`
+	HandlerTimeout time.Duration `envconfig:"OUTBOX_HANDLER_TIMEOUT" default:"30s"`
+	Retention      time.Duration `envconfig:"OUTBOX_RETENTION" default:"168h"` // 7 days
 }
 
 // Load reads configuration from environment variables
@@ -100,11 +212,60 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to load storage config: %w", err)
 	}
 
+	// Load Image config
+	if err := envconfig.Process("", &cfg.Image); err != nil {
+		return nil, fmt.Errorf("failed to load image config: %w", err)
+	}
+
+	// Load Upload config
+	if err := envconfig.Process("", &cfg.Upload); err != nil {
+		return nil, fmt.Errorf("failed to load upload config: %w", err)
+	}
+
 	// Load Server config
 	if err := envconfig.Process("", &cfg.Server); err != nil {
 		return nil, fmt.Errorf("failed to load server config: %w", err)
 	}
 
+	// Load Captcha config
+	if err := envconfig.Process("", &cfg.Captcha); err != nil {
+		return nil, fmt.Errorf("failed to load captcha config: %w", err)
+	}
+
+	// Load lead anti-spam config
+	if err := envconfig.Process("", &cfg.LeadSpam); err != nil {
+		return nil, fmt.Errorf("failed to load lead anti-spam config: %w", err)
+	}
+
+	// Load review anti-spam config
+	if err := envconfig.Process("", &cfg.ReviewSpam); err != nil {
+		return nil, fmt.Errorf("failed to load review anti-spam config: %w", err)
+	}
+
+	// Load password reset limits
+	if err := envconfig.Process("", &cfg.PasswordReset); err != nil {
+		return nil, fmt.Errorf("failed to load password reset config: %w", err)
+	}
+
+	// Load Phone config
+	if err := envconfig.Process("", &cfg.Phone); err != nil {
+		return nil, fmt.Errorf("failed to load phone config: %w", err)
+	}
+
+	// Load Notify config
+	if err := envconfig.Process("", &cfg.Notify); err != nil {
+		return nil, fmt.Errorf("failed to load notify config: %w", err)
+	}
+	// "console, Email" is accepted; anything else unknown fails Validate
+	for i, channel := range cfg.Notify.Channels {
+		cfg.Notify.Channels[i] = strings.ToLower(strings.TrimSpace(channel))
+	}
+
+	// Load Outbox config
+	if err := envconfig.Process("", &cfg.Outbox); err != nil {
+		return nil, fmt.Errorf("failed to load outbox config: %w", err)
+	}
+
 	return &cfg, nil
 }
 
@@ -148,6 +309,79 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate storage backend
+	switch c.Storage.Type {
+	case "local":
+	case "s3":
+		if c.Storage.S3.Bucket == "" {
+			return fmt.Errorf("STORAGE_S3_BUCKET is required when STORAGE_TYPE is s3")
+		}
+		if c.Storage.S3.AccessKey == "" || c.Storage.S3.SecretKey == "" {
+			return fmt.Errorf("STORAGE_S3_ACCESS_KEY and STORAGE_S3_SECRET_KEY are required when STORAGE_TYPE is s3")
+		}
+		if c.Storage.S3.PublicURL == "" && (c.Storage.S3.PresignExpiry <= 0 || c.Storage.S3.PresignExpiry > 7*24*time.Hour) {
+			return fmt.Errorf("STORAGE_S3_PRESIGN_EXPIRY must be between 1s and 168h")
+		}
+	default:
+		return fmt.Errorf("invalid STORAGE_TYPE: %s (must be local or s3)", c.Storage.Type)
+	}
+
+	// Validate captcha. The noop verifier accepts every token, so it is not
+	// allowed in production
+	switch c.Captcha.Provider {
+	case "noop":
+		if c.IsProduction() {
+			return fmt.Errorf("CAPTCHA_PROVIDER noop is not allowed in production")
+		}
+	case "http":
+		if c.Captcha.Secret == "" {
+			return fmt.Errorf("CAPTCHA_SECRET is required when CAPTCHA_PROVIDER is http")
+		}
+	default:
+		return fmt.Errorf("invalid CAPTCHA_PROVIDER: %s (must be noop or http)", c.Captcha.Provider)
+	}
+
+	// Validate upload limits
+	if c.Upload.MaxBytes <= 0 || c.Upload.MaxPixels <= 0 || c.Upload.MaxDimension <= 0 {
+		return fmt.Errorf("UPLOAD_MAX_BYTES, UPLOAD_MAX_PIXELS and UPLOAD_MAX_DIMENSION must be positive")
+	}
+
+	// Validate phone region
+	if !utils.IsSupportedPhoneRegion(c.Phone.DefaultRegion) {
+		return fmt.Errorf("unsupported PHONE_DEFAULT_REGION: %s", c.Phone.DefaultRegion)
+	}
+
+	// Validate staff notification channels
+	for _, channel := range c.Notify.Channels {
+		switch channel {
+		case "console":
+		case "email":
+			if len(c.Notify.EmailRecipients) == 0 {
+				return fmt.Errorf("NOTIFY_EMAIL_RECIPIENTS is required when NOTIFY_CHANNELS contains email")
+			}
+		case "webhook":
+			if len(c.Notify.WebhookURLs) == 0 {
+				return fmt.Errorf("NOTIFY_WEBHOOK_URLS is required when NOTIFY_CHANNELS contains webhook")
+			}
+		case "telegram":
+			if c.Notify.TelegramToken == "" || len(c.Notify.TelegramChatIDs) == 0 {
+				return fmt.Errorf("NOTIFY_TELEGRAM_BOT_TOKEN and NOTIFY_TELEGRAM_CHAT_IDS are required when NOTIFY_CHANNELS contains telegram")
+			}
+		default:
+			return fmt.Errorf("invalid NOTIFY_CHANNELS entry: %s (must be console, email, webhook or telegram)", channel)
+		}
+	}
+	// Validate outbox dispatcher
+	if c.Outbox.PollInterval <= 0 || c.Outbox.BatchSize <= 0 || c.Outbox.MaxAttempts <= 0 {
+		return fmt.Errorf("OUTBOX_POLL_INTERVAL, OUTBOX_BATCH_SIZE and OUTBOX_MAX_ATTEMPTS must be positive")
+	}
+	if c.Outbox.BackoffBase <= 0 || c.Outbox.BackoffMax < c.Outbox.BackoffBase {
+		return fmt.Errorf("OUTBOX_BACKOFF_BASE must be positive and not exceed OUTBOX_BACKOFF_MAX")
+	}
+	if c.Outbox.HandlerTimeout <= 0 {
+		return fmt.Errorf("OUTBOX_HANDLER_TIMEOUT must be positive")
+	}
+
 	// Validate environment
 	validEnvs := map[string]bool{
 		"development": true,
@@ -158,6 +392,9 @@ func (c *Config) Validate() error {
 	if !validEnvs[c.App.Environment] {
 		return fmt.Errorf("invalid APP_ENV: %s (must be development, staging, production, or test)", c.App.Environment)
 	}
+	if _, err := time.LoadLocation(c.App.Timezone); err != nil {
+		return fmt.Errorf("invalid APP_TIMEZONE: %s", c.App.Timezone)
+	}
 
 	return nil
 }
@@ -1,11 +1,16 @@
 package di
 
 import (
+	"context"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/config"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/infrastructure/outbox"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/auth"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/booking"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/car"
+	carAvailability "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
 	carCategory "github.com/nomad-pixel/imperial/internal/interfaces/http/car/category"
 	carImage "github.com/nomad-pixel/imperial/internal/interfaces/http/car/image"
 	carMark "github.com/nomad-pixel/imperial/internal/interfaces/http/car/mark"
@@ -13,22 +18,31 @@ import (
 	celebrity "github.com/nomad-pixel/imperial/internal/interfaces/http/celebrity"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/driver"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/lead"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/pricing"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/public"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/review"
 )
 
 // App contains all application dependencies
 type App struct {
-	Config             *config.Config
-	DB                 *pgxpool.Pool
-	TokenService       ports.TokenService
-	AuthHandler        *auth.AuthHandler
-	CarHandler         *car.CarHandler
-	CarImageHandler    *carImage.CarImageHandler
-	CarTagHandler      *carTag.CarTagHandler
-	CarMarkHandler     *carMark.CarMarkHandler
-	CarCategoryHandler *carCategory.CarCategoryHandler
-	CelebrityHandler   *celebrity.CelebrityHandler
-	LeadHandler        *lead.LeadHandler
-	DriverHandler      *driver.DriverHandler
+	Config                 *config.Config
+	DB                     *pgxpool.Pool
+	TokenService           ports.TokenService
+	AuthHandler            *auth.AuthHandler
+	CarHandler             *car.CarHandler
+	CarImageHandler        *carImage.CarImageHandler
+	CarTagHandler          *carTag.CarTagHandler
+	CarMarkHandler         *carMark.CarMarkHandler
+	CarCategoryHandler     *carCategory.CarCategoryHandler
+	CarAvailabilityHandler *carAvailability.CarAvailabilityHandler
+	CelebrityHandler       *celebrity.CelebrityHandler
+	LeadHandler            *lead.LeadHandler
+	DriverHandler          *driver.DriverHandler
+	PublicHandler          *public.PublicHandler
+	BookingHandler         *booking.BookingHandler
+	PricingHandler         *pricing.PricingHandler
+	ReviewHandler          *review.ReviewHandler
+	Outbox                 *outbox.Dispatcher
 }
 
 // NewApp creates a new App instance with all dependencies injected
@@ -42,27 +56,50 @@ func NewApp(
 	carTagHandler *carTag.CarTagHandler,
 	carMarkHandler *carMark.CarMarkHandler,
 	carCategoryHandler *carCategory.CarCategoryHandler,
+	carAvailabilityHandler *carAvailability.CarAvailabilityHandler,
 	celebrityHandler *celebrity.CelebrityHandler,
 	leadHandler *lead.LeadHandler,
 	driverHandler *driver.DriverHandler,
+	publicHandler *public.PublicHandler,
+	bookingHandler *booking.BookingHandler,
+	pricingHandler *pricing.PricingHandler,
+	reviewHandler *review.ReviewHandler,
+	outboxDispatcher *outbox.Dispatcher,
 ) *App {
 	return &App{
-		Config:             cfg,
-		DB:                 db,
-		TokenService:       tokenSvc,
-		AuthHandler:        authHandler,
-		CarHandler:         carHandler,
-		CarImageHandler:    carImageHandler,
-		CarTagHandler:      carTagHandler,
-		CarMarkHandler:     carMarkHandler,
-		CarCategoryHandler: carCategoryHandler,
-		CelebrityHandler:   celebrityHandler,
-		LeadHandler:        leadHandler,
-		DriverHandler:      driverHandler,
+		Config:                 cfg,
+		DB:                     db,
+		TokenService:           tokenSvc,
+		AuthHandler:            authHandler,
+		CarHandler:             carHandler,
+		CarImageHandler:        carImageHandler,
+		CarTagHandler:          carTagHandler,
+		CarMarkHandler:         carMarkHandler,
+		CarCategoryHandler:     carCategoryHandler,
+		CarAvailabilityHandler: carAvailabilityHandler,
+		CelebrityHandler:       celebrityHandler,
+		LeadHandler:            leadHandler,
+		DriverHandler:          driverHandler,
+		PublicHandler:          publicHandler,
+		BookingHandler:         bookingHandler,
+		PricingHandler:         pricingHandler,
+		ReviewHandler:          reviewHandler,
+		Outbox:                 outboxDispatcher,
 	}
 }
 
+// Start launches the background workers
+func (a *App) Start(ctx context.Context) {
+	if a.Outbox != nil {
+		a.Outbox.Start(ctx)
+	}
+}
+
+// Close stops the background workers and releases resources
 func (a *App) Close() {
+	if a.Outbox != nil {
+		a.Outbox.Stop()
+	}
 	if a.DB != nil {
 		a.DB.Close()
 	}
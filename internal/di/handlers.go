@@ -3,7 +3,9 @@ package di
 import (
 	"github.com/google/wire"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/auth"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/booking"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/car"
+	carAvailability "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
 	carCategory "github.com/nomad-pixel/imperial/internal/interfaces/http/car/category"
 	carImage "github.com/nomad-pixel/imperial/internal/interfaces/http/car/image"
 	carMark "github.com/nomad-pixel/imperial/internal/interfaces/http/car/mark"
@@ -11,6 +13,9 @@ import (
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/celebrity"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/driver"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/lead"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/pricing"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/public"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/review"
 )
 
 // HandlerSet provides all HTTP handlers
@@ -21,7 +26,12 @@ var HandlerSet = wire.NewSet(
 	carTag.NewCarTagHandler,
 	carMark.NewCarMarkHandler,
 	carCategory.NewCarCategoryHandler,
+	carAvailability.NewCarAvailabilityHandler,
 	celebrity.NewCelebrityHandler,
 	lead.NewLeadHandler,
 	driver.NewDriverHandler,
+	public.NewPublicHandler,
+	booking.NewBookingHandler,
+	pricing.NewPricingHandler,
+	review.NewReviewHandler,
 )
@@ -4,15 +4,24 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/google/wire"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/config"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	authUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/auth"
+	leadUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	reviewUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/review"
 	token "github.com/nomad-pixel/imperial/internal/infrastructure/auth"
+	"github.com/nomad-pixel/imperial/internal/infrastructure/captcha"
 	"github.com/nomad-pixel/imperial/internal/infrastructure/email"
 	imageSvc "github.com/nomad-pixel/imperial/internal/infrastructure/image"
+	"github.com/nomad-pixel/imperial/internal/infrastructure/notification"
+	"github.com/nomad-pixel/imperial/internal/infrastructure/outbox"
 	postgres "github.com/nomad-pixel/imperial/internal/infrastructure/postgres"
+	"github.com/nomad-pixel/imperial/internal/infrastructure/ratelimit"
 )
 
 var ProviderSet = wire.NewSet(
@@ -24,18 +33,39 @@ var ProviderSet = wire.NewSet(
 	ProvideEmailService,
 	ProvideTokenService,
 	ProvideImageService,
+	ProvideImageUploadValidator,
+	ProvideCaptchaVerifier,
+	ProvideRateLimiter,
+	ProvideSubmitLeadLimits,
+	ProvideSubmitReviewLimits,
+	ProvideForgotPasswordLimits,
+	ProvideResetPasswordLimits,
+	ProvidePhoneRegion,
+	ProvideBusinessLocation,
+	ProvideStaffNotifier,
+	ProvideOutboxDispatcher,
+	ProvideTransactor,
 
 	// Repository providers
 	ProvideUserRepository,
 	ProvideVerifyCodeRepository,
+	ProvideSessionRepository,
 	ProvideCarRepository,
 	ProvideCarCategoryRepository,
 	ProvideCarTagRepository,
 	ProvideCarMarkRepository,
 	ProvideCarImageRepository,
 	ProvideCelebrityRepository,
+	ProvideCelebrityCarRepository,
+	ProvideCelebrityImageRepository,
 	ProvideLeadRepository,
+	ProvideLeadNoteRepository,
 	ProvideDriverRepository,
+	ProvideBookingRepository,
+	ProvideCarAvailabilityRepository,
+	ProvideRatePlanRepository,
+	ProvideOutboxRepository,
+	ProvideReviewRepository,
 
 	// Use case providers (imported from other files)
 	AuthUsecaseSet,
@@ -43,6 +73,9 @@ var ProviderSet = wire.NewSet(
 	CelebrityUsecaseSet,
 	LeadUsecaseSet,
 	DriverUsecaseSet,
+	BookingUsecaseSet,
+	PricingUsecaseSet,
+	ReviewUsecaseSet,
 
 	// Handler providers
 	HandlerSet,
@@ -121,13 +154,162 @@ func ProvideTokenService(cfg *config.Config) ports.TokenService {
 }
 
 func ProvideImageService(cfg *config.Config) (ports.ImageService, error) {
-	log.Printf("Initializing file storage (path: %s, base URL: %s)", cfg.Storage.LocalPath, cfg.Storage.BaseURL)
+	var webp *imageSvc.WebPEncoder
+	if cfg.Image.WebPEncoder != "" {
+		encoder, err := imageSvc.NewWebPEncoder(cfg.Image.WebPEncoder, cfg.Image.WebPQuality)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize webp encoder: %w", err)
+		}
+		webp = encoder
+	} else {
+		log.Printf("IMAGE_WEBP_ENCODER is not set, WebP image variants are disabled")
+	}
+	processor := imageSvc.NewProcessor(cfg.Image.JPEGQuality, webp)
+
+	switch cfg.Storage.Type {
+	case "s3":
+		log.Printf("Initializing S3 storage (endpoint: %s, bucket: %s)", cfg.Storage.S3.Endpoint, cfg.Storage.S3.Bucket)
+		client, err := imageSvc.NewS3Client(imageSvc.S3Options{
+			Endpoint:     cfg.Storage.S3.Endpoint,
+			Region:       cfg.Storage.S3.Region,
+			Bucket:       cfg.Storage.S3.Bucket,
+			AccessKey:    cfg.Storage.S3.AccessKey,
+			SecretKey:    cfg.Storage.S3.SecretKey,
+			UsePathStyle: cfg.Storage.S3.UsePathStyle,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize s3 client: %w", err)
+		}
+		return imageSvc.NewS3ImageService(client, cfg.Storage.S3.PublicURL, cfg.Storage.S3.PresignExpiry, processor), nil
+	default:
+		log.Printf("Initializing file storage (path: %s, base URL: %s)", cfg.Storage.LocalPath, cfg.Storage.BaseURL)
+		imageService, err := imageSvc.NewFileImageService(cfg.Storage.LocalPath, cfg.Storage.BaseURL, processor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize image service: %w", err)
+		}
+		return imageService, nil
+	}
+}
 
-	imageService, err := imageSvc.NewFileImageService(cfg.Storage.LocalPath, cfg.Storage.BaseURL)
+func ProvideImageUploadValidator(cfg *config.Config) (ports.ImageUploadValidator, error) {
+	validator, err := imageSvc.NewUploadValidator(
+		cfg.Upload.MaxBytes,
+		cfg.Upload.MaxPixels,
+		cfg.Upload.MaxDimension,
+		cfg.Upload.AllowedFormats,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize image service: %w", err)
+		return nil, fmt.Errorf("failed to initialize upload validator: %w", err)
 	}
-	return imageService, nil
+	return validator, nil
+}
+
+// ProvideCaptchaVerifier relies on config validation to reject unknown
+// providers and the noop verifier in production.
+func ProvideCaptchaVerifier(cfg *config.Config) ports.CaptchaVerifier {
+	if cfg.Captcha.Provider == "http" {
+		log.Printf("Initializing captcha verifier (url: %s)", cfg.Captcha.VerifyURL)
+		return captcha.NewHTTPCaptchaVerifier(cfg.Captcha.VerifyURL, cfg.Captcha.Secret)
+	}
+
+	log.Println("Using no-op captcha verifier (all captcha tokens are accepted)")
+	return captcha.NewNoopCaptchaVerifier()
+}
+
+// ProvideStaffNotifier fails on an unknown channel instead of falling back to
+// the console, so a typo cannot silently drop staff alerts.
+func ProvideStaffNotifier(cfg *config.Config, emailService ports.EmailService) (ports.StaffNotificationRouter, error) {
+	channels := make([]notification.Channel, 0, len(cfg.Notify.Channels))
+	for _, name := range cfg.Notify.Channels {
+		var notifier ports.StaffNotifier
+		switch name {
+		case "email":
+			notifier = notification.NewEmailNotifier(emailService, cfg.Notify.EmailRecipients)
+		case "webhook":
+			notifier = notification.NewWebhookNotifier(cfg.Notify.WebhookURLs, cfg.Notify.WebhookSecret)
+		case "telegram":
+			notifier = notification.NewTelegramNotifier(cfg.Notify.TelegramAPIURL, cfg.Notify.TelegramToken, cfg.Notify.TelegramChatIDs)
+		case "console":
+			notifier = notification.NewConsoleNotifier()
+		default:
+			return nil, fmt.Errorf("unknown staff notification channel: %s", name)
+		}
+		channels = append(channels, notification.Channel{Name: name, Notifier: notifier})
+	}
+
+	log.Printf("Staff notification channels: %v", cfg.Notify.Channels)
+	return notification.NewRouter(channels), nil
+}
+
+func ProvideOutboxDispatcher(
+	cfg *config.Config,
+	outboxRepo ports.OutboxRepository,
+	emailService ports.EmailService,
+	staffNotifier ports.StaffNotificationRouter,
+) *outbox.Dispatcher {
+	dispatcher := outbox.NewDispatcher(outboxRepo, outbox.Config{
+		PollInterval:   cfg.Outbox.PollInterval,
+		BatchSize:      cfg.Outbox.BatchSize,
+		MaxAttempts:    cfg.Outbox.MaxAttempts,
+		BackoffBase:    cfg.Outbox.BackoffBase,
+		BackoffMax:     cfg.Outbox.BackoffMax,
+		HandlerTimeout: cfg.Outbox.HandlerTimeout,
+		Retention:      cfg.Outbox.Retention,
+	})
+	dispatcher.Handle(entities.OutboxTopicVerificationEmail, outbox.EmailCodeHandler(emailService.SendVerificationCode))
+	dispatcher.Handle(entities.OutboxTopicPasswordResetEmail, outbox.EmailCodeHandler(emailService.SendPasswordResetCode))
+	dispatcher.Handle(entities.OutboxTopicLeadCreated, outbox.LeadCreatedHandler(staffNotifier))
+	return dispatcher
+}
+
+func ProvideTransactor(db *pgxpool.Pool) ports.Transactor {
+	return postgres.NewTransactor(db)
+}
+
+// ProvideRateLimiter counts hits in process memory. Each API replica keeps its
+// own counters, so behind a load balancer every limit is per replica.
+func ProvideRateLimiter() ports.RateLimiter {
+	return ratelimit.NewMemoryRateLimiter()
+}
+
+func ProvideSubmitLeadLimits(cfg *config.Config) leadUsecase.SubmitLeadLimits {
+	return leadUsecase.SubmitLeadLimits{
+		PerIP:    cfg.LeadSpam.IPLimit,
+		PerPhone: cfg.LeadSpam.PhoneLimit,
+		Window:   cfg.LeadSpam.Window,
+	}
+}
+
+func ProvideSubmitReviewLimits(cfg *config.Config) reviewUsecase.SubmitReviewLimits {
+	return reviewUsecase.SubmitReviewLimits{
+		PerIP:      cfg.ReviewSpam.IPLimit,
+		PerBooking: cfg.ReviewSpam.BookingLimit,
+		Window:     cfg.ReviewSpam.Window,
+	}
+}
+
+func ProvideForgotPasswordLimits(cfg *config.Config) authUsecase.ForgotPasswordLimits {
+	return authUsecase.ForgotPasswordLimits{
+		PerEmail: cfg.PasswordReset.RequestEmailLimit,
+		PerIP:    cfg.PasswordReset.RequestIPLimit,
+		Window:   cfg.PasswordReset.RequestWindow,
+	}
+}
+
+func ProvideResetPasswordLimits(cfg *config.Config) authUsecase.ResetPasswordLimits {
+	return authUsecase.ResetPasswordLimits{
+		PerEmail: cfg.PasswordReset.AttemptLimit,
+		Window:   cfg.PasswordReset.Window,
+	}
+}
+
+func ProvidePhoneRegion(cfg *config.Config) leadUsecase.PhoneRegion {
+	return leadUsecase.PhoneRegion(cfg.Phone.DefaultRegion)
+}
+
+// ProvideBusinessLocation returns the timezone calendar dates are read in
+func ProvideBusinessLocation(cfg *config.Config) (*time.Location, error) {
+	return time.LoadLocation(cfg.App.Timezone)
 }
 
 func ProvideUserRepository(db *pgxpool.Pool) ports.UserRepository {
@@ -138,6 +320,10 @@ func ProvideVerifyCodeRepository(db *pgxpool.Pool) ports.VerifyCodeRepository {
 	return postgres.NewVerifyCodeRepositoryImpl(db)
 }
 
+func ProvideSessionRepository(db *pgxpool.Pool) ports.SessionRepository {
+	return postgres.NewSessionRepositoryImpl(db)
+}
+
 func ProvideCarRepository(db *pgxpool.Pool) ports.CarRepository {
 	return postgres.NewCarRepositoryImpl(db)
 }
@@ -162,10 +348,42 @@ func ProvideCelebrityRepository(db *pgxpool.Pool) ports.CelebrityRepository {
 	return postgres.NewCelebrityRepositoryImpl(db)
 }
 
+func ProvideCelebrityCarRepository(db *pgxpool.Pool) ports.CelebrityCarRepository {
+	return postgres.NewCelebrityCarRepository(db)
+}
+
+func ProvideCelebrityImageRepository(db *pgxpool.Pool) ports.CelebrityImageRepository {
+	return postgres.NewCelebrityImageRepository(db)
+}
+
 func ProvideLeadRepository(db *pgxpool.Pool) ports.LeadRepository {
 	return postgres.NewLeadRepository(db)
 }
 
+func ProvideLeadNoteRepository(db *pgxpool.Pool) ports.LeadNoteRepository {
+	return postgres.NewLeadNoteRepository(db)
+}
+
 func ProvideDriverRepository(db *pgxpool.Pool) ports.DriverRepository {
 	return postgres.NewDriverRepository(db)
 }
+
+func ProvideBookingRepository(db *pgxpool.Pool) ports.BookingRepository {
+	return postgres.NewBookingRepository(db)
+}
+
+func ProvideCarAvailabilityRepository(db *pgxpool.Pool) ports.CarAvailabilityRepository {
+	return postgres.NewCarAvailabilityRepository(db)
+}
+
+func ProvideRatePlanRepository(db *pgxpool.Pool) ports.RatePlanRepository {
+	return postgres.NewRatePlanRepository(db)
+}
+
+func ProvideOutboxRepository(db *pgxpool.Pool) ports.OutboxRepository {
+	return postgres.NewOutboxRepository(db)
+}
+
+func ProvideReviewRepository(db *pgxpool.Pool) ports.ReviewRepository {
+	return postgres.NewReviewRepository(db)
+}
@@ -3,10 +3,13 @@ package di
 import (
 	"github.com/google/wire"
 	authUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/auth"
+	bookingUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/booking"
 	carUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/car"
 	celebrityUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/celebrity"
 	driverUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/driver"
 	leadUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	pricingUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/pricing"
+	reviewUsecase "github.com/nomad-pixel/imperial/internal/domain/usecases/review"
 )
 
 // AuthUsecaseSet provides all auth-related use cases
@@ -16,6 +19,11 @@ var AuthUsecaseSet = wire.NewSet(
 	authUsecase.NewConfirmEmailVerificationUsecase,
 	authUsecase.NewSignInUsecase,
 	authUsecase.NewRefreshTokenUsecase,
+	authUsecase.NewForgotPasswordUsecase,
+	authUsecase.NewResetPasswordUsecase,
+	authUsecase.NewLogoutUsecase,
+	authUsecase.NewLogoutAllUsecase,
+	authUsecase.NewChangeUserRoleUsecase,
 )
 
 // CarUsecaseSet provides all car-related use cases
@@ -26,6 +34,13 @@ var CarUsecaseSet = wire.NewSet(
 	carUsecase.NewUpdateCarUsecase,
 	carUsecase.NewGetCarByIdUsecase,
 	carUsecase.NewGetListCarsUsecase,
+	carUsecase.NewSearchCarsUsecase,
+	carUsecase.NewGetCarFacetsUsecase,
+
+	// Car Availability
+	carUsecase.NewGetCarCalendarUsecase,
+	carUsecase.NewCreateCarMaintenanceBlockUsecase,
+	carUsecase.NewDeleteCarMaintenanceBlockUsecase,
 
 	// Car Tag
 	carUsecase.NewCreateCarTagUsecase,
@@ -52,6 +67,8 @@ var CarUsecaseSet = wire.NewSet(
 	carUsecase.NewCreateCarImageUsecase,
 	carUsecase.NewDeleteCarImageUsecase,
 	carUsecase.NewGetCarImagesListUsecase,
+	carUsecase.NewReorderCarImagesUsecase,
+	carUsecase.NewSetCarImageCoverUsecase,
 )
 
 // CelebrityUsecaseSet provides all celebrity-related use cases
@@ -62,6 +79,16 @@ var CelebrityUsecaseSet = wire.NewSet(
 	celebrityUsecase.NewListCelebritiesUsecase,
 	celebrityUsecase.NewUpdateCelebrityUsecase,
 	celebrityUsecase.NewDeleteCelebrityUsecase,
+	celebrityUsecase.NewAddCelebrityCarUsecase,
+	celebrityUsecase.NewUpdateCelebrityCarUsecase,
+	celebrityUsecase.NewDeleteCelebrityCarUsecase,
+	celebrityUsecase.NewReorderCelebrityCarsUsecase,
+	celebrityUsecase.NewReorderCarCelebritiesUsecase,
+	celebrityUsecase.NewListFeaturedCelebritiesUsecase,
+	celebrityUsecase.NewReorderFeaturedCelebritiesUsecase,
+	celebrityUsecase.NewAddCelebrityImageUsecase,
+	celebrityUsecase.NewDeleteCelebrityImageUsecase,
+	celebrityUsecase.NewReorderCelebrityImagesUsecase,
 )
 
 var LeadUsecaseSet = wire.NewSet(
@@ -69,6 +96,14 @@ var LeadUsecaseSet = wire.NewSet(
 	leadUsecase.NewGetLeadByIdUsecase,
 	leadUsecase.NewListLeadsUsecase,
 	leadUsecase.NewDeleteLeadUsecase,
+	leadUsecase.NewSubmitLeadUsecase,
+	leadUsecase.NewUpdateLeadStatusUsecase,
+	leadUsecase.NewAssignLeadUsecase,
+	leadUsecase.NewGetLeadHistoryUsecase,
+	leadUsecase.NewAddLeadNoteUsecase,
+	leadUsecase.NewListLeadNotesUsecase,
+	leadUsecase.NewListLeadDuplicatesUsecase,
+	leadUsecase.NewMergeLeadsUsecase,
 )
 
 var DriverUsecaseSet = wire.NewSet(
@@ -78,4 +113,35 @@ var DriverUsecaseSet = wire.NewSet(
 	driverUsecase.NewUpdateDriverUsecase,
 	driverUsecase.NewDeleteDriverUsecase,
 	driverUsecase.NewUploadDriverPhotoUsecase,
+	driverUsecase.NewGetDriverScheduleUsecase,
+	driverUsecase.NewSetDriverScheduleUsecase,
+	driverUsecase.NewGetDriverCalendarUsecase,
+	driverUsecase.NewCreateDriverDayOffUsecase,
+	driverUsecase.NewDeleteDriverDayOffUsecase,
+	driverUsecase.NewListAvailableDriversUsecase,
+)
+
+var BookingUsecaseSet = wire.NewSet(
+	bookingUsecase.NewCreateBookingUsecase,
+	bookingUsecase.NewGetBookingByIdUsecase,
+	bookingUsecase.NewListBookingsUsecase,
+	bookingUsecase.NewUpdateBookingStatusUsecase,
+	bookingUsecase.NewAssignBookingDriverUsecase,
+)
+
+var PricingUsecaseSet = wire.NewSet(
+	pricingUsecase.NewCreateRatePlanUsecase,
+	pricingUsecase.NewGetRatePlanByIdUsecase,
+	pricingUsecase.NewListRatePlansUsecase,
+	pricingUsecase.NewUpdateRatePlanUsecase,
+	pricingUsecase.NewDeleteRatePlanUsecase,
+	pricingUsecase.NewGetPriceQuoteUsecase,
+)
+
+var ReviewUsecaseSet = wire.NewSet(
+	reviewUsecase.NewSubmitReviewUsecase,
+	reviewUsecase.NewListReviewsUsecase,
+	reviewUsecase.NewGetReviewByIdUsecase,
+	reviewUsecase.NewModerateReviewUsecase,
+	reviewUsecase.NewDeleteReviewUsecase,
 )
@@ -9,12 +9,17 @@ package di
 import (
 	"context"
 	"github.com/nomad-pixel/imperial/internal/domain/usecases/auth"
+	usecases7 "github.com/nomad-pixel/imperial/internal/domain/usecases/booking"
 	usecases2 "github.com/nomad-pixel/imperial/internal/domain/usecases/car"
 	usecases3 "github.com/nomad-pixel/imperial/internal/domain/usecases/celebrity"
-	usecases5 "github.com/nomad-pixel/imperial/internal/domain/usecases/driver"
-	usecases4 "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	usecases6 "github.com/nomad-pixel/imperial/internal/domain/usecases/driver"
+	usecases5 "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	usecases4 "github.com/nomad-pixel/imperial/internal/domain/usecases/pricing"
+	usecases8 "github.com/nomad-pixel/imperial/internal/domain/usecases/review"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/auth"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/booking"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/car"
+	car6 "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
 	car5 "github.com/nomad-pixel/imperial/internal/interfaces/http/car/category"
 	car2 "github.com/nomad-pixel/imperial/internal/interfaces/http/car/image"
 	car4 "github.com/nomad-pixel/imperial/internal/interfaces/http/car/mark"
@@ -22,6 +27,9 @@ import (
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/celebrity"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/driver"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/lead"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/pricing"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/public"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/review"
 )
 
 // Injectors from wire.go:
@@ -40,15 +48,22 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	userRepository := ProvideUserRepository(pool)
 	signUpUsecase := usecases.NewSignUpUsecase(userRepository)
 	verifyCodeRepository := ProvideVerifyCodeRepository(pool)
-	emailService, err := ProvideEmailService(config)
-	if err != nil {
-		return nil, err
-	}
-	sendEmailVerificationUsecase := usecases.NewSendEmailVerificationUsecase(userRepository, verifyCodeRepository, emailService)
+	outboxRepository := ProvideOutboxRepository(pool)
+	transactor := ProvideTransactor(pool)
+	sendEmailVerificationUsecase := usecases.NewSendEmailVerificationUsecase(userRepository, verifyCodeRepository, outboxRepository, transactor)
 	confirmEmailVerificationUsecase := usecases.NewConfirmEmailVerificationUsecase(verifyCodeRepository, userRepository)
-	signInUsecase := usecases.NewSignInUsecase(userRepository, tokenService)
-	refreshTokenUsecase := usecases.NewRefreshTokenUsecase(tokenService)
-	authHandler := auth.NewAuthHandler(signUpUsecase, sendEmailVerificationUsecase, confirmEmailVerificationUsecase, signInUsecase, refreshTokenUsecase)
+	sessionRepository := ProvideSessionRepository(pool)
+	signInUsecase := usecases.NewSignInUsecase(userRepository, sessionRepository, tokenService)
+	refreshTokenUsecase := usecases.NewRefreshTokenUsecase(tokenService, userRepository, sessionRepository)
+	rateLimiter := ProvideRateLimiter()
+	forgotPasswordLimits := ProvideForgotPasswordLimits(config)
+	forgotPasswordUsecase := usecases.NewForgotPasswordUsecase(userRepository, verifyCodeRepository, outboxRepository, transactor, rateLimiter, forgotPasswordLimits)
+	resetPasswordLimits := ProvideResetPasswordLimits(config)
+	resetPasswordUsecase := usecases.NewResetPasswordUsecase(verifyCodeRepository, userRepository, sessionRepository, transactor, rateLimiter, resetPasswordLimits)
+	logoutUsecase := usecases.NewLogoutUsecase(tokenService, sessionRepository)
+	logoutAllUsecase := usecases.NewLogoutAllUsecase(sessionRepository)
+	changeUserRoleUsecase := usecases.NewChangeUserRoleUsecase(userRepository, sessionRepository, transactor)
+	authHandler := auth.NewAuthHandler(signUpUsecase, sendEmailVerificationUsecase, confirmEmailVerificationUsecase, signInUsecase, refreshTokenUsecase, forgotPasswordUsecase, resetPasswordUsecase, logoutUsecase, logoutAllUsecase, changeUserRoleUsecase)
 	carRepository := ProvideCarRepository(pool)
 	createCarUsecase := usecases2.NewCreateCarUsecase(carRepository)
 	carImageRepository := ProvideCarImageRepository(pool)
@@ -60,11 +75,19 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	updateCarUsecase := usecases2.NewUpdateCarUsecase(carRepository)
 	getCarByIdUsecase := usecases2.NewGetCarByIdUsecase(carRepository)
 	getListCarsUsecase := usecases2.NewGetListCarsUsecase(carRepository)
-	carHandler := car.NewCarHandler(createCarUsecase, deleteCarUsecase, updateCarUsecase, getCarByIdUsecase, getListCarsUsecase)
-	createCarImageUsecase := usecases2.NewCreateCarImageUsecase(carImageRepository, imageService)
+	searchCarsUsecase := usecases2.NewSearchCarsUsecase(carRepository)
+	getCarFacetsUsecase := usecases2.NewGetCarFacetsUsecase(carRepository)
+	carHandler := car.NewCarHandler(createCarUsecase, deleteCarUsecase, updateCarUsecase, getCarByIdUsecase, getListCarsUsecase, searchCarsUsecase, getCarFacetsUsecase)
+	imageUploadValidator, err := ProvideImageUploadValidator(config)
+	if err != nil {
+		return nil, err
+	}
+	createCarImageUsecase := usecases2.NewCreateCarImageUsecase(carImageRepository, imageService, imageUploadValidator)
 	deleteCarImageUsecase := usecases2.NewDeleteCarImageUsecase(carImageRepository, imageService)
 	getCarImagesListUsecase := usecases2.NewGetCarImagesListUsecase(carImageRepository)
-	carImageHandler := car2.NewCarImageHandler(createCarImageUsecase, deleteCarImageUsecase, getCarImagesListUsecase)
+	reorderCarImagesUsecase := usecases2.NewReorderCarImagesUsecase(carImageRepository)
+	setCarImageCoverUsecase := usecases2.NewSetCarImageCoverUsecase(carImageRepository)
+	carImageHandler := car2.NewCarImageHandler(createCarImageUsecase, deleteCarImageUsecase, getCarImagesListUsecase, reorderCarImagesUsecase, setCarImageCoverUsecase)
 	carTagRepository := ProvideCarTagRepository(pool)
 	createCarTagUsecase := usecases2.NewCreateCarTagUsecase(carTagRepository)
 	getCarTagUsecase := usecases2.NewGetCarTagUsecase(carTagRepository)
@@ -86,28 +109,100 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	updateCarCategoryUsecase := usecases2.NewUpdateCarCategoryUsecase(carCategoryRepository)
 	deleteCarCategoryUsecase := usecases2.NewDeleteCarCategoryUsecase(carCategoryRepository)
 	carCategoryHandler := car5.NewCarCategoryHandler(createCarCategoryUsecase, getCarCategoryUsecase, getCarCategoriesListUsecase, updateCarCategoryUsecase, deleteCarCategoryUsecase)
+	carAvailabilityRepository := ProvideCarAvailabilityRepository(pool)
+	getCarCalendarUsecase := usecases2.NewGetCarCalendarUsecase(carRepository, carAvailabilityRepository)
+	createCarMaintenanceBlockUsecase := usecases2.NewCreateCarMaintenanceBlockUsecase(carRepository, carAvailabilityRepository, transactor)
+	deleteCarMaintenanceBlockUsecase := usecases2.NewDeleteCarMaintenanceBlockUsecase(carAvailabilityRepository)
+	carAvailabilityHandler := car6.NewCarAvailabilityHandler(getCarCalendarUsecase, createCarMaintenanceBlockUsecase, deleteCarMaintenanceBlockUsecase)
 	celebrityRepository := ProvideCelebrityRepository(pool)
 	createCelebrityUsecase := usecases3.NewCreateCelebrityUsecase(celebrityRepository)
-	uploadCelebrityImageUsecase := usecases3.NewUploadCelebrityImageUsecase(celebrityRepository, imageService)
+	uploadCelebrityImageUsecase := usecases3.NewUploadCelebrityImageUsecase(celebrityRepository, imageService, imageUploadValidator)
 	getCelebrityByIdUsecase := usecases3.NewGetCelebrityByIdUsecase(celebrityRepository)
+	listFeaturedCelebritiesUsecase := usecases3.NewListFeaturedCelebritiesUsecase(celebrityRepository)
 	listCelebritiesUsecase := usecases3.NewListCelebritiesUsecase(celebrityRepository)
 	updateCelebrityUsecase := usecases3.NewUpdateCelebrityUsecase(celebrityRepository)
 	deleteCelebrityUsecase := usecases3.NewDeleteCelebrityUsecase(celebrityRepository, imageService)
-	celebrityHandler := celebrity.NewCelebrityHandler(createCelebrityUsecase, uploadCelebrityImageUsecase, getCelebrityByIdUsecase, listCelebritiesUsecase, updateCelebrityUsecase, deleteCelebrityUsecase)
+	celebrityCarRepository := ProvideCelebrityCarRepository(pool)
+	addCelebrityCarUsecase := usecases3.NewAddCelebrityCarUsecase(celebrityCarRepository)
+	updateCelebrityCarUsecase := usecases3.NewUpdateCelebrityCarUsecase(celebrityCarRepository)
+	deleteCelebrityCarUsecase := usecases3.NewDeleteCelebrityCarUsecase(celebrityCarRepository)
+	reorderCelebrityCarsUsecase := usecases3.NewReorderCelebrityCarsUsecase(celebrityCarRepository)
+	reorderCarCelebritiesUsecase := usecases3.NewReorderCarCelebritiesUsecase(celebrityCarRepository)
+	reorderFeaturedCelebritiesUsecase := usecases3.NewReorderFeaturedCelebritiesUsecase(celebrityRepository)
+	celebrityImageRepository := ProvideCelebrityImageRepository(pool)
+	addCelebrityImageUsecase := usecases3.NewAddCelebrityImageUsecase(celebrityImageRepository, imageService, imageUploadValidator)
+	deleteCelebrityImageUsecase := usecases3.NewDeleteCelebrityImageUsecase(celebrityImageRepository, imageService)
+	reorderCelebrityImagesUsecase := usecases3.NewReorderCelebrityImagesUsecase(celebrityImageRepository)
+	celebrityHandler := celebrity.NewCelebrityHandler(createCelebrityUsecase, uploadCelebrityImageUsecase, getCelebrityByIdUsecase, listCelebritiesUsecase, updateCelebrityUsecase, deleteCelebrityUsecase, addCelebrityCarUsecase, updateCelebrityCarUsecase, deleteCelebrityCarUsecase, reorderCelebrityCarsUsecase, reorderCarCelebritiesUsecase, reorderFeaturedCelebritiesUsecase, addCelebrityImageUsecase, deleteCelebrityImageUsecase, reorderCelebrityImagesUsecase)
 	leadRepository := ProvideLeadRepository(pool)
-	createLeadUsecase := usecases4.NewCreateLeadUsecase(leadRepository)
-	getLeadByIdUsecase := usecases4.NewGetLeadByIdUsecase(leadRepository)
-	listLeadsUsecase := usecases4.NewListLeadsUsecase(leadRepository)
-	deleteLeadUsecase := usecases4.NewDeleteLeadUsecase(leadRepository)
-	leadHandler := lead.NewLeadHandler(createLeadUsecase, getLeadByIdUsecase, listLeadsUsecase, deleteLeadUsecase)
+	ratePlanRepository := ProvideRatePlanRepository(pool)
+	location, err := ProvideBusinessLocation(config)
+	if err != nil {
+		return nil, err
+	}
+	getPriceQuoteUsecase := usecases4.NewGetPriceQuoteUsecase(carRepository, ratePlanRepository, location)
+	phoneRegion := ProvidePhoneRegion(config)
+	emailService, err := ProvideEmailService(config)
+	if err != nil {
+		return nil, err
+	}
+	staffNotifier, err := ProvideStaffNotifier(config, emailService)
+	if err != nil {
+		return nil, err
+	}
+	createLeadUsecase := usecases5.NewCreateLeadUsecase(leadRepository, getPriceQuoteUsecase, outboxRepository, staffNotifier, transactor, phoneRegion)
+	getLeadByIdUsecase := usecases5.NewGetLeadByIdUsecase(leadRepository)
+	listLeadsUsecase := usecases5.NewListLeadsUsecase(leadRepository)
+	deleteLeadUsecase := usecases5.NewDeleteLeadUsecase(leadRepository)
+	captchaVerifier := ProvideCaptchaVerifier(config)
+	submitLeadLimits := ProvideSubmitLeadLimits(config)
+	submitLeadUsecase := usecases5.NewSubmitLeadUsecase(createLeadUsecase, captchaVerifier, rateLimiter, submitLeadLimits, phoneRegion)
+	updateLeadStatusUsecase := usecases5.NewUpdateLeadStatusUsecase(leadRepository)
+	assignLeadUsecase := usecases5.NewAssignLeadUsecase(leadRepository, userRepository)
+	getLeadHistoryUsecase := usecases5.NewGetLeadHistoryUsecase(leadRepository)
+	leadNoteRepository := ProvideLeadNoteRepository(pool)
+	addLeadNoteUsecase := usecases5.NewAddLeadNoteUsecase(leadNoteRepository)
+	listLeadNotesUsecase := usecases5.NewListLeadNotesUsecase(leadRepository, leadNoteRepository)
+	listLeadDuplicatesUsecase := usecases5.NewListLeadDuplicatesUsecase(leadRepository)
+	mergeLeadsUsecase := usecases5.NewMergeLeadsUsecase(leadRepository, leadNoteRepository, transactor)
+	leadHandler := lead.NewLeadHandler(createLeadUsecase, getLeadByIdUsecase, listLeadsUsecase, deleteLeadUsecase, submitLeadUsecase, updateLeadStatusUsecase, assignLeadUsecase, getLeadHistoryUsecase, addLeadNoteUsecase, listLeadNotesUsecase, listLeadDuplicatesUsecase, mergeLeadsUsecase)
 	driverRepository := ProvideDriverRepository(pool)
-	createDriverUsecase := usecases5.NewCreateDriverUsecase(driverRepository)
-	getDriverByIdUsecase := usecases5.NewGetDriverByIdUsecase(driverRepository)
-	listDriversUsecase := usecases5.NewListDriversUsecase(driverRepository)
-	updateDriverUsecase := usecases5.NewUpdateDriverUsecase(driverRepository)
-	deleteDriverUsecase := usecases5.NewDeleteDriverUsecase(driverRepository)
-	uploadDriverPhotoUsecase := usecases5.NewUploadDriverPhotoUsecase(driverRepository, imageService)
-	driverHandler := driver.NewDriverHandler(createDriverUsecase, getDriverByIdUsecase, listDriversUsecase, updateDriverUsecase, deleteDriverUsecase, uploadDriverPhotoUsecase)
-	app := NewApp(config, pool, tokenService, authHandler, carHandler, carImageHandler, carTagHandler, carMarkHandler, carCategoryHandler, celebrityHandler, leadHandler, driverHandler)
+	createDriverUsecase := usecases6.NewCreateDriverUsecase(driverRepository)
+	getDriverByIdUsecase := usecases6.NewGetDriverByIdUsecase(driverRepository)
+	listDriversUsecase := usecases6.NewListDriversUsecase(driverRepository)
+	updateDriverUsecase := usecases6.NewUpdateDriverUsecase(driverRepository)
+	deleteDriverUsecase := usecases6.NewDeleteDriverUsecase(driverRepository)
+	uploadDriverPhotoUsecase := usecases6.NewUploadDriverPhotoUsecase(driverRepository, imageService, imageUploadValidator)
+	getDriverScheduleUsecase := usecases6.NewGetDriverScheduleUsecase(driverRepository)
+	setDriverScheduleUsecase := usecases6.NewSetDriverScheduleUsecase(driverRepository)
+	getDriverCalendarUsecase := usecases6.NewGetDriverCalendarUsecase(driverRepository)
+	createDriverDayOffUsecase := usecases6.NewCreateDriverDayOffUsecase(driverRepository)
+	deleteDriverDayOffUsecase := usecases6.NewDeleteDriverDayOffUsecase(driverRepository)
+	listAvailableDriversUsecase := usecases6.NewListAvailableDriversUsecase(driverRepository, location)
+	driverHandler := driver.NewDriverHandler(createDriverUsecase, getDriverByIdUsecase, listDriversUsecase, updateDriverUsecase, deleteDriverUsecase, uploadDriverPhotoUsecase, getDriverScheduleUsecase, setDriverScheduleUsecase, getDriverCalendarUsecase, createDriverDayOffUsecase, deleteDriverDayOffUsecase, listAvailableDriversUsecase)
+	reviewRepository := ProvideReviewRepository(pool)
+	listReviewsUsecase := usecases8.NewListReviewsUsecase(reviewRepository)
+	publicHandler := public.NewPublicHandler(getListCarsUsecase, getCarByIdUsecase, getCarMarksListUsecase, getCarCategoriesListUsecase, getCarTagsListUsecase, listDriversUsecase, getDriverByIdUsecase, listCelebritiesUsecase, getCelebrityByIdUsecase, listFeaturedCelebritiesUsecase, getCarCalendarUsecase, searchCarsUsecase, getCarFacetsUsecase, listReviewsUsecase, imageService)
+	bookingRepository := ProvideBookingRepository(pool)
+	createBookingUsecase := usecases7.NewCreateBookingUsecase(bookingRepository, carRepository, driverRepository, leadRepository, carAvailabilityRepository, getPriceQuoteUsecase, transactor, location)
+	getBookingByIdUsecase := usecases7.NewGetBookingByIdUsecase(bookingRepository)
+	listBookingsUsecase := usecases7.NewListBookingsUsecase(bookingRepository)
+	updateBookingStatusUsecase := usecases7.NewUpdateBookingStatusUsecase(bookingRepository)
+	assignBookingDriverUsecase := usecases7.NewAssignBookingDriverUsecase(bookingRepository, carRepository, driverRepository, location)
+	bookingHandler := booking.NewBookingHandler(createBookingUsecase, getBookingByIdUsecase, listBookingsUsecase, updateBookingStatusUsecase, assignBookingDriverUsecase)
+	createRatePlanUsecase := usecases4.NewCreateRatePlanUsecase(ratePlanRepository)
+	getRatePlanByIdUsecase := usecases4.NewGetRatePlanByIdUsecase(ratePlanRepository)
+	listRatePlansUsecase := usecases4.NewListRatePlansUsecase(ratePlanRepository)
+	updateRatePlanUsecase := usecases4.NewUpdateRatePlanUsecase(ratePlanRepository)
+	deleteRatePlanUsecase := usecases4.NewDeleteRatePlanUsecase(ratePlanRepository)
+	pricingHandler := pricing.NewPricingHandler(createRatePlanUsecase, getRatePlanByIdUsecase, listRatePlansUsecase, updateRatePlanUsecase, deleteRatePlanUsecase, getPriceQuoteUsecase)
+	submitReviewLimits := ProvideSubmitReviewLimits(config)
+	submitReviewUsecase := usecases8.NewSubmitReviewUsecase(reviewRepository, bookingRepository, imageService, imageUploadValidator, captchaVerifier, rateLimiter, submitReviewLimits, phoneRegion)
+	getReviewByIdUsecase := usecases8.NewGetReviewByIdUsecase(reviewRepository)
+	moderateReviewUsecase := usecases8.NewModerateReviewUsecase(reviewRepository)
+	deleteReviewUsecase := usecases8.NewDeleteReviewUsecase(reviewRepository, imageService)
+	reviewHandler := review.NewReviewHandler(submitReviewUsecase, listReviewsUsecase, getReviewByIdUsecase, moderateReviewUsecase, deleteReviewUsecase)
+	dispatcher := ProvideOutboxDispatcher(config, outboxRepository, emailService, staffNotifier)
+	app := NewApp(config, pool, tokenService, authHandler, carHandler, carImageHandler, carTagHandler, carMarkHandler, carCategoryHandler, carAvailabilityHandler, celebrityHandler, leadHandler, driverHandler, publicHandler, bookingHandler, pricingHandler, reviewHandler, dispatcher)
 	return app, nil
 }
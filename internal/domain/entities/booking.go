@@ -0,0 +1,156 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type BookingStatus string
+
+const (
+	BookingStatusPending   BookingStatus = "pending"
+	BookingStatusConfirmed BookingStatus = "confirmed"
+	BookingStatusActive    BookingStatus = "active"
+	BookingStatusCompleted BookingStatus = "completed"
+	BookingStatusCancelled BookingStatus = "cancelled"
+)
+
+// bookingTransitions lists the statuses each status may move to.
+// Completed and cancelled bookings are final.
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending:   {BookingStatusConfirmed, BookingStatusCancelled},
+	BookingStatusConfirmed: {BookingStatusActive, BookingStatusCancelled},
+	BookingStatusActive:    {BookingStatusCompleted},
+}
+
+func (s BookingStatus) IsValid() bool {
+	switch s {
+	case BookingStatusPending, BookingStatusConfirmed, BookingStatusActive, BookingStatusCompleted, BookingStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+type Booking struct {
+	ID            int64         `json:"id"`
+	CarID         int64         `json:"car_id"`
+	DriverID      *int64        `json:"driver_id,omitempty"`
+	LeadID        *int64        `json:"lead_id,omitempty"`
+	CustomerName  string        `json:"customer_name"`
+	CustomerPhone string        `json:"customer_phone"`
+	StartDate     time.Time     `json:"start_date"`
+	EndDate       time.Time     `json:"end_date"`
+	Status        BookingStatus `json:"status"`
+	Comment       string        `json:"comment"`
+	TotalPrice    int64         `json:"total_price"`
+	PriceQuote    *PriceQuote   `json:"price_quote,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+func NewBooking(carID int64, driverID, leadID *int64, customerName, customerPhone string, startDate, endDate time.Time, comment string) (*Booking, error) {
+	now := time.Now()
+	booking := &Booking{
+		CarID:         carID,
+		DriverID:      driverID,
+		LeadID:        leadID,
+		CustomerName:  strings.TrimSpace(customerName),
+		CustomerPhone: strings.TrimSpace(customerPhone),
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Status:        BookingStatusPending,
+		Comment:       strings.TrimSpace(comment),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := booking.validateFields(); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (b *Booking) Validate() error {
+	if b.ID <= 0 {
+		return errors.New("invalid booking ID")
+	}
+	if !b.Status.IsValid() {
+		return errors.New("invalid booking status")
+	}
+	return b.validateFields()
+}
+
+func (b *Booking) validateFields() error {
+	if b.CarID <= 0 {
+		return errors.New("car is required")
+	}
+	if b.DriverID != nil && *b.DriverID <= 0 {
+		return errors.New("invalid driver ID")
+	}
+	if b.LeadID != nil && *b.LeadID <= 0 {
+		return errors.New("invalid lead ID")
+	}
+
+	if len(b.CustomerName) < 2 || len(b.CustomerName) > 100 {
+		return errors.New("customer name must be between 2 and 100 characters")
+	}
+	if b.CustomerPhone == "" || len(b.CustomerPhone) > 32 {
+		return errors.New("customer phone is invalid")
+	}
+
+	if b.StartDate.IsZero() || b.EndDate.IsZero() {
+		return errors.New("dates cannot be zero")
+	}
+	if !b.EndDate.After(b.StartDate) {
+		return errors.New("end date must be after start date")
+	}
+
+	if len(b.Comment) > 1000 {
+		return errors.New("comment cannot exceed 1000 characters")
+	}
+
+	return nil
+}
+
+// TransitionTo moves the booking to the next status if the lifecycle allows it
+func (b *Booking) TransitionTo(next BookingStatus) error {
+	if !next.IsValid() {
+		return fmt.Errorf("unknown booking status %q", next)
+	}
+	if !b.Status.CanTransitionTo(next) {
+		return fmt.Errorf("booking cannot move from %s to %s", b.Status, next)
+	}
+
+	b.Status = next
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+func (b *Booking) IsFinal() bool {
+	return b.Status == BookingStatusCompleted || b.Status == BookingStatusCancelled
+}
+
+// SetQuote stores the agreed price snapshot on the booking
+func (b *Booking) SetQuote(quote *PriceQuote) {
+	b.PriceQuote = quote
+	b.TotalPrice = quote.Total
+	b.UpdatedAt = time.Now()
+}
+
+// AssignDriver sets the chauffeur of the booking; nil removes the current one
+func (b *Booking) AssignDriver(driverID *int64) {
+	b.DriverID = driverID
+	b.UpdatedAt = time.Now()
+}
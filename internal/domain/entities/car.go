@@ -6,20 +6,30 @@ import (
 	"time"
 )
 
+// Car is a rental car. Cover is the image shown on the listing card; car
+// lists load only the cover and leave Images and RentedBy empty.
+// EffectivePricePerDay is the short daily rate of the car's rate plan, or
+// PricePerDay for cars without one; it is read-only and is what car lists
+// filter and sort by.
 type Car struct {
-	ID             int64        `json:"id"`
-	Name           string       `json:"name"`
-	OnlyWithDriver bool         `json:"only_with_driver"`
-	PricePerDay    int64        `json:"price_per_day"`
-	Tags           []*CarTag    `json:"tags"`
-	Mark           *CarMark     `json:"mark"`
-	Category       *CarCategory `json:"category"`
-	Images         []*CarImage  `json:"images"`
-	CreatedAt      time.Time    `json:"created_at"`
-	UpdatedAt      time.Time    `json:"updated_at"`
+	ID                   int64         `json:"id"`
+	Name                 string        `json:"name"`
+	OnlyWithDriver       bool          `json:"only_with_driver"`
+	PricePerDay          int64         `json:"price_per_day"`
+	EffectivePricePerDay int64         `json:"effective_price_per_day"`
+	Tags                 []*CarTag     `json:"tags"`
+	Mark                 *CarMark      `json:"mark"`
+	Category             *CarCategory  `json:"category"`
+	Specs                CarSpecs      `json:"specs"`
+	Images               []*CarImage   `json:"images"`
+	Cover                *CarImage     `json:"cover"`
+	Rating               RatingSummary `json:"rating"`
+	RentedBy             []*CarRenter  `json:"rented_by"`
+	CreatedAt            time.Time     `json:"created_at"`
+	UpdatedAt            time.Time     `json:"updated_at"`
 }
 
-func NewCar(name string, pricePerDay int64, markID, categoryID int64, onlyWithDriver bool) (*Car, error) {
+func NewCar(name string, pricePerDay int64, markID, categoryID int64, onlyWithDriver bool, specs CarSpecs) (*Car, error) {
 	name = strings.TrimSpace(name)
 
 	if name == "" {
@@ -46,6 +56,10 @@ func NewCar(name string, pricePerDay int64, markID, categoryID int64, onlyWithDr
 		return nil, errors.New("category ID must be positive")
 	}
 
+	if err := specs.Validate(); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	return &Car{
 		Name:           name,
@@ -53,6 +67,7 @@ func NewCar(name string, pricePerDay int64, markID, categoryID int64, onlyWithDr
 		OnlyWithDriver: onlyWithDriver,
 		Mark:           &CarMark{ID: markID},
 		Category:       &CarCategory{ID: categoryID},
+		Specs:          specs,
 		Tags:           make([]*CarTag, 0),
 		Images:         make([]*CarImage, 0),
 		CreatedAt:      now,
@@ -86,7 +101,7 @@ func (c *Car) Validate() error {
 		return errors.New("car must have a valid category")
 	}
 
-	return nil
+	return c.Specs.Validate()
 }
 
 func (c *Car) SetName(name string) error {
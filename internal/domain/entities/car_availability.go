@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// CarMaintenanceBlock takes a car out of service for a period, e.g. for
+// repairs or detailing. Like bookings, the period is half-open [start, end).
+type CarMaintenanceBlock struct {
+	ID        int64     `json:"id"`
+	CarID     int64     `json:"car_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewCarMaintenanceBlock(carID int64, startDate, endDate time.Time, reason string) (*CarMaintenanceBlock, error) {
+	if carID <= 0 {
+		return nil, errors.New("car is required")
+	}
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.New("dates cannot be zero")
+	}
+	if !endDate.After(startDate) {
+		return nil, errors.New("end date must be after start date")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if len(reason) > 255 {
+		return nil, errors.New("reason cannot exceed 255 characters")
+	}
+
+	return &CarMaintenanceBlock{
+		CarID:     carID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+type BusyIntervalKind string
+
+const (
+	BusyIntervalKindBooking     BusyIntervalKind = "booking"
+	BusyIntervalKindMaintenance BusyIntervalKind = "maintenance"
+	BusyIntervalKindDayOff      BusyIntervalKind = "day_off"
+)
+
+type BusyInterval struct {
+	Start time.Time        `json:"start"`
+	End   time.Time        `json:"end"`
+	Kind  BusyIntervalKind `json:"kind"`
+}
+
+type CarCalendar struct {
+	CarID int64           `json:"car_id"`
+	From  time.Time       `json:"from"`
+	To    time.Time       `json:"to"`
+	Busy  []*BusyInterval `json:"busy"`
+}
@@ -0,0 +1,28 @@
+package entities
+
+type FacetCount struct {
+	ID    int64  `json:"id" example:"1"`
+	Name  string `json:"name" example:"Mercedes-Benz"`
+	Count int64  `json:"count" example:"12"`
+}
+
+type PriceRange struct {
+	Min int64 `json:"min" example:"15000"`
+	Max int64 `json:"max" example:"250000"`
+}
+
+type DriverFacet struct {
+	WithDriver int64 `json:"with_driver" example:"4"`
+	SelfDrive  int64 `json:"self_drive" example:"18"`
+}
+
+// CarFacets summarises the cars matching a list filter so the catalog can
+// show how many results each refinement would give. Each facet ignores its
+// own filter, so the other options stay visible once one is picked.
+type CarFacets struct {
+	Marks      []FacetCount `json:"marks"`
+	Categories []FacetCount `json:"categories"`
+	Tags       []FacetCount `json:"tags"`
+	Price      PriceRange   `json:"price"`
+	Driver     DriverFacet  `json:"driver"`
+}
@@ -3,8 +3,11 @@ package entities
 import "time"
 
 type CarImage struct {
-	ID        int64     `json:"id"`
-	CarID     int64     `json:"car_id"`
-	ImagePath string    `json:"image_path"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        int64          `json:"id"`
+	CarID     int64          `json:"car_id"`
+	ImagePath string         `json:"image_path"`
+	Variants  []ImageVariant `json:"variants"`
+	Position  int            `json:"position"`
+	IsCover   bool           `json:"is_cover"`
+	CreatedAt time.Time      `json:"created_at"`
 }
@@ -0,0 +1,10 @@
+package entities
+
+// CarSearchResult is a car matched by full-text search together with its
+// relevance and an HTML-escaped snippet with the matched words wrapped in
+// <mark> tags.
+type CarSearchResult struct {
+	Car       *Car    `json:"car"`
+	Rank      float64 `json:"rank"`
+	Highlight string  `json:"highlight"`
+}
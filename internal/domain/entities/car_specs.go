@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type CarTransmission string
+
+const (
+	CarTransmissionAutomatic CarTransmission = "automatic"
+	CarTransmissionManual    CarTransmission = "manual"
+	CarTransmissionRobot     CarTransmission = "robot"
+	CarTransmissionCVT       CarTransmission = "cvt"
+)
+
+func (t CarTransmission) IsValid() bool {
+	switch t {
+	case CarTransmissionAutomatic, CarTransmissionManual, CarTransmissionRobot, CarTransmissionCVT:
+		return true
+	}
+	return false
+}
+
+type CarFuelType string
+
+const (
+	CarFuelTypePetrol   CarFuelType = "petrol"
+	CarFuelTypeDiesel   CarFuelType = "diesel"
+	CarFuelTypeHybrid   CarFuelType = "hybrid"
+	CarFuelTypeElectric CarFuelType = "electric"
+)
+
+func (f CarFuelType) IsValid() bool {
+	switch f {
+	case CarFuelTypePetrol, CarFuelTypeDiesel, CarFuelTypeHybrid, CarFuelTypeElectric:
+		return true
+	}
+	return false
+}
+
+type CarDriveType string
+
+const (
+	CarDriveTypeFWD CarDriveType = "fwd"
+	CarDriveTypeRWD CarDriveType = "rwd"
+	CarDriveTypeAWD CarDriveType = "awd"
+)
+
+func (d CarDriveType) IsValid() bool {
+	switch d {
+	case CarDriveTypeFWD, CarDriveTypeRWD, CarDriveTypeAWD:
+		return true
+	}
+	return false
+}
+
+const minCarYear = 1900
+
+// CarSpecs holds the technical specification of a car. Every field is
+// optional: nil means the value is unknown and is not shown in the catalog.
+type CarSpecs struct {
+	Year         *int64           `json:"year,omitempty" example:"2023"`
+	Seats        *int64           `json:"seats,omitempty" example:"5"`
+	Transmission *CarTransmission `json:"transmission,omitempty" example:"automatic"`
+	FuelType     *CarFuelType     `json:"fuel_type,omitempty" example:"petrol"`
+	EngineVolume *float64         `json:"engine_volume,omitempty" example:"4.0"`
+	Horsepower   *int64           `json:"horsepower,omitempty" example:"585"`
+	Acceleration *float64         `json:"acceleration,omitempty" example:"3.8"`
+	Color        *string          `json:"color,omitempty" example:"black"`
+	DriveType    *CarDriveType    `json:"drive_type,omitempty" example:"awd"`
+}
+
+func (s *CarSpecs) Validate() error {
+	if s.Year != nil && (*s.Year < minCarYear || *s.Year > int64(time.Now().Year()+1)) {
+		return errors.New("car year is out of range")
+	}
+
+	if s.Seats != nil && (*s.Seats < 1 || *s.Seats > 60) {
+		return errors.New("seats must be between 1 and 60")
+	}
+
+	if s.Transmission != nil && !s.Transmission.IsValid() {
+		return errors.New("invalid transmission")
+	}
+
+	if s.FuelType != nil && !s.FuelType.IsValid() {
+		return errors.New("invalid fuel type")
+	}
+
+	if s.EngineVolume != nil && (*s.EngineVolume <= 0 || *s.EngineVolume > 20) {
+		return errors.New("engine volume must be between 0 and 20 liters")
+	}
+
+	if s.Horsepower != nil && (*s.Horsepower <= 0 || *s.Horsepower > 3000) {
+		return errors.New("horsepower must be between 1 and 3000")
+	}
+
+	if s.Acceleration != nil && (*s.Acceleration <= 0 || *s.Acceleration > 60) {
+		return errors.New("acceleration must be between 0 and 60 seconds")
+	}
+
+	if s.Color != nil {
+		color := strings.TrimSpace(*s.Color)
+		if color == "" || len(color) > 50 {
+			return errors.New("color must be between 1 and 50 characters")
+		}
+		s.Color = &color
+	}
+
+	if s.DriveType != nil && !s.DriveType.IsValid() {
+		return errors.New("invalid drive type")
+	}
+
+	return nil
+}
@@ -2,16 +2,53 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"strings"
 	"time"
 )
 
+const (
+	MaxCelebritySocialLinks   = 10
+	maxCelebrityBioLen        = 2000
+	maxCelebrityProfessionLen = 200
+	maxSocialNetworkLen       = 50
+)
+
+// SocialLink is a public profile of a celebrity, e.g. Instagram
+type SocialLink struct {
+	Network string `json:"network" example:"instagram"`
+	URL     string `json:"url" example:"https://instagram.com/johndoe"`
+}
+
+// CelebrityImage is an extra photo in the gallery of a celebrity. The main
+// photo is Celebrity.Image.
+type CelebrityImage struct {
+	ID          int64          `json:"id"`
+	CelebrityID int64          `json:"celebrity_id"`
+	ImagePath   string         `json:"image_path"`
+	Variants    []ImageVariant `json:"variants"`
+	Position    int            `json:"position"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// Celebrity is a famous client shown on the marketing site. Featured
+// celebrities appear in the homepage carousel sorted by DisplayOrder. Images
+// and Cars are only loaded for a single celebrity.
 type Celebrity struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Image     string    `json:"image"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            int64             `json:"id"`
+	Name          string            `json:"name"`
+	Profession    string            `json:"profession"`
+	Bio           string            `json:"bio"`
+	SocialLinks   []SocialLink      `json:"social_links"`
+	IsFeatured    bool              `json:"is_featured"`
+	DisplayOrder  int               `json:"display_order"`
+	Image         string            `json:"image"`
+	ImageVariants []ImageVariant    `json:"image_variants"`
+	Images        []*CelebrityImage `json:"images"`
+	Cars          []*RentedCar      `json:"cars"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
 }
 
 func NewCelebrity(name string) (*Celebrity, error) {
@@ -31,10 +68,11 @@ func NewCelebrity(name string) (*Celebrity, error) {
 
 	now := time.Now()
 	return &Celebrity{
-		Name:      name,
-		Image:     "",
-		CreatedAt: now,
-		UpdatedAt: now,
+		Name:        name,
+		Image:       "",
+		SocialLinks: make([]SocialLink, 0),
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}, nil
 }
 
@@ -75,3 +113,47 @@ func (c *Celebrity) SetImage(imageURL string) {
 	c.Image = imageURL
 	c.UpdatedAt = time.Now()
 }
+
+// SetProfile replaces the marketing profile. Social networks are stored in
+// lower case and every link must be an absolute http(s) URL.
+func (c *Celebrity) SetProfile(profession, bio string, links []SocialLink) error {
+	profession = strings.TrimSpace(profession)
+	if len(profession) > maxCelebrityProfessionLen {
+		return fmt.Errorf("profession cannot exceed %d characters", maxCelebrityProfessionLen)
+	}
+
+	bio = strings.TrimSpace(bio)
+	if len(bio) > maxCelebrityBioLen {
+		return fmt.Errorf("bio cannot exceed %d characters", maxCelebrityBioLen)
+	}
+
+	if len(links) > MaxCelebritySocialLinks {
+		return fmt.Errorf("a celebrity can have at most %d social links", MaxCelebritySocialLinks)
+	}
+	normalized := make([]SocialLink, 0, len(links))
+	for _, link := range links {
+		network := strings.ToLower(strings.TrimSpace(link.Network))
+		if network == "" || len(network) > maxSocialNetworkLen {
+			return fmt.Errorf("social network must be between 1 and %d characters", maxSocialNetworkLen)
+		}
+		rawURL := strings.TrimSpace(link.URL)
+		parsed, err := url.ParseRequestURI(rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid %s link", network)
+		}
+		normalized = append(normalized, SocialLink{Network: network, URL: rawURL})
+	}
+
+	c.Profession = profession
+	c.Bio = bio
+	c.SocialLinks = normalized
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetFeatured adds the celebrity to the homepage carousel or removes it.
+// The repository places newly featured celebrities at the end.
+func (c *Celebrity) SetFeatured(featured bool) {
+	c.IsFeatured = featured
+	c.UpdatedAt = time.Now()
+}
@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const maxCelebrityCarQuoteLen = 500
+
+// CelebrityCar links a celebrity to a car they rented. Quote is what they said
+// about the car; RentedAt is the date of the rental when it may be published.
+type CelebrityCar struct {
+	CelebrityID int64      `json:"celebrity_id"`
+	CarID       int64      `json:"car_id"`
+	Quote       string     `json:"quote"`
+	RentedAt    *time.Time `json:"rented_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func NewCelebrityCar(celebrityID, carID int64, quote string, rentedAt *time.Time) (*CelebrityCar, error) {
+	if celebrityID <= 0 {
+		return nil, errors.New("celebrity is required")
+	}
+	if carID <= 0 {
+		return nil, errors.New("car is required")
+	}
+
+	now := time.Now()
+	link := &CelebrityCar{
+		CelebrityID: celebrityID,
+		CarID:       carID,
+		CreatedAt:   now,
+	}
+	if err := link.Update(quote, rentedAt); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// Update replaces the quote and the rental date. The date is kept without
+// the time of day.
+func (l *CelebrityCar) Update(quote string, rentedAt *time.Time) error {
+	quote = strings.TrimSpace(quote)
+	if len(quote) > maxCelebrityCarQuoteLen {
+		return errors.New("quote cannot exceed 500 characters")
+	}
+
+	if rentedAt != nil {
+		date := truncateToDate(*rentedAt)
+		if date.After(time.Now()) {
+			return errors.New("rental date cannot be in the future")
+		}
+		rentedAt = &date
+	}
+
+	l.Quote = quote
+	l.RentedAt = rentedAt
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+// RentedCar is a car on a celebrity profile ("Their choice")
+type RentedCar struct {
+	CarID    int64      `json:"car_id"`
+	Name     string     `json:"name"`
+	Cover    *CarImage  `json:"cover"`
+	Quote    string     `json:"quote"`
+	RentedAt *time.Time `json:"rented_at,omitempty"`
+	Position int        `json:"position"`
+}
+
+// CarRenter is a celebrity on a car page ("Rented by")
+type CarRenter struct {
+	CelebrityID   int64          `json:"celebrity_id"`
+	Name          string         `json:"name"`
+	Image         string         `json:"image"`
+	ImageVariants []ImageVariant `json:"image_variants"`
+	Quote         string         `json:"quote"`
+	RentedAt      *time.Time     `json:"rented_at,omitempty"`
+	Position      int            `json:"position"`
+}
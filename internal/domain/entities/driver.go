@@ -6,14 +6,19 @@ import (
 	"time"
 )
 
+// Driver is a chauffeur profile. ExperienceYears is the free-text blurb shown
+// on the site; Qualifications holds the structured data drivers are matched by.
 type Driver struct {
-	ID              int64     `json:"id"`
-	FullName        string    `json:"full_name"`
-	About           string    `json:"about"`
-	PhotoURL        string    `json:"photo_url"`
-	ExperienceYears string    `json:"experience_years"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              int64                `json:"id"`
+	FullName        string               `json:"full_name"`
+	About           string               `json:"about"`
+	PhotoURL        string               `json:"photo_url"`
+	PhotoVariants   []ImageVariant       `json:"photo_variants"`
+	ExperienceYears string               `json:"experience_years"`
+	Qualifications  DriverQualifications `json:"qualifications"`
+	Rating          RatingSummary        `json:"rating"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
 }
 
 func NewDriver(fullName, about, experienceYears string) (*Driver, error) {
@@ -49,12 +54,18 @@ func NewDriver(fullName, about, experienceYears string) (*Driver, error) {
 		return nil, errors.New("experience years cannot exceed 300 characters")
 	}
 
+	var qualifications DriverQualifications
+	if err := qualifications.Normalize(); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	return &Driver{
 		FullName:        fullName,
 		About:           about,
 		ExperienceYears: experienceYears,
 		PhotoURL:        "",
+		Qualifications:  qualifications,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}, nil
@@ -119,7 +130,23 @@ func (d *Driver) SetExperienceYears(experienceYears string) error {
 	return nil
 }
 
+func (d *Driver) SetQualifications(qualifications DriverQualifications) error {
+	if err := qualifications.Normalize(); err != nil {
+		return err
+	}
+
+	d.Qualifications = qualifications
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
 func (d *Driver) SetPhotoURL(photoURL string) {
 	d.PhotoURL = photoURL
 	d.UpdatedAt = time.Now()
 }
+
+func (d *Driver) SetPhoto(photoURL string, variants []ImageVariant) {
+	d.PhotoURL = photoURL
+	d.PhotoVariants = variants
+	d.UpdatedAt = time.Now()
+}
@@ -0,0 +1,181 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DriverShift is a driver's working hours on one weekday. Times are HH:MM
+// local time of the business timezone; EndTime may be 24:00 for a shift that
+// runs until midnight.
+type DriverShift struct {
+	Weekday   time.Weekday `json:"weekday" swaggertype:"integer" example:"1"`
+	StartTime string       `json:"start_time" example:"09:00"`
+	EndTime   string       `json:"end_time" example:"21:00"`
+}
+
+func (s DriverShift) minutes() (start, end int, err error) {
+	if start, err = parseShiftTime(s.StartTime); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseShiftTime(s.EndTime); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseShiftTime converts HH:MM into minutes since midnight
+func parseShiftTime(value string) (int, error) {
+	var hours, minutes int
+	if len(value) != 5 || value[2] != ':' {
+		return 0, fmt.Errorf("time %q must be in HH:MM format", value)
+	}
+	if _, err := fmt.Sscanf(value, "%02d:%02d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("time %q must be in HH:MM format", value)
+	}
+	if hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > 24*60 {
+		return 0, fmt.Errorf("time %q is out of range", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+// DriverSchedule is the weekly working schedule of a driver. Weekdays without
+// a shift are days off. A driver without any shifts has no schedule set and
+// is treated as working every day.
+type DriverSchedule struct {
+	DriverID int64         `json:"driver_id"`
+	Shifts   []DriverShift `json:"shifts"`
+}
+
+func NewDriverSchedule(driverID int64, shifts []DriverShift) (*DriverSchedule, error) {
+	if driverID <= 0 {
+		return nil, errors.New("driver is required")
+	}
+
+	seen := make(map[time.Weekday]bool, len(shifts))
+	normalized := make([]DriverShift, 0, len(shifts))
+	for _, shift := range shifts {
+		if shift.Weekday < time.Sunday || shift.Weekday > time.Saturday {
+			return nil, fmt.Errorf("weekday %d must be between 0 (Sunday) and 6 (Saturday)", shift.Weekday)
+		}
+		if seen[shift.Weekday] {
+			return nil, fmt.Errorf("%s has more than one shift", shift.Weekday)
+		}
+		seen[shift.Weekday] = true
+
+		shift.StartTime = strings.TrimSpace(shift.StartTime)
+		shift.EndTime = strings.TrimSpace(shift.EndTime)
+		start, end, err := shift.minutes()
+		if err != nil {
+			return nil, err
+		}
+		if end <= start {
+			return nil, fmt.Errorf("%s shift must end after it starts", shift.Weekday)
+		}
+		normalized = append(normalized, shift)
+	}
+
+	return &DriverSchedule{
+		DriverID: driverID,
+		Shifts:   normalized,
+	}, nil
+}
+
+func (s *DriverSchedule) IsEmpty() bool {
+	return s == nil || len(s.Shifts) == 0
+}
+
+func (s *DriverSchedule) shiftOn(weekday time.Weekday) (DriverShift, bool) {
+	for _, shift := range s.Shifts {
+		if shift.Weekday == weekday {
+			return shift, true
+		}
+	}
+	return DriverShift{}, false
+}
+
+// Covers reports whether the driver works during [start, end). Every day the
+// period touches has to be a working day. A period within a single day, such
+// as a transfer, also has to fit into that day's shift; longer rentals are
+// only checked by day since the driver is not on duty around the clock.
+// Days and shift times are read in loc, the business timezone; a nil loc
+// means UTC.
+func (s *DriverSchedule) Covers(start, end time.Time, loc *time.Location) bool {
+	if s.IsEmpty() {
+		return true
+	}
+	if !end.After(start) {
+		return false
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	firstDay := dateIn(start, loc)
+	for day := firstDay; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if _, ok := s.shiftOn(day.Weekday()); !ok {
+			return false
+		}
+	}
+
+	if !end.After(firstDay.AddDate(0, 0, 1)) {
+		shift, _ := s.shiftOn(firstDay.Weekday())
+		shiftStart, shiftEnd, err := shift.minutes()
+		if err != nil {
+			return false
+		}
+		startMinute := int(start.Sub(firstDay).Minutes())
+		endMinute := int(end.Sub(firstDay).Minutes())
+		return startMinute >= shiftStart && endMinute <= shiftEnd
+	}
+
+	return true
+}
+
+// DriverDayOff takes a driver off duty for a period, e.g. vacation or sick
+// leave. Like bookings, the period is half-open [start, end).
+type DriverDayOff struct {
+	ID        int64     `json:"id"`
+	DriverID  int64     `json:"driver_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewDriverDayOff(driverID int64, startDate, endDate time.Time, reason string) (*DriverDayOff, error) {
+	if driverID <= 0 {
+		return nil, errors.New("driver is required")
+	}
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.New("dates cannot be zero")
+	}
+	if !endDate.After(startDate) {
+		return nil, errors.New("end date must be after start date")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if len(reason) > 255 {
+		return nil, errors.New("reason cannot exceed 255 characters")
+	}
+
+	return &DriverDayOff{
+		DriverID:  driverID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// DriverCalendar shows when a driver works and when they are already taken
+// by bookings or days off.
+type DriverCalendar struct {
+	DriverID int64           `json:"driver_id"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Shifts   []DriverShift   `json:"shifts"`
+	Busy     []*BusyInterval `json:"busy"`
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDriverScheduleCoversShiftBoundariesInBusinessTimezone(t *testing.T) {
+	almaty := time.FixedZone("Asia/Almaty", 5*60*60)
+	schedule, err := NewDriverSchedule(1, []DriverShift{
+		{Weekday: time.Monday, StartTime: "09:00", EndTime: "21:00"},
+	})
+	if err != nil {
+		t.Fatalf("NewDriverSchedule: %v", err)
+	}
+
+	// Periods come in as UTC, the way the API receives them; 2024-06-03 is a
+	// Monday and 09:00 in Almaty is 04:00 UTC
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  bool
+	}{
+		{
+			name:  "starts when the shift starts",
+			start: time.Date(2024, time.June, 3, 4, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, time.June, 3, 5, 0, 0, 0, time.UTC),
+			want:  true,
+		},
+		{
+			name:  "ends when the shift ends",
+			start: time.Date(2024, time.June, 3, 15, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, time.June, 3, 16, 0, 0, 0, time.UTC),
+			want:  true,
+		},
+		{
+			name:  "starts before the shift",
+			start: time.Date(2024, time.June, 3, 3, 30, 0, 0, time.UTC),
+			end:   time.Date(2024, time.June, 3, 5, 0, 0, 0, time.UTC),
+			want:  false,
+		},
+		{
+			name:  "ends after the shift",
+			start: time.Date(2024, time.June, 3, 15, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, time.June, 3, 16, 30, 0, 0, time.UTC),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.Covers(tt.start, tt.end, almaty); got != tt.want {
+				t.Errorf("Covers = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DriverLicenseCategory is a driving license category as printed on
+// Kazakhstan and Russian licenses.
+type DriverLicenseCategory string
+
+const (
+	DriverLicenseCategoryA   DriverLicenseCategory = "A"
+	DriverLicenseCategoryB   DriverLicenseCategory = "B"
+	DriverLicenseCategoryBE  DriverLicenseCategory = "BE"
+	DriverLicenseCategoryC   DriverLicenseCategory = "C"
+	DriverLicenseCategoryCE  DriverLicenseCategory = "CE"
+	DriverLicenseCategoryD   DriverLicenseCategory = "D"
+	DriverLicenseCategoryDE  DriverLicenseCategory = "DE"
+	DriverLicenseCategoryA1  DriverLicenseCategory = "A1"
+	DriverLicenseCategoryB1  DriverLicenseCategory = "B1"
+	DriverLicenseCategoryC1  DriverLicenseCategory = "C1"
+	DriverLicenseCategoryC1E DriverLicenseCategory = "C1E"
+	DriverLicenseCategoryD1  DriverLicenseCategory = "D1"
+	DriverLicenseCategoryD1E DriverLicenseCategory = "D1E"
+)
+
+func (c DriverLicenseCategory) IsValid() bool {
+	switch c {
+	case DriverLicenseCategoryA, DriverLicenseCategoryB, DriverLicenseCategoryBE,
+		DriverLicenseCategoryC, DriverLicenseCategoryCE, DriverLicenseCategoryD, DriverLicenseCategoryDE,
+		DriverLicenseCategoryA1, DriverLicenseCategoryB1, DriverLicenseCategoryC1, DriverLicenseCategoryC1E,
+		DriverLicenseCategoryD1, DriverLicenseCategoryD1E:
+		return true
+	}
+	return false
+}
+
+type DriverLicense struct {
+	Category  DriverLicenseCategory `json:"category" example:"B"`
+	ExpiresAt time.Time             `json:"expires_at" example:"2030-05-01T00:00:00Z"`
+}
+
+const (
+	maxDrivingExperience = 70
+	maxDriverLanguages   = 10
+)
+
+// DriverQualifications is the structured part of a driver profile used to
+// match drivers to rentals. DrivingExperience is in whole years. Languages
+// are ISO 639-1 codes. CarIDs and CategoryIDs list the cars and car
+// categories the driver is certified for.
+type DriverQualifications struct {
+	DrivingExperience int64           `json:"driving_experience" example:"12"`
+	Languages         []string        `json:"languages" example:"ru,kk,en"`
+	Licenses          []DriverLicense `json:"licenses"`
+	CarIDs            []int64         `json:"car_ids"`
+	CategoryIDs       []int64         `json:"category_ids"`
+}
+
+// Normalize validates the qualifications and brings them to the stored form:
+// lowercase unique languages, uppercase unique license categories with
+// date-only expiry, unique sorted IDs and no nil slices.
+func (q *DriverQualifications) Normalize() error {
+	if q.DrivingExperience < 0 || q.DrivingExperience > maxDrivingExperience {
+		return fmt.Errorf("driving experience must be between 0 and %d years", maxDrivingExperience)
+	}
+
+	languages := make([]string, 0, len(q.Languages))
+	seenLanguages := make(map[string]bool, len(q.Languages))
+	for _, language := range q.Languages {
+		language = strings.ToLower(strings.TrimSpace(language))
+		if !isLanguageCode(language) {
+			return fmt.Errorf("language %q must be a two-letter ISO 639-1 code", language)
+		}
+		if seenLanguages[language] {
+			continue
+		}
+		seenLanguages[language] = true
+		languages = append(languages, language)
+	}
+	if len(languages) > maxDriverLanguages {
+		return fmt.Errorf("a driver can have at most %d languages", maxDriverLanguages)
+	}
+
+	licenses := make([]DriverLicense, 0, len(q.Licenses))
+	seenCategories := make(map[DriverLicenseCategory]bool, len(q.Licenses))
+	for _, license := range q.Licenses {
+		license.Category = DriverLicenseCategory(strings.ToUpper(strings.TrimSpace(string(license.Category))))
+		if !license.Category.IsValid() {
+			return fmt.Errorf("unknown license category %q", license.Category)
+		}
+		if seenCategories[license.Category] {
+			return fmt.Errorf("license category %s is listed more than once", license.Category)
+		}
+		seenCategories[license.Category] = true
+		if license.ExpiresAt.IsZero() {
+			return fmt.Errorf("license category %s must have an expiry date", license.Category)
+		}
+		license.ExpiresAt = truncateToDate(license.ExpiresAt)
+		licenses = append(licenses, license)
+	}
+	sort.Slice(licenses, func(i, j int) bool { return licenses[i].Category < licenses[j].Category })
+
+	carIDs, err := uniqueIDs(q.CarIDs)
+	if err != nil {
+		return fmt.Errorf("car IDs: %w", err)
+	}
+	categoryIDs, err := uniqueIDs(q.CategoryIDs)
+	if err != nil {
+		return fmt.Errorf("category IDs: %w", err)
+	}
+
+	q.Languages = languages
+	q.Licenses = licenses
+	q.CarIDs = carIDs
+	q.CategoryIDs = categoryIDs
+	return nil
+}
+
+func isLanguageCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+	for _, r := range value {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueIDs(ids []int64) ([]int64, error) {
+	unique := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, errors.New("IDs must be positive")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	sort.Slice(unique, func(i, j int) bool { return unique[i] < unique[j] })
+	return unique, nil
+}
@@ -0,0 +1,21 @@
+package entities
+
+type ImageVariantName string
+
+const (
+	ImageVariantThumb ImageVariantName = "thumb"
+	ImageVariantCard  ImageVariantName = "card"
+	ImageVariantFull  ImageVariantName = "full"
+)
+
+// ImageVariant is one resized rendition of an uploaded image. Every size is
+// stored as JPEG (PNG when the source has transparency) and, when a WebP
+// encoder is configured, additionally as WebP, so the frontend can build
+// srcset and <picture> sources from the list.
+type ImageVariant struct {
+	Name   ImageVariantName `json:"name" example:"card"`
+	Format string           `json:"format" example:"jpeg"`
+	Path   string           `json:"path" example:"cars/1700000000_s-class_card.jpg"`
+	Width  int              `json:"width" example:"800"`
+	Height int              `json:"height" example:"533"`
+}
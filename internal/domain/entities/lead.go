@@ -2,61 +2,131 @@ package entities
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+
+	"github.com/nomad-pixel/imperial/pkg/utils"
 )
 
-type Lead struct {
-	ID        int64     `json:"id"`
-	FullName  string    `json:"full_name"`
-	Phone     string    `json:"phone"`
-	StartDate time.Time `json:"start_date"`
-	EndDate   time.Time `json:"end_date"`
-	CreatedAt time.Time `json:"created_at"`
-}
+type LeadStatus string
 
-func NewLead(fullName, phone string, startDate, endDate time.Time) (*Lead, error) {
-	fullName = strings.TrimSpace(fullName)
+const (
+	LeadStatusNew       LeadStatus = "new"
+	LeadStatusContacted LeadStatus = "contacted"
+	LeadStatusQuoted    LeadStatus = "quoted"
+	LeadStatusWon       LeadStatus = "won"
+	LeadStatusLost      LeadStatus = "lost"
+)
 
-	if fullName == "" {
-		return nil, errors.New("full name cannot be empty")
-	}
+// leadTransitions lists the statuses each status may move to.
+// Won and lost leads are final.
+var leadTransitions = map[LeadStatus][]LeadStatus{
+	LeadStatusNew:       {LeadStatusContacted, LeadStatusLost},
+	LeadStatusContacted: {LeadStatusQuoted, LeadStatusWon, LeadStatusLost},
+	LeadStatusQuoted:    {LeadStatusWon, LeadStatusLost},
+}
 
-	if len(fullName) < 2 {
-		return nil, errors.New("full name must be at least 2 characters")
+func (s LeadStatus) IsValid() bool {
+	switch s {
+	case LeadStatusNew, LeadStatusContacted, LeadStatusQuoted, LeadStatusWon, LeadStatusLost:
+		return true
 	}
+	return false
+}
 
-	if len(fullName) > 100 {
-		return nil, errors.New("full name cannot exceed 100 characters")
+func (s LeadStatus) CanTransitionTo(next LeadStatus) bool {
+	for _, allowed := range leadTransitions[s] {
+		if allowed == next {
+			return true
+		}
 	}
+	return false
+}
 
-	phone = strings.TrimSpace(phone)
-	if phone == "" {
-		return nil, errors.New("phone cannot be empty")
+// Lead is an inquiry from a customer. Phone is stored in E.164 format and
+// PhoneRaw keeps the number as it was typed. DuplicateOfID flags a likely
+// duplicate of an earlier lead; MergedIntoID is set on leads merged into
+// another one, which then leave the lead list.
+type Lead struct {
+	ID            int64       `json:"id"`
+	FullName      string      `json:"full_name"`
+	Phone         string      `json:"phone"`
+	PhoneRaw      string      `json:"phone_raw"`
+	StartDate     time.Time   `json:"start_date"`
+	EndDate       time.Time   `json:"end_date"`
+	CarID         *int64      `json:"car_id,omitempty"`
+	WithDriver    bool        `json:"with_driver"`
+	PriceQuote    *PriceQuote `json:"price_quote,omitempty"`
+	Status        LeadStatus  `json:"status"`
+	LostReason    *string     `json:"lost_reason,omitempty"`
+	AssigneeID    *int64      `json:"assignee_id,omitempty"`
+	DuplicateOfID *int64      `json:"duplicate_of_id,omitempty"`
+	MergedIntoID  *int64      `json:"merged_into_id,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// LeadStatusChange is one entry of the lead status history
+type LeadStatusChange struct {
+	ID         int64      `json:"id"`
+	LeadID     int64      `json:"lead_id"`
+	FromStatus LeadStatus `json:"from_status"`
+	ToStatus   LeadStatus `json:"to_status"`
+	Reason     *string    `json:"reason,omitempty"`
+	ChangedBy  *int64     `json:"changed_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// NewLead validates the inquiry and normalizes the phone to E.164, reading
+// numbers without a country code as numbers of phoneRegion. The phone as
+// typed is kept in PhoneRaw. Errors are reported per field as ValidationErrors.
+func NewLead(fullName, phone, phoneRegion string, startDate, endDate time.Time) (*Lead, error) {
+	fieldErrs := ValidationErrors{}
+
+	fullName = strings.TrimSpace(fullName)
+	switch {
+	case fullName == "":
+		fieldErrs.Add("full_name", "full name cannot be empty")
+	case len(fullName) < 2:
+		fieldErrs.Add("full_name", "full name must be at least 2 characters")
+	case len(fullName) > 100:
+		fieldErrs.Add("full_name", "full name cannot exceed 100 characters")
 	}
 
-	if len(phone) > 32 {
-		return nil, errors.New("phone cannot exceed 32 characters")
+	phoneRaw := strings.TrimSpace(phone)
+	normalized, err := utils.NormalizePhone(phoneRaw, phoneRegion)
+	switch {
+	case len(phoneRaw) > 32:
+		fieldErrs.Add("phone", "phone cannot exceed 32 characters")
+	case err != nil:
+		fieldErrs.Add("phone", err.Error())
 	}
 
 	if startDate.IsZero() {
-		return nil, errors.New("start date cannot be zero")
+		fieldErrs.Add("start_date", "start date cannot be zero")
 	}
 
 	if endDate.IsZero() {
-		return nil, errors.New("end date cannot be zero")
+		fieldErrs.Add("end_date", "end date cannot be zero")
+	} else if endDate.Before(startDate) {
+		fieldErrs.Add("end_date", "end date must be after start date")
 	}
 
-	if endDate.Before(startDate) {
-		return nil, errors.New("end date must be after start date")
+	if err := fieldErrs.Err(); err != nil {
+		return nil, err
 	}
 
+	now := time.Now()
 	return &Lead{
 		FullName:  fullName,
-		Phone:     phone,
+		Phone:     normalized,
+		PhoneRaw:  phoneRaw,
 		StartDate: startDate,
 		EndDate:   endDate,
-		CreatedAt: time.Now(),
+		Status:    LeadStatusNew,
+		CreatedAt: now,
+		UpdatedAt: now,
 	}, nil
 }
 
@@ -70,9 +140,8 @@ func (l *Lead) Validate() error {
 		return errors.New("full name must be between 2 and 100 characters")
 	}
 
-	phone := strings.TrimSpace(l.Phone)
-	if phone == "" || len(phone) > 32 {
-		return errors.New("phone is invalid")
+	if !strings.HasPrefix(l.Phone, "+") || len(l.Phone) > 16 {
+		return errors.New("phone must be in E.164 format")
 	}
 
 	if l.StartDate.IsZero() || l.EndDate.IsZero() {
@@ -83,5 +152,65 @@ func (l *Lead) Validate() error {
 		return errors.New("end date must be after start date")
 	}
 
+	if !l.Status.IsValid() {
+		return errors.New("invalid lead status")
+	}
+
 	return nil
 }
+
+// AttachQuote links the lead to the quoted car and keeps the price snapshot
+func (l *Lead) AttachQuote(quote *PriceQuote) {
+	l.CarID = &quote.CarID
+	l.WithDriver = quote.WithDriver
+	l.PriceQuote = quote
+}
+
+// TransitionTo moves the lead to the next status if the pipeline allows it and
+// returns the history entry to record. Lost leads require a reason.
+func (l *Lead) TransitionTo(next LeadStatus, reason string, changedBy int64) (*LeadStatusChange, error) {
+	if !next.IsValid() {
+		return nil, fmt.Errorf("unknown lead status %q", next)
+	}
+	if !l.Status.CanTransitionTo(next) {
+		return nil, fmt.Errorf("lead cannot move from %s to %s", l.Status, next)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if next == LeadStatusLost && reason == "" {
+		return nil, errors.New("reason is required for lost leads")
+	}
+	if len(reason) > 500 {
+		return nil, errors.New("reason cannot exceed 500 characters")
+	}
+
+	change := &LeadStatusChange{
+		LeadID:     l.ID,
+		FromStatus: l.Status,
+		ToStatus:   next,
+		CreatedAt:  time.Now(),
+	}
+	if reason != "" {
+		change.Reason = &reason
+	}
+	if changedBy > 0 {
+		change.ChangedBy = &changedBy
+	}
+
+	l.Status = next
+	if next == LeadStatusLost {
+		l.LostReason = change.Reason
+	}
+	l.UpdatedAt = change.CreatedAt
+	return change, nil
+}
+
+// AssignTo hands the lead to a staff member; nil clears the assignment
+func (l *Lead) AssignTo(userID *int64) {
+	l.AssigneeID = userID
+	l.UpdatedAt = time.Now()
+}
+
+func (l *Lead) IsFinal() bool {
+	return l.Status == LeadStatusWon || l.Status == LeadStatusLost
+}
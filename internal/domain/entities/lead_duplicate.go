@@ -0,0 +1,136 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DuplicateLookback is how far back a new lead is compared with earlier ones
+const DuplicateLookback = 30 * 24 * time.Hour
+
+const (
+	// similarNameThreshold accepts typos and reordered words ("Иванов Иван"
+	// and "Иван Иванов") for leads with the same phone
+	similarNameThreshold = 0.8
+	// sameNameThreshold is required when only name and period match
+	sameNameThreshold = 0.9
+)
+
+// IsLikelyDuplicateOf reports whether l and other are probably the same
+// inquiry: the same phone with a similar name or an overlapping period, or a
+// practically equal name with an overlapping period.
+func (l *Lead) IsLikelyDuplicateOf(other *Lead) bool {
+	if l.ID != 0 && l.ID == other.ID {
+		return false
+	}
+
+	similarity := NameSimilarity(l.FullName, other.FullName)
+	overlaps := l.PeriodOverlaps(other)
+
+	if l.Phone == other.Phone {
+		return similarity >= similarNameThreshold || overlaps
+	}
+	return similarity >= sameNameThreshold && overlaps
+}
+
+func (l *Lead) PeriodOverlaps(other *Lead) bool {
+	return !l.StartDate.After(other.EndDate) && !other.StartDate.After(l.EndDate)
+}
+
+// MarkDuplicateOf flags l as a duplicate of original, pointing at the first
+// lead of the group when original is a duplicate itself
+func (l *Lead) MarkDuplicateOf(original *Lead) {
+	id := original.ID
+	if original.DuplicateOfID != nil {
+		id = *original.DuplicateOfID
+	}
+	l.DuplicateOfID = &id
+}
+
+func (l *Lead) IsMerged() bool {
+	return l.MergedIntoID != nil
+}
+
+// Merge absorbs sources into l: l keeps its own data and takes the car and
+// the assignee from the first source that has them when it has none. The
+// sources are marked as merged into l.
+func (l *Lead) Merge(sources []*Lead) error {
+	if l.IsMerged() {
+		return fmt.Errorf("lead %d is already merged into lead %d", l.ID, *l.MergedIntoID)
+	}
+	if len(sources) == 0 {
+		return errors.New("no leads to merge")
+	}
+
+	now := time.Now()
+	for _, src := range sources {
+		if src.ID == l.ID {
+			return errors.New("lead cannot be merged into itself")
+		}
+		if src.IsMerged() {
+			return fmt.Errorf("lead %d is already merged into lead %d", src.ID, *src.MergedIntoID)
+		}
+
+		if l.CarID == nil && src.CarID != nil {
+			l.CarID = src.CarID
+			l.WithDriver = src.WithDriver
+			l.PriceQuote = src.PriceQuote
+		}
+		if l.AssigneeID == nil && src.AssigneeID != nil {
+			l.AssigneeID = src.AssigneeID
+		}
+
+		src.MergedIntoID = &l.ID
+		src.DuplicateOfID = nil
+		src.UpdatedAt = now
+	}
+
+	// l is no longer a duplicate of a lead it absorbed
+	if l.DuplicateOfID != nil && slices.ContainsFunc(sources, func(src *Lead) bool { return src.ID == *l.DuplicateOfID }) {
+		l.DuplicateOfID = nil
+	}
+	l.UpdatedAt = now
+	return nil
+}
+
+// NameSimilarity compares two person names from 0 (different) to 1 (equal),
+// ignoring case, extra spaces, word order and ё/е
+func NameSimilarity(a, b string) float64 {
+	ra, rb := []rune(normalizeName(a)), []rune(normalizeName(b))
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func normalizeName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+	words := strings.Fields(name)
+	slices.Sort(words)
+	return strings.Join(words, " ")
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
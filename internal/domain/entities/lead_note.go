@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type LeadNote struct {
+	ID        int64     `json:"id"`
+	LeadID    int64     `json:"lead_id"`
+	AuthorID  *int64    `json:"author_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewLeadNote(leadID, authorID int64, body string) (*LeadNote, error) {
+	body = strings.TrimSpace(body)
+
+	if leadID <= 0 {
+		return nil, errors.New("invalid lead ID")
+	}
+
+	if body == "" {
+		return nil, errors.New("note cannot be empty")
+	}
+
+	if len(body) > 2000 {
+		return nil, errors.New("note cannot exceed 2000 characters")
+	}
+
+	note := &LeadNote{
+		LeadID:    leadID,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}
+	if authorID > 0 {
+		note.AuthorID = &authorID
+	}
+	return note, nil
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	// OutboxStatusDead marks a message that ran out of delivery attempts
+	OutboxStatusDead OutboxStatus = "dead"
+)
+
+// Outbox topics. Each topic has exactly one payload type.
+const (
+	OutboxTopicVerificationEmail  = "email.verification_code" // EmailCodePayload
+	OutboxTopicPasswordResetEmail = "email.password_reset"    // EmailCodePayload
+	OutboxTopicLeadCreated        = "lead.created"            // LeadNotificationPayload
+)
+
+// OutboxMessage is a side effect (an email, a notification) recorded in the
+// same transaction as the change that caused it and delivered later by a
+// background dispatcher.
+type OutboxMessage struct {
+	ID            int64           `json:"id"`
+	Topic         string          `json:"topic"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        OutboxStatus    `json:"status"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	LastError     *string         `json:"last_error,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	ProcessedAt   *time.Time      `json:"processed_at,omitempty"`
+}
+
+// EmailCodePayload is the payload of the email topics that deliver a one-time code
+type EmailCodePayload struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+// LeadNotificationPayload is the payload of OutboxTopicLeadCreated. A lead is
+// queued once per staff channel so each channel is retried on its own.
+type LeadNotificationPayload struct {
+	Channel string `json:"channel"`
+	Lead    *Lead  `json:"lead"`
+}
+
+func NewOutboxMessage(topic string, payload any) (*OutboxMessage, error) {
+	if topic == "" {
+		return nil, errors.New("topic is required")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &OutboxMessage{
+		Topic:         topic,
+		Payload:       data,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// DecodePayload unmarshals the payload into v
+func (m *OutboxMessage) DecodePayload(v any) error {
+	return json.Unmarshal(m.Payload, v)
+}
@@ -0,0 +1,291 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Rental length tiers. A rental of 1–2 days is charged at ShortDailyRate,
+// 3–6 days at MediumDailyRate and 7+ days at LongDailyRate.
+const (
+	MediumTierMinDays = 3
+	LongTierMinDays   = 7
+)
+
+// RatePlan is a tariff attached either to a single car or to a whole car
+// category. A car-specific plan takes precedence over its category plan.
+type RatePlan struct {
+	ID                      int64           `json:"id"`
+	Name                    string          `json:"name"`
+	CarID                   *int64          `json:"car_id,omitempty"`
+	CategoryID              *int64          `json:"category_id,omitempty"`
+	ShortDailyRate          int64           `json:"short_daily_rate"`
+	MediumDailyRate         int64           `json:"medium_daily_rate"`
+	LongDailyRate           int64           `json:"long_daily_rate"`
+	WeekendSurchargePercent int64           `json:"weekend_surcharge_percent"`
+	ChauffeurDailyRate      int64           `json:"chauffeur_daily_rate"`
+	Seasons                 []*SeasonalRate `json:"seasons"`
+	CreatedAt               time.Time       `json:"created_at"`
+	UpdatedAt               time.Time       `json:"updated_at"`
+}
+
+// SeasonalRate adds a surcharge to every rental day within [StartDate, EndDate]
+// (both inclusive, compared by calendar date in UTC).
+type SeasonalRate struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	StartDate        time.Time `json:"start_date"`
+	EndDate          time.Time `json:"end_date"`
+	SurchargePercent int64     `json:"surcharge_percent"`
+}
+
+func NewRatePlan(name string, carID, categoryID *int64, shortRate, mediumRate, longRate, weekendPercent, chauffeurRate int64, seasons []*SeasonalRate) (*RatePlan, error) {
+	now := time.Now()
+	plan := &RatePlan{
+		Name:                    strings.TrimSpace(name),
+		CarID:                   carID,
+		CategoryID:              categoryID,
+		ShortDailyRate:          shortRate,
+		MediumDailyRate:         mediumRate,
+		LongDailyRate:           longRate,
+		WeekendSurchargePercent: weekendPercent,
+		ChauffeurDailyRate:      chauffeurRate,
+		Seasons:                 seasons,
+		CreatedAt:               now,
+		UpdatedAt:               now,
+	}
+	if plan.Seasons == nil {
+		plan.Seasons = make([]*SeasonalRate, 0)
+	}
+
+	if err := plan.validateFields(); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// FlatRatePlan wraps the legacy Car.PricePerDay for cars without a rate plan
+func FlatRatePlan(car *Car) *RatePlan {
+	return &RatePlan{
+		Name:            "flat",
+		CarID:           &car.ID,
+		ShortDailyRate:  car.PricePerDay,
+		MediumDailyRate: car.PricePerDay,
+		LongDailyRate:   car.PricePerDay,
+		Seasons:         make([]*SeasonalRate, 0),
+	}
+}
+
+func (p *RatePlan) Validate() error {
+	if p.ID <= 0 {
+		return errors.New("invalid rate plan ID")
+	}
+	return p.validateFields()
+}
+
+func (p *RatePlan) validateFields() error {
+	if p.Name == "" || len(p.Name) > 255 {
+		return errors.New("name must be between 1 and 255 characters")
+	}
+	if (p.CarID == nil) == (p.CategoryID == nil) {
+		return errors.New("rate plan must be attached to exactly one of car or category")
+	}
+	if p.ShortDailyRate < 0 || p.MediumDailyRate < 0 || p.LongDailyRate < 0 || p.ChauffeurDailyRate < 0 {
+		return errors.New("rates cannot be negative")
+	}
+	if p.WeekendSurchargePercent < 0 || p.WeekendSurchargePercent > 1000 {
+		return errors.New("weekend surcharge must be between 0 and 1000 percent")
+	}
+
+	for _, season := range p.Seasons {
+		if err := season.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SeasonalRate) Validate() error {
+	name := strings.TrimSpace(s.Name)
+	if name == "" || len(name) > 255 {
+		return errors.New("season name must be between 1 and 255 characters")
+	}
+	if s.StartDate.IsZero() || s.EndDate.IsZero() {
+		return errors.New("season dates cannot be zero")
+	}
+	if s.EndDate.Before(s.StartDate) {
+		return errors.New("season end date must not be before start date")
+	}
+	if s.SurchargePercent < -100 || s.SurchargePercent > 1000 {
+		return errors.New("season surcharge must be between -100 and 1000 percent")
+	}
+	return nil
+}
+
+// DailyRateFor returns the base daily rate for a rental of the given length
+func (p *RatePlan) DailyRateFor(days int64) int64 {
+	switch {
+	case days >= LongTierMinDays:
+		return p.LongDailyRate
+	case days >= MediumTierMinDays:
+		return p.MediumDailyRate
+	default:
+		return p.ShortDailyRate
+	}
+}
+
+func (p *RatePlan) seasonFor(day time.Time) *SeasonalRate {
+	var best *SeasonalRate
+	for _, season := range p.Seasons {
+		start := civilDateIn(season.StartDate, day.Location())
+		end := civilDateIn(season.EndDate, day.Location())
+		if day.Before(start) || day.After(end) {
+			continue
+		}
+		// Overlapping seasons do not stack, the biggest surcharge wins
+		if best == nil || season.SurchargePercent > best.SurchargePercent {
+			best = season
+		}
+	}
+	return best
+}
+
+// Quote prices a rental over [startDate, endDate). Every started 24 hours
+// counts as a rental day. Weekends and seasons are read from the calendar
+// days in loc, the business timezone; a nil loc means UTC.
+func (p *RatePlan) Quote(car *Car, startDate, endDate time.Time, withDriver bool, loc *time.Location) (*PriceQuote, error) {
+	if !endDate.After(startDate) {
+		return nil, errors.New("end date must be after start date")
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	days := int64(math.Ceil(endDate.Sub(startDate).Hours() / 24))
+	dailyRate := p.DailyRateFor(days)
+
+	quote := &PriceQuote{
+		CarID:      car.ID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Days:       days,
+		WithDriver: withDriver || car.OnlyWithDriver,
+		DailyRate:  dailyRate,
+		Items:      make([]PriceLineItem, 0),
+	}
+	if p.ID > 0 {
+		quote.RatePlanID = &p.ID
+	}
+
+	quote.addItem(PriceLineItem{
+		Code:        PriceItemBase,
+		Description: fmt.Sprintf("Аренда, %d сут.", days),
+		Quantity:    days,
+		UnitPrice:   dailyRate,
+	})
+
+	var weekendDays int64
+	seasonDays := make(map[*SeasonalRate]int64)
+	seasonOrder := make([]*SeasonalRate, 0)
+	for i := int64(0); i < days; i++ {
+		day := dateIn(startDate.In(loc).AddDate(0, 0, int(i)), loc)
+		if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			weekendDays++
+		}
+		if season := p.seasonFor(day); season != nil {
+			if _, seen := seasonDays[season]; !seen {
+				seasonOrder = append(seasonOrder, season)
+			}
+			seasonDays[season]++
+		}
+	}
+
+	if weekendDays > 0 && p.WeekendSurchargePercent != 0 {
+		quote.addItem(PriceLineItem{
+			Code:        PriceItemWeekend,
+			Description: fmt.Sprintf("Надбавка за выходные, %d%%", p.WeekendSurchargePercent),
+			Quantity:    weekendDays,
+			UnitPrice:   dailyRate * p.WeekendSurchargePercent / 100,
+		})
+	}
+
+	for _, season := range seasonOrder {
+		if season.SurchargePercent == 0 {
+			continue
+		}
+		quote.addItem(PriceLineItem{
+			Code:        PriceItemSeason,
+			Description: fmt.Sprintf("Сезон «%s», %+d%%", season.Name, season.SurchargePercent),
+			Quantity:    seasonDays[season],
+			UnitPrice:   dailyRate * season.SurchargePercent / 100,
+		})
+	}
+
+	if quote.WithDriver && p.ChauffeurDailyRate > 0 {
+		quote.addItem(PriceLineItem{
+			Code:        PriceItemChauffeur,
+			Description: "Услуги водителя",
+			Quantity:    days,
+			UnitPrice:   p.ChauffeurDailyRate,
+		})
+	}
+
+	return quote, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return dateIn(t, time.UTC)
+}
+
+// dateIn returns midnight of the day t falls on in loc
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// civilDateIn returns midnight in loc of a calendar date stored as UTC
+// midnight, such as a DATE column
+func civilDateIn(date time.Time, loc *time.Location) time.Time {
+	date = date.UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+type PriceItemCode string
+
+const (
+	PriceItemBase      PriceItemCode = "base"
+	PriceItemWeekend   PriceItemCode = "weekend"
+	PriceItemSeason    PriceItemCode = "season"
+	PriceItemChauffeur PriceItemCode = "chauffeur"
+)
+
+type PriceLineItem struct {
+	Code        PriceItemCode `json:"code"`
+	Description string        `json:"description"`
+	Quantity    int64         `json:"quantity"`
+	UnitPrice   int64         `json:"unit_price"`
+	Amount      int64         `json:"amount"`
+}
+
+// PriceQuote is an itemized price for a rental. It is stored as a snapshot
+// on leads and bookings so later tariff changes do not alter agreed prices.
+type PriceQuote struct {
+	CarID      int64           `json:"car_id"`
+	RatePlanID *int64          `json:"rate_plan_id,omitempty"`
+	StartDate  time.Time       `json:"start_date"`
+	EndDate    time.Time       `json:"end_date"`
+	Days       int64           `json:"days"`
+	WithDriver bool            `json:"with_driver"`
+	DailyRate  int64           `json:"daily_rate"`
+	Items      []PriceLineItem `json:"items"`
+	Total      int64           `json:"total"`
+}
+
+func (q *PriceQuote) addItem(item PriceLineItem) {
+	item.Amount = item.Quantity * item.UnitPrice
+	q.Items = append(q.Items, item)
+	q.Total += item.Amount
+}
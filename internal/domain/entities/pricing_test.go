@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRatePlanQuoteReadsWeekendsInBusinessTimezone(t *testing.T) {
+	almaty := time.FixedZone("Asia/Almaty", 5*60*60)
+	plan := &RatePlan{
+		ShortDailyRate:          100,
+		MediumDailyRate:         100,
+		LongDailyRate:           100,
+		WeekendSurchargePercent: 50,
+	}
+	car := &Car{ID: 1}
+
+	// Saturday 00:00 to Monday 00:00 in Almaty starts on Friday in UTC
+	start := time.Date(2024, time.June, 1, 0, 0, 0, 0, almaty)
+	end := time.Date(2024, time.June, 3, 0, 0, 0, 0, almaty)
+
+	quote, err := plan.Quote(car, start, end, false, almaty)
+	if err != nil {
+		t.Fatalf("Quote: %v", err)
+	}
+
+	var weekendDays int64
+	for _, item := range quote.Items {
+		if item.Code == PriceItemWeekend {
+			weekendDays = item.Quantity
+		}
+	}
+	if weekendDays != 2 {
+		t.Errorf("weekend days = %d, want 2", weekendDays)
+	}
+}
+
+func TestRatePlanQuoteReadsSeasonDatesInBusinessTimezone(t *testing.T) {
+	almaty := time.FixedZone("Asia/Almaty", 5*60*60)
+	plan := &RatePlan{
+		ShortDailyRate:  100,
+		MediumDailyRate: 100,
+		LongDailyRate:   100,
+		Seasons: []*SeasonalRate{{
+			Name:             "Summer",
+			StartDate:        time.Date(2024, time.June, 4, 0, 0, 0, 0, time.UTC),
+			EndDate:          time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC),
+			SurchargePercent: 20,
+		}},
+	}
+	car := &Car{ID: 1}
+
+	// 02:00 on June 4 in Almaty is still June 3 in UTC
+	start := time.Date(2024, time.June, 4, 2, 0, 0, 0, almaty)
+	end := time.Date(2024, time.June, 5, 2, 0, 0, 0, almaty)
+
+	quote, err := plan.Quote(car, start, end, false, almaty)
+	if err != nil {
+		t.Fatalf("Quote: %v", err)
+	}
+
+	var seasonDays int64
+	for _, item := range quote.Items {
+		if item.Code == PriceItemSeason {
+			seasonDays = item.Quantity
+		}
+	}
+	if seasonDays != 1 {
+		t.Errorf("season days = %d, want 1", seasonDays)
+	}
+}
@@ -0,0 +1,169 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+// reviewTransitions lists the statuses each status may move to. Moderators
+// can change their mind, but a review never goes back to pending.
+var reviewTransitions = map[ReviewStatus][]ReviewStatus{
+	ReviewStatusPending:  {ReviewStatusApproved, ReviewStatusRejected},
+	ReviewStatusApproved: {ReviewStatusRejected},
+	ReviewStatusRejected: {ReviewStatusApproved},
+}
+
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (s ReviewStatus) CanTransitionTo(next ReviewStatus) bool {
+	for _, allowed := range reviewTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	MinReviewRating    = 1
+	MaxReviewRating    = 5
+	MaxReviewPhotos    = 5
+	maxReviewTextLen   = 2000
+	maxReviewReasonLen = 500
+)
+
+type ReviewPhoto struct {
+	Path     string         `json:"path" example:"reviews/1700000000_photo_full.jpg"`
+	Variants []ImageVariant `json:"variants"`
+}
+
+// Review is customer feedback on a completed booking. Rating scores the
+// rental and the car; DriverRating scores the chauffeur and is only allowed
+// when the booking had one. Only approved reviews are public and count
+// towards the car and driver ratings.
+type Review struct {
+	ID              int64         `json:"id"`
+	BookingID       int64         `json:"booking_id"`
+	CarID           int64         `json:"car_id"`
+	DriverID        *int64        `json:"driver_id,omitempty"`
+	AuthorName      string        `json:"author_name"`
+	Rating          int           `json:"rating"`
+	DriverRating    *int          `json:"driver_rating,omitempty"`
+	Text            string        `json:"text"`
+	Photos          []ReviewPhoto `json:"photos"`
+	Status          ReviewStatus  `json:"status"`
+	RejectionReason string        `json:"rejection_reason,omitempty"`
+	ModeratedBy     *int64        `json:"moderated_by,omitempty"`
+	ModeratedAt     *time.Time    `json:"moderated_at,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+// NewReview validates feedback for the booking. The author name defaults to
+// the customer name on the booking. Photos are attached separately once they
+// are stored.
+func NewReview(booking *Booking, authorName string, rating int, driverRating *int, text string) (*Review, error) {
+	if booking.Status != BookingStatusCompleted {
+		return nil, errors.New("only completed bookings can be reviewed")
+	}
+
+	authorName = strings.TrimSpace(authorName)
+	if authorName == "" {
+		authorName = booking.CustomerName
+	}
+	if len(authorName) < 2 || len(authorName) > 100 {
+		return nil, errors.New("author name must be between 2 and 100 characters")
+	}
+
+	if rating < MinReviewRating || rating > MaxReviewRating {
+		return nil, fmt.Errorf("rating must be between %d and %d", MinReviewRating, MaxReviewRating)
+	}
+	if driverRating != nil {
+		if booking.DriverID == nil {
+			return nil, errors.New("the booking had no driver to rate")
+		}
+		if *driverRating < MinReviewRating || *driverRating > MaxReviewRating {
+			return nil, fmt.Errorf("driver rating must be between %d and %d", MinReviewRating, MaxReviewRating)
+		}
+	}
+
+	text = strings.TrimSpace(text)
+	if len(text) > maxReviewTextLen {
+		return nil, fmt.Errorf("text cannot exceed %d characters", maxReviewTextLen)
+	}
+
+	now := time.Now()
+	return &Review{
+		BookingID:    booking.ID,
+		CarID:        booking.CarID,
+		DriverID:     booking.DriverID,
+		AuthorName:   authorName,
+		Rating:       rating,
+		DriverRating: driverRating,
+		Text:         text,
+		Photos:       make([]ReviewPhoto, 0),
+		Status:       ReviewStatusPending,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func (r *Review) AddPhoto(path string, variants []ImageVariant) error {
+	if len(r.Photos) >= MaxReviewPhotos {
+		return fmt.Errorf("a review can have at most %d photos", MaxReviewPhotos)
+	}
+	r.Photos = append(r.Photos, ReviewPhoto{Path: path, Variants: variants})
+	return nil
+}
+
+// Moderate approves or rejects the review. Rejections require a reason,
+// which is kept for staff and never shown publicly.
+func (r *Review) Moderate(next ReviewStatus, reason string, moderatorID int64) error {
+	if !next.IsValid() {
+		return fmt.Errorf("unknown review status %q", next)
+	}
+	if !r.Status.CanTransitionTo(next) {
+		return fmt.Errorf("review cannot move from %s to %s", r.Status, next)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if next == ReviewStatusRejected && reason == "" {
+		return errors.New("reason is required for rejected reviews")
+	}
+	if len(reason) > maxReviewReasonLen {
+		return fmt.Errorf("reason cannot exceed %d characters", maxReviewReasonLen)
+	}
+	if next == ReviewStatusApproved {
+		reason = ""
+	}
+
+	now := time.Now()
+	r.Status = next
+	r.RejectionReason = reason
+	r.ModeratedBy = &moderatorID
+	r.ModeratedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// RatingSummary aggregates the approved reviews of a car or driver
+type RatingSummary struct {
+	Average float64 `json:"average" example:"4.75"`
+	Count   int64   `json:"count" example:"12"`
+}
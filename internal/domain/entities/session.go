@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// Session is a persisted refresh token. Every refresh rotates the session:
+// the old row is revoked and points to its successor via ReplacedBy, while
+// all rotations of one sign-in share the same FamilyID.
+type Session struct {
+	ID         int64
+	JTI        string
+	FamilyID   string
+	UserID     int64
+	UserAgent  string
+	IPAddress  string
+	ReplacedBy *string
+	RevokedAt  *time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewSessionID returns a random identifier used both as token jti and family id
+func NewSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func NewSession(jti, familyID string, userID int64, userAgent, ipAddress string, expiresAt time.Time) (*Session, error) {
+	if jti == "" || familyID == "" {
+		return nil, errors.New("session id is required")
+	}
+	if userID <= 0 {
+		return nil, errors.New("user id is required")
+	}
+
+	now := time.Now()
+	return &Session{
+		JTI:       jti,
+		FamilyID:  familyID,
+		UserID:    userID,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// IsRotated reports whether the session was revoked because a newer token
+// replaced it. Presenting such a token again means it has leaked.
+func (s *Session) IsRotated() bool {
+	return s.RevokedAt != nil && s.ReplacedBy != nil
+}
+
+func (s *Session) IsExpired() bool {
+	return time.Now().After(s.ExpiresAt)
+}
@@ -1,6 +1,20 @@
 package entities
 
+import "time"
+
 type Tokens struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"-"`
+}
+
+type AccessClaims struct {
+	UserID int64
+	Role   UserRole
+}
+
+type RefreshClaims struct {
+	UserID   int64
+	JTI      string
+	IssuedAt time.Time
 }
@@ -9,13 +9,31 @@ import (
 
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 
+type UserRole string
+
+const (
+	UserRoleAdmin    UserRole = "admin"
+	UserRoleManager  UserRole = "manager"
+	UserRoleCustomer UserRole = "customer"
+)
+
+func (r UserRole) IsValid() bool {
+	switch r {
+	case UserRoleAdmin, UserRoleManager, UserRoleCustomer:
+		return true
+	}
+	return false
+}
+
 type User struct {
-	ID           int64
-	Email        string
-	PasswordHash string
-	IsVerified   bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID                int64
+	Email             string
+	PasswordHash      string
+	IsVerified        bool
+	Role              UserRole
+	PasswordChangedAt *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 func NewUser(email, passwordHash string) (*User, error) {
@@ -42,6 +60,7 @@ func NewUser(email, passwordHash string) (*User, error) {
 		Email:        email,
 		PasswordHash: passwordHash,
 		IsVerified:   false,
+		Role:         UserRoleCustomer,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
@@ -64,6 +83,10 @@ func (u *User) Validate() error {
 		return errors.New("password hash cannot be empty")
 	}
 
+	if !u.Role.IsValid() {
+		return errors.New("invalid user role")
+	}
+
 	return nil
 }
 
@@ -83,7 +106,51 @@ func (u *User) SetEmail(email string) error {
 	return nil
 }
 
+func (u *User) SetRole(role UserRole) error {
+	if !role.IsValid() {
+		return errors.New("invalid user role")
+	}
+
+	u.Role = role
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+func (u *User) HasRole(roles ...UserRole) bool {
+	for _, role := range roles {
+		if u.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
 func (u *User) MarkAsVerified() {
 	u.IsVerified = true
 	u.UpdatedAt = time.Now()
 }
+
+func (u *User) ChangePassword(passwordHash string) error {
+	if passwordHash == "" {
+		return errors.New("password hash cannot be empty")
+	}
+
+	if len(passwordHash) < 32 {
+		return errors.New("password hash is too short (must be bcrypt hash)")
+	}
+
+	now := time.Now()
+	u.PasswordHash = passwordHash
+	u.PasswordChangedAt = &now
+	u.UpdatedAt = now
+	return nil
+}
+
+// IsTokenRevoked reports whether a token issued at issuedAt was invalidated
+// by a later password change.
+func (u *User) IsTokenRevoked(issuedAt time.Time) bool {
+	if u.PasswordChangedAt == nil {
+		return false
+	}
+	return issuedAt.Unix() < u.PasswordChangedAt.Unix()
+}
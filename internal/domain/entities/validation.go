@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+)
+
+// ValidationErrors maps an input field (by its JSON name) to the reason it
+// was rejected, so every invalid field is reported at once
+type ValidationErrors map[string]string
+
+func (e ValidationErrors) Add(field, reason string) {
+	if _, exists := e[field]; !exists {
+		e[field] = reason
+	}
+}
+
+// Err returns nil when no field was rejected
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e ValidationErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, field+": "+e[field])
+	}
+	return strings.Join(parts, "; ")
+}
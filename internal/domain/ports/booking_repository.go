@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type BookingFilter struct {
+	Status   entities.BookingStatus
+	CarID    int64
+	DriverID int64
+	LeadID   int64
+}
+
+type BookingRepository interface {
+	CreateBooking(ctx context.Context, booking *entities.Booking) error
+	GetBookingByID(ctx context.Context, id int64) (*entities.Booking, error)
+	ListBookings(ctx context.Context, offset, limit int64, filter BookingFilter) (int64, []*entities.Booking, error)
+	UpdateBooking(ctx context.Context, booking *entities.Booking) error
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
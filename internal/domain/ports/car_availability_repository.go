@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type CarAvailabilityRepository interface {
+	CreateMaintenanceBlock(ctx context.Context, block *entities.CarMaintenanceBlock) error
+	DeleteMaintenanceBlock(ctx context.Context, carID, blockID int64) error
+	// LockCar locks the car row until the transaction in ctx ends, so the
+	// overlap check and insert of bookings and maintenance blocks of one car
+	// cannot interleave
+	LockCar(ctx context.Context, carID int64) error
+	// ListBusyIntervals returns live bookings and maintenance blocks of the
+	// car overlapping [from, to), ordered by start.
+	ListBusyIntervals(ctx context.Context, carID int64, from, to time.Time) ([]*entities.BusyInterval, error)
+}
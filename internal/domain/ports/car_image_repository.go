@@ -7,9 +7,13 @@ import (
 )
 
 type CarImageRepository interface {
-	Save(ctx context.Context, carID int64, imageUrl string) (*entities.CarImage, error)
+	Save(ctx context.Context, carID int64, imageUrl string, variants []entities.ImageVariant) (*entities.CarImage, error)
 	Delete(ctx context.Context, imageID int64) error
 	GetByID(ctx context.Context, imageID int64) (*entities.CarImage, error)
 	GetList(ctx context.Context, carID int64, offset, limit int64) (int64, []*entities.CarImage, error)
 	GetListByCar(ctx context.Context, carID int64) ([]*entities.CarImage, error)
+	// Reorder sets the gallery order of a car. imageIDs must list every image
+	// of the car exactly once.
+	Reorder(ctx context.Context, carID int64, imageIDs []int64) ([]*entities.CarImage, error)
+	SetCover(ctx context.Context, carID, imageID int64) error
 }
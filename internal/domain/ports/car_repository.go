@@ -2,14 +2,81 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 )
 
+type CarListSort string
+
+const (
+	CarListSortNewest    CarListSort = "newest"
+	CarListSortPriceAsc  CarListSort = "price_asc"
+	CarListSortPriceDesc CarListSort = "price_desc"
+	CarListSortPopular   CarListSort = "popular"
+)
+
+func (s CarListSort) IsValid() bool {
+	switch s {
+	case CarListSortNewest, CarListSortPriceAsc, CarListSortPriceDesc, CarListSortPopular:
+		return true
+	}
+	return false
+}
+
+// CarTagMatch controls how TagIDs are combined: any tag (OR) or all tags (AND).
+type CarTagMatch string
+
+const (
+	CarTagMatchAny CarTagMatch = "any"
+	CarTagMatchAll CarTagMatch = "all"
+)
+
+func (m CarTagMatch) IsValid() bool {
+	return m == CarTagMatchAny || m == CarTagMatchAll
+}
+
+// CarListFilter narrows ListCars. Zero values mean "no filter". When both
+// AvailableFrom and AvailableTo are set only cars with no live booking or
+// maintenance block overlapping [AvailableFrom, AvailableTo) are returned.
+// Spec ranges are inclusive; enum lists match any of the given values.
+// Price filters and sorts use the short daily rate of the car's rate plan
+// and fall back to PricePerDay for cars without one.
+type CarListFilter struct {
+	Name          string
+	MarkID        int64
+	CategoryID    int64
+	AvailableFrom time.Time
+	AvailableTo   time.Time
+
+	YearFrom         int64
+	YearTo           int64
+	SeatsFrom        int64
+	SeatsTo          int64
+	HorsepowerFrom   int64
+	HorsepowerTo     int64
+	EngineVolumeFrom float64
+	EngineVolumeTo   float64
+	AccelerationTo   float64
+	Transmissions    []entities.CarTransmission
+	FuelTypes        []entities.CarFuelType
+	DriveTypes       []entities.CarDriveType
+	Color            string
+
+	PriceFrom      int64
+	PriceTo        int64
+	OnlyWithDriver *bool
+	TagIDs         []int64
+	TagMatch       CarTagMatch
+	Sort           CarListSort
+}
+
 type CarRepository interface {
 	CreateCar(ctx context.Context, car *entities.Car) error
 	GetCarByID(ctx context.Context, id int64) (*entities.Car, error)
 	UpdateCar(ctx context.Context, car *entities.Car) error
 	DeleteCar(ctx context.Context, id int64) error
-	ListCars(ctx context.Context, offset, limit int64, name string, markID int64, categoryID int64) (int64, []*entities.Car, error)
+	ListCars(ctx context.Context, offset, limit int64, filter CarListFilter) (int64, []*entities.Car, error)
+	GetCarFacets(ctx context.Context, filter CarListFilter) (*entities.CarFacets, error)
+	SearchCars(ctx context.Context, query string, offset, limit int64) (int64, []*entities.CarSearchResult, error)
 }
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+// CelebrityCarRepository stores which cars celebrities rented. A new link is
+// placed last on both the celebrity and the car page.
+type CelebrityCarRepository interface {
+	AddCelebrityCar(ctx context.Context, link *entities.CelebrityCar) error
+	GetCelebrityCar(ctx context.Context, celebrityID, carID int64) (*entities.CelebrityCar, error)
+	UpdateCelebrityCar(ctx context.Context, link *entities.CelebrityCar) error
+	DeleteCelebrityCar(ctx context.Context, celebrityID, carID int64) error
+	// ReorderCelebrityCars sets the order of the cars on the celebrity page.
+	// carIDs must list every car of the celebrity exactly once.
+	ReorderCelebrityCars(ctx context.Context, celebrityID int64, carIDs []int64) ([]*entities.RentedCar, error)
+	// ReorderCarCelebrities sets the order of the celebrities on the car page.
+	// celebrityIDs must list every celebrity of the car exactly once.
+	ReorderCarCelebrities(ctx context.Context, carID int64, celebrityIDs []int64) ([]*entities.CarRenter, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+// CelebrityImageRepository stores the photo gallery of a celebrity. A new
+// image is placed last.
+type CelebrityImageRepository interface {
+	Save(ctx context.Context, celebrityID int64, imagePath string, variants []entities.ImageVariant) (*entities.CelebrityImage, error)
+	GetByID(ctx context.Context, imageID int64) (*entities.CelebrityImage, error)
+	Delete(ctx context.Context, imageID int64) error
+	// Reorder sets the gallery order of a celebrity. imageIDs must list every
+	// image of the celebrity exactly once.
+	Reorder(ctx context.Context, celebrityID int64, imageIDs []int64) ([]*entities.CelebrityImage, error)
+}
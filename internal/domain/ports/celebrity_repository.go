@@ -8,9 +8,14 @@ import (
 
 type CelebrityRepository interface {
 	CreateCelebrity(ctx context.Context, celebrity *entities.Celebrity) error
-	UploadImage(ctx context.Context, id int64, imagePath string) (*entities.Celebrity, error)
+	UploadImage(ctx context.Context, id int64, imagePath string, variants []entities.ImageVariant) (*entities.Celebrity, error)
 	UpdateCelebrity(ctx context.Context, celebrity *entities.Celebrity) error
 	GetCelebrityByID(ctx context.Context, id int64) (*entities.Celebrity, error)
 	DeleteCelebrity(ctx context.Context, id int64) error
 	ListCelebrities(ctx context.Context, offset int64, limit int64) (int64, []*entities.Celebrity, error)
+	// ListFeaturedCelebrities returns the homepage carousel in display order
+	ListFeaturedCelebrities(ctx context.Context) ([]*entities.Celebrity, error)
+	// ReorderFeaturedCelebrities sets the carousel order. celebrityIDs must
+	// list every featured celebrity exactly once.
+	ReorderFeaturedCelebrities(ctx context.Context, celebrityIDs []int64) ([]*entities.Celebrity, error)
 }
@@ -2,14 +2,41 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 )
 
+// DriverFilter narrows the driver list; zero values are ignored. A driver
+// must speak all Languages and hold a LicenseCategory that has not expired.
+// CarID matches drivers certified for the car itself or for its category.
+type DriverFilter struct {
+	MinExperience   int64
+	Languages       []string
+	LicenseCategory entities.DriverLicenseCategory
+	CarID           int64
+	CategoryID      int64
+}
+
 type DriverRepository interface {
 	CreateDriver(ctx context.Context, driver *entities.Driver) error
 	GetDriverByID(ctx context.Context, id int64) (*entities.Driver, error)
-	ListDrivers(ctx context.Context, offset, limit int64) (int64, []*entities.Driver, error)
+	ListDrivers(ctx context.Context, offset, limit int64, filter DriverFilter) (int64, []*entities.Driver, error)
 	UpdateDriver(ctx context.Context, driver *entities.Driver) error
 	DeleteDriver(ctx context.Context, id int64) error
+
+	GetSchedule(ctx context.Context, driverID int64) (*entities.DriverSchedule, error)
+	// ReplaceSchedule overwrites all shifts of the driver
+	ReplaceSchedule(ctx context.Context, schedule *entities.DriverSchedule) error
+	// ListSchedules returns the schedules of the given drivers keyed by driver ID
+	ListSchedules(ctx context.Context, driverIDs []int64) (map[int64]*entities.DriverSchedule, error)
+
+	CreateDayOff(ctx context.Context, dayOff *entities.DriverDayOff) error
+	DeleteDayOff(ctx context.Context, driverID, dayOffID int64) error
+
+	// ListBusyIntervals returns live bookings and days off of the driver overlapping [from, to)
+	ListBusyIntervals(ctx context.Context, driverID int64, from, to time.Time) ([]*entities.BusyInterval, error)
+	// ListAvailableDrivers returns drivers with no live booking or day off overlapping [from, to).
+	// Working schedules are not taken into account.
+	ListAvailableDrivers(ctx context.Context, from, to time.Time) ([]*entities.Driver, error)
 }
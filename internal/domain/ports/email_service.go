@@ -1,8 +1,13 @@
 package ports
 
-import "context"
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
 
 type EmailService interface {
 	SendVerificationCode(ctx context.Context, email, code string) error
 	SendPasswordResetCode(ctx context.Context, email, code string) error
+	SendNewLeadNotification(ctx context.Context, email string, lead *entities.Lead) error
 }
@@ -1,7 +1,16 @@
 package ports
 
+import "github.com/nomad-pixel/imperial/internal/domain/entities"
+
 type ImageService interface {
 	SaveImage(fileData []byte, folderName, fileName string) (string, error)
+	// SaveImageVariants decodes the upload, normalizes its orientation, strips
+	// metadata and stores every variant. The returned path is the full-size
+	// variant and is meant to be kept as the primary image path.
+	SaveImageVariants(fileData []byte, folderName, fileName string) (string, []entities.ImageVariant, error)
 	DeleteImage(imagePath string) error
+	// DeleteImageWithVariants removes the primary file and all variants,
+	// ignoring files that are already gone.
+	DeleteImageWithVariants(imagePath string, variants []entities.ImageVariant) error
 	GetFullImagePath(imagePath string) string
 }
@@ -0,0 +1,21 @@
+package ports
+
+import "io"
+
+// UploadedImage is an image upload that passed validation.
+type UploadedImage struct {
+	Data []byte
+	// FileName is generated by the validator and safe to use in storage
+	// paths; the client supplied name is never used as is.
+	FileName    string
+	Format      string
+	ContentType string
+	Width       int
+	Height      int
+}
+
+type ImageUploadValidator interface {
+	// ReadImage reads an upload, enforcing the size limit while reading, and
+	// checks the real format from magic bytes and the pixel dimensions.
+	ReadImage(r io.Reader, originalName string) (*UploadedImage, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type LeadNoteRepository interface {
+	CreateNote(ctx context.Context, note *entities.LeadNote) error
+	// ListNotes includes the notes of leads merged into leadID
+	ListNotes(ctx context.Context, leadID int64) ([]*entities.LeadNote, error)
+}
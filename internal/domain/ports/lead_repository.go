@@ -2,13 +2,42 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 )
 
+// LeadFilter narrows the lead list. Zero values mean "any"; CreatedFrom is
+// inclusive and CreatedTo exclusive. Merged leads are never listed.
+type LeadFilter struct {
+	Status         entities.LeadStatus
+	AssigneeID     int64
+	Unassigned     bool
+	DuplicatesOnly bool
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+}
+
 type LeadRepository interface {
 	CreateLead(ctx context.Context, lead *entities.Lead) error
 	GetLeadByID(ctx context.Context, id int64) (*entities.Lead, error)
-	ListLeads(ctx context.Context, offset, limit int64) (int64, []*entities.Lead, error)
+	ListLeads(ctx context.Context, offset, limit int64, filter LeadFilter) (int64, []*entities.Lead, error)
 	DeleteLead(ctx context.Context, id int64) error
+	// UpdateLeadStatus stores the new status and appends change to the
+	// status history in one transaction
+	UpdateLeadStatus(ctx context.Context, lead *entities.Lead, change *entities.LeadStatusChange) error
+	UpdateLeadAssignee(ctx context.Context, lead *entities.Lead) error
+	// ListLeadStatusHistory includes the history of leads merged into leadID
+	ListLeadStatusHistory(ctx context.Context, leadID int64) ([]*entities.LeadStatusChange, error)
+	// FindDuplicateCandidates returns unmerged leads created since since that
+	// share the phone or overlap the period of lead: same-phone leads first,
+	// then oldest first
+	FindDuplicateCandidates(ctx context.Context, lead *entities.Lead, since time.Time) ([]*entities.Lead, error)
+	// ListDuplicateGroup returns the unmerged lead rootID and the leads
+	// flagged as its duplicates
+	ListDuplicateGroup(ctx context.Context, rootID int64) ([]*entities.Lead, error)
+	// MergeLeads stores target and marks sources as merged into it. Leads
+	// merged into or flagged as duplicates of a source move to target. Call it
+	// inside a Transactor.
+	MergeLeads(ctx context.Context, target *entities.Lead, sources []*entities.Lead) error
 }
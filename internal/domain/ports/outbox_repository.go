@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type OutboxRepository interface {
+	// Enqueue stores a pending message; call it inside a Transactor to make
+	// the message part of the surrounding change
+	Enqueue(ctx context.Context, msg *entities.OutboxMessage) error
+	// ClaimDue locks up to limit due pending messages for lease, counting the
+	// attempt. A message whose worker dies becomes due again once the lease
+	// expires.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*entities.OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id int64, lastError string) error
+	// DeleteSentBefore removes delivered messages older than before
+	DeleteSentBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimiter interface {
+	// Allow records a hit for key and reports whether it is still within
+	// limit hits per window.
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
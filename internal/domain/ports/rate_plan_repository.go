@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type RatePlanRepository interface {
+	CreateRatePlan(ctx context.Context, plan *entities.RatePlan) error
+	GetRatePlanByID(ctx context.Context, id int64) (*entities.RatePlan, error)
+	ListRatePlans(ctx context.Context, offset, limit int64) (int64, []*entities.RatePlan, error)
+	UpdateRatePlan(ctx context.Context, plan *entities.RatePlan) error
+	DeleteRatePlan(ctx context.Context, id int64) error
+	// FindRatePlanForCar returns the car's own plan, falling back to the plan
+	// of its category. categoryID may be 0 for cars without a category.
+	FindRatePlanForCar(ctx context.Context, carID, categoryID int64) (*entities.RatePlan, error)
+}
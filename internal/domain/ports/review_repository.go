@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type ReviewFilter struct {
+	Status   entities.ReviewStatus
+	CarID    int64
+	DriverID int64
+}
+
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *entities.Review) error
+	GetReviewByID(ctx context.Context, id int64) (*entities.Review, error)
+	GetReviewByBookingID(ctx context.Context, bookingID int64) (*entities.Review, error)
+	ListReviews(ctx context.Context, offset, limit int64, filter ReviewFilter) (int64, []*entities.Review, error)
+	// UpdateReviewModeration stores the moderation decision and refreshes the
+	// rating of the reviewed car and driver in the same transaction. It
+	// returns a conflict when the review is no longer in fromStatus.
+	UpdateReviewModeration(ctx context.Context, review *entities.Review, fromStatus entities.ReviewStatus) error
+	// DeleteReview removes the review and refreshes the affected ratings
+	DeleteReview(ctx context.Context, id int64) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *entities.Session) error
+	GetSessionByJTI(ctx context.Context, jti string) (*entities.Session, error)
+	// RotateSession revokes the current session and stores next in a single
+	// transaction. It returns false if current was already revoked, which
+	// means a concurrent request rotated the same token first.
+	RotateSession(ctx context.Context, currentJTI string, next *entities.Session) (bool, error)
+	RevokeSession(ctx context.Context, jti string) error
+	RevokeSessionFamily(ctx context.Context, familyID string) error
+	RevokeUserSessions(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+// ErrUnknownStaffChannel is returned for a channel that is not configured,
+// e.g. one removed from the config while its messages were still queued
+var ErrUnknownStaffChannel = errors.New("unknown staff notification channel")
+
+// StaffNotifier tells the staff about events that need a reaction, such as a
+// new lead, over a single channel.
+type StaffNotifier interface {
+	NotifyNewLead(ctx context.Context, lead *entities.Lead) error
+}
+
+// StaffNotificationRouter delivers staff notifications over the configured
+// channels. It is driven by the outbox dispatcher, which retries on error;
+// every channel gets its own outbox message.
+type StaffNotificationRouter interface {
+	Channels() []string
+	NotifyNewLead(ctx context.Context, channel string, lead *entities.Lead) error
+}
@@ -1,9 +1,12 @@
 package ports
 
-import "github.com/nomad-pixel/imperial/internal/domain/entities"
+import (
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
 
 type TokenService interface {
-	GenerateTokens(user *entities.User) (*entities.Tokens, error)
-	ValidateAccessToken(token string) (int64, error)
-	RefreshAccessToken(refreshToken string) (string, error)
+	GenerateTokens(user *entities.User, sessionID string) (*entities.Tokens, error)
+	GenerateAccessToken(user *entities.User) (string, error)
+	ValidateAccessToken(token string) (*entities.AccessClaims, error)
+	ParseRefreshToken(refreshToken string) (*entities.RefreshClaims, error)
 }
@@ -0,0 +1,11 @@
+package ports
+
+import "context"
+
+// Transactor runs fn in a single database transaction. Repository calls made
+// with the context passed to fn take part in it; the transaction commits when
+// fn returns nil and rolls back otherwise. Nested calls join the outer
+// transaction.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
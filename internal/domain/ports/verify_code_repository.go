@@ -13,4 +13,7 @@ type VerifyCodeRepository interface {
 	GetVerifyCodeByEmailAndCodeAndType(ctx context.Context, email, code string, verifyCodeType entities.VerifyCodeType) (*entities.VerifyCode, error)
 	GetVerifyCodeByCodeAndType(ctx context.Context, code string, verifyCodeType entities.VerifyCodeType) (*entities.VerifyCode, error)
 	UpdateVerifyCode(ctx context.Context, verifyCode *entities.VerifyCode) (*entities.VerifyCode, error)
+	// UseVerifyCode marks the code as used unless it already is, and returns
+	// ErrVerifyCodeAlreadyUsed otherwise, so a code can be spent only once
+	UseVerifyCode(ctx context.Context, verifyCode *entities.VerifyCode) error
 }
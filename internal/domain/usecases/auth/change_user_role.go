@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type ChangeUserRoleUsecase interface {
+	Execute(ctx context.Context, actorID, userID int64, role entities.UserRole) (*entities.User, error)
+}
+
+type changeUserRoleUsecase struct {
+	userRepo    ports.UserRepository
+	sessionRepo ports.SessionRepository
+	transactor  ports.Transactor
+}
+
+func NewChangeUserRoleUsecase(
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	transactor ports.Transactor,
+) ChangeUserRoleUsecase {
+	return &changeUserRoleUsecase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		transactor:  transactor,
+	}
+}
+
+// Execute grants role to the user. Admins cannot change their own role, so
+// the last admin cannot lock everyone out. The role is carried in the access
+// token, so the user's sessions are revoked with the change: the old role
+// lives only until the current access token expires and cannot be refreshed.
+func (u *changeUserRoleUsecase) Execute(ctx context.Context, actorID, userID int64, role entities.UserRole) (*entities.User, error) {
+	if actorID == userID {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "Нельзя изменить собственную роль")
+	}
+
+	user, err := u.userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.SetRole(role); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+	}
+
+	var updated *entities.User
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = u.userRepo.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		return u.sessionRepo.RevokeUserSessions(ctx, user.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
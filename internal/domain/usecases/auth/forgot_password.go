@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
+)
+
+const passwordResetCodeTTL = 15 * time.Minute
+
+// ForgotPasswordLimits caps how many reset codes can be requested per email
+// and per client IP within Window, so codes cannot be minted in bulk and a
+// victim's inbox cannot be flooded.
+type ForgotPasswordLimits struct {
+	PerEmail int
+	PerIP    int
+	Window   time.Duration
+}
+
+type ForgotPasswordUsecase interface {
+	Execute(ctx context.Context, email, remoteIP string) error
+}
+
+type forgotPasswordUsecase struct {
+	userRepo       ports.UserRepository
+	verifyCodeRepo ports.VerifyCodeRepository
+	outboxRepo     ports.OutboxRepository
+	transactor     ports.Transactor
+	rateLimiter    ports.RateLimiter
+	limits         ForgotPasswordLimits
+}
+
+func NewForgotPasswordUsecase(
+	userRepo ports.UserRepository,
+	verifyCodeRepo ports.VerifyCodeRepository,
+	outboxRepo ports.OutboxRepository,
+	transactor ports.Transactor,
+	rateLimiter ports.RateLimiter,
+	limits ForgotPasswordLimits,
+) ForgotPasswordUsecase {
+	return &forgotPasswordUsecase{
+		userRepo:       userRepo,
+		verifyCodeRepo: verifyCodeRepo,
+		outboxRepo:     outboxRepo,
+		transactor:     transactor,
+		rateLimiter:    rateLimiter,
+		limits:         limits,
+	}
+}
+
+func (u *forgotPasswordUsecase) Execute(ctx context.Context, email, remoteIP string) error {
+	// Counted for unknown emails too, so the limit does not reveal which
+	// emails are registered
+	emailKey := "forgot-password:email:" + strings.ToLower(strings.TrimSpace(email))
+	if err := u.allow(ctx, emailKey, u.limits.PerEmail); err != nil {
+		return err
+	}
+	if remoteIP != "" {
+		if err := u.allow(ctx, "forgot-password:ip:"+remoteIP, u.limits.PerIP); err != nil {
+			return err
+		}
+	}
+
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		// Do not reveal whether the email is registered
+		if errors.Is(err, apperrors.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	code, err := utils.GenerateVerificationCode(6)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка генерации кода сброса пароля")
+	}
+
+	// Sent from the outbox after commit, like the verification email. A user
+	// has one reset code at a time, so issuing it invalidates the previous one
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		verifyCode, err := u.verifyCodeRepo.CreateVerifyCode(ctx, code, user.ID, entities.VerifyCodeTypePasswordReset, time.Now().Add(passwordResetCodeTTL))
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка создания кода сброса пароля")
+		}
+
+		msg, err := entities.NewOutboxMessage(entities.OutboxTopicPasswordResetEmail, entities.EmailCodePayload{
+			Email: user.Email,
+			Code:  verifyCode.Code,
+		})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования email")
+		}
+		return u.outboxRepo.Enqueue(ctx, msg)
+	})
+}
+
+func (u *forgotPasswordUsecase) allow(ctx context.Context, key string, limit int) error {
+	allowed, err := u.rateLimiter.Allow(ctx, key, limit, u.limits.Window)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+	}
+	if !allowed {
+		return apperrors.ErrTooManyRequests
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type LogoutUsecase interface {
+	Execute(ctx context.Context, refreshToken string) error
+}
+
+type logoutUsecase struct {
+	tokenSvc    ports.TokenService
+	sessionRepo ports.SessionRepository
+}
+
+func NewLogoutUsecase(tokenSvc ports.TokenService, sessionRepo ports.SessionRepository) LogoutUsecase {
+	return &logoutUsecase{
+		tokenSvc:    tokenSvc,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (u *logoutUsecase) Execute(ctx context.Context, refreshToken string) error {
+	claims, err := u.tokenSvc.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	session, err := u.sessionRepo.GetSessionByJTI(ctx, claims.JTI)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSessionNotFound) {
+			return apperrors.ErrUnauthorized
+		}
+		return err
+	}
+	if session.UserID != claims.UserID {
+		return apperrors.ErrUnauthorized
+	}
+
+	return u.sessionRepo.RevokeSession(ctx, session.JTI)
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type LogoutAllUsecase interface {
+	Execute(ctx context.Context, userID int64) error
+}
+
+type logoutAllUsecase struct {
+	sessionRepo ports.SessionRepository
+}
+
+func NewLogoutAllUsecase(sessionRepo ports.SessionRepository) LogoutAllUsecase {
+	return &logoutAllUsecase{sessionRepo: sessionRepo}
+}
+
+func (u *logoutAllUsecase) Execute(ctx context.Context, userID int64) error {
+	return u.sessionRepo.RevokeUserSessions(ctx, userID)
+}
@@ -2,22 +2,93 @@ package usecases
 
 import (
 	"context"
+	"errors"
 
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type RefreshTokenUsecase interface {
-	Execute(ctx context.Context, refreshToken string) (newAccessToken string, err error)
+	Execute(ctx context.Context, refreshToken, userAgent, ipAddress string) (*entities.Tokens, error)
 }
 
 type refreshTokenUsecase struct {
-	tokenSvc ports.TokenService
+	tokenSvc    ports.TokenService
+	userRepo    ports.UserRepository
+	sessionRepo ports.SessionRepository
 }
 
-func NewRefreshTokenUsecase(tokenSvc ports.TokenService) RefreshTokenUsecase {
-	return &refreshTokenUsecase{tokenSvc: tokenSvc}
+func NewRefreshTokenUsecase(tokenSvc ports.TokenService, userRepo ports.UserRepository, sessionRepo ports.SessionRepository) RefreshTokenUsecase {
+	return &refreshTokenUsecase{
+		tokenSvc:    tokenSvc,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
 }
 
-func (u *refreshTokenUsecase) Execute(ctx context.Context, refreshToken string) (string, error) {
-	return u.tokenSvc.RefreshAccessToken(refreshToken)
+func (u *refreshTokenUsecase) Execute(ctx context.Context, refreshToken, userAgent, ipAddress string) (*entities.Tokens, error) {
+	claims, err := u.tokenSvc.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := u.sessionRepo.GetSessionByJTI(ctx, claims.JTI)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSessionNotFound) {
+			return nil, apperrors.ErrUnauthorized
+		}
+		return nil, err
+	}
+	if session.UserID != claims.UserID {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	// An already rotated token being presented again means it was stolen:
+	// kill the whole family so neither the thief nor the victim can go on
+	if session.IsRotated() {
+		if err := u.sessionRepo.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, apperrors.ErrRefreshTokenReused
+	}
+	if session.IsRevoked() || session.IsExpired() {
+		return nil, apperrors.ErrSessionRevoked
+	}
+
+	user, err := u.userRepo.GetUserById(ctx, claims.UserID)
+	if err != nil {
+		return nil, apperrors.ErrUnauthorized
+	}
+	if user.IsTokenRevoked(claims.IssuedAt) {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	jti, err := entities.NewSessionID()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка генерации идентификатора сессии")
+	}
+	tokens, err := u.tokenSvc.GenerateTokens(user, jti)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := entities.NewSession(jti, session.FamilyID, user.ID, userAgent, ipAddress, tokens.RefreshExpiresAt)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка создания сессии")
+	}
+
+	rotated, err := u.sessionRepo.RotateSession(ctx, session.JTI, next)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// Another request rotated this token between our read and write
+		if err := u.sessionRepo.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, apperrors.ErrRefreshTokenReused
+	}
+
+	return tokens, nil
 }
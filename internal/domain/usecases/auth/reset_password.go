@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ResetPasswordLimits caps the reset attempts per email within Window, so the
+// 6-digit code cannot be guessed during its lifetime.
+type ResetPasswordLimits struct {
+	PerEmail int
+	Window   time.Duration
+}
+
+type ResetPasswordUsecase interface {
+	Execute(ctx context.Context, email, code, newPassword string) error
+}
+
+type resetPasswordUsecase struct {
+	verifyCodeRepo ports.VerifyCodeRepository
+	userRepo       ports.UserRepository
+	sessionRepo    ports.SessionRepository
+	transactor     ports.Transactor
+	rateLimiter    ports.RateLimiter
+	limits         ResetPasswordLimits
+}
+
+func NewResetPasswordUsecase(
+	verifyCodeRepo ports.VerifyCodeRepository,
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	transactor ports.Transactor,
+	rateLimiter ports.RateLimiter,
+	limits ResetPasswordLimits,
+) ResetPasswordUsecase {
+	return &resetPasswordUsecase{
+		verifyCodeRepo: verifyCodeRepo,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		transactor:     transactor,
+		rateLimiter:    rateLimiter,
+		limits:         limits,
+	}
+}
+
+func (u *resetPasswordUsecase) Execute(ctx context.Context, email, code, newPassword string) error {
+	if len(newPassword) < 8 {
+		return apperrors.ErrPasswordTooShort
+	}
+
+	// Every attempt counts, whether the code is right or not
+	emailKey := strings.ToLower(strings.TrimSpace(email))
+	allowed, err := u.rateLimiter.Allow(ctx, "reset-password:email:"+emailKey, u.limits.PerEmail, u.limits.Window)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+	}
+	if !allowed {
+		return apperrors.ErrTooManyRequests
+	}
+
+	verifyCode, err := u.verifyCodeRepo.GetVerifyCodeByEmailAndCodeAndType(
+		ctx,
+		email,
+		code,
+		entities.VerifyCodeTypePasswordReset,
+	)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrVerifyCodeNotFound) {
+			return apperrors.ErrVerifyCodeNotFound
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка получения кода сброса пароля")
+	}
+
+	if verifyCode.IsUsed {
+		return apperrors.ErrVerifyCodeAlreadyUsed
+	}
+
+	if verifyCode.ExpiresAt.Before(time.Now()) {
+		return apperrors.ErrVerifyCodeExpired
+	}
+
+	user, err := u.userRepo.GetUserById(ctx, verifyCode.UserID)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка хеширования пароля")
+	}
+
+	if err := user.ChangePassword(string(passwordHash)); err != nil {
+		return apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	// Spending the code, changing the password and revoking the sessions
+	// succeed or fail together
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.verifyCodeRepo.UseVerifyCode(ctx, verifyCode); err != nil {
+			return err
+		}
+
+		// Bumping password_changed_at revokes every refresh token issued before it
+		if _, err := u.userRepo.UpdateUser(ctx, user); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка обновления пароля")
+		}
+
+		return u.sessionRepo.RevokeUserSessions(ctx, user.ID)
+	})
+}
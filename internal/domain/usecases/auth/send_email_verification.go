@@ -18,18 +18,21 @@ type SendEmailVerificationUsecase interface {
 type sendEmailVerificationUsecase struct {
 	userRepo       ports.UserRepository
 	verifyCodeRepo ports.VerifyCodeRepository
-	emailService   ports.EmailService
+	outboxRepo     ports.OutboxRepository
+	transactor     ports.Transactor
 }
 
 func NewSendEmailVerificationUsecase(
 	userRepo ports.UserRepository,
 	verifyCodeRepo ports.VerifyCodeRepository,
-	emailService ports.EmailService,
+	outboxRepo ports.OutboxRepository,
+	transactor ports.Transactor,
 ) SendEmailVerificationUsecase {
 	return &sendEmailVerificationUsecase{
 		userRepo:       userRepo,
 		verifyCodeRepo: verifyCodeRepo,
-		emailService:   emailService,
+		outboxRepo:     outboxRepo,
+		transactor:     transactor,
 	}
 }
 
@@ -46,14 +49,22 @@ func (u *sendEmailVerificationUsecase) Execute(ctx context.Context, email string
 	if err != nil {
 		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка генерации кода верификации")
 	}
-	verifyCode, err := u.verifyCodeRepo.CreateVerifyCode(ctx, code, user.ID, entities.VerifyCodeTypeEmailVerification, time.Now().Add(5*time.Minute))
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка создания кода верификации")
-	}
-	err = u.emailService.SendVerificationCode(ctx, email, verifyCode.Code)
-	if err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeExternal, "Ошибка отправки email")
-	}
-	return nil
 
+	// The code and its email are stored together; the email is sent by the
+	// outbox dispatcher, so a slow or failing SMTP server does not fail the request
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		verifyCode, err := u.verifyCodeRepo.CreateVerifyCode(ctx, code, user.ID, entities.VerifyCodeTypeEmailVerification, time.Now().Add(5*time.Minute))
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка создания кода верификации")
+		}
+
+		msg, err := entities.NewOutboxMessage(entities.OutboxTopicVerificationEmail, entities.EmailCodePayload{
+			Email: email,
+			Code:  verifyCode.Code,
+		})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования email")
+		}
+		return u.outboxRepo.Enqueue(ctx, msg)
+	})
 }
@@ -11,22 +11,24 @@ import (
 )
 
 type signInUsecase struct {
-	userRepo ports.UserRepository
-	tokenSvc ports.TokenService
+	userRepo    ports.UserRepository
+	sessionRepo ports.SessionRepository
+	tokenSvc    ports.TokenService
 }
 
 type SignInUsecase interface {
-	Execute(ctx context.Context, email, password string) (*entities.User, *entities.Tokens, error)
+	Execute(ctx context.Context, email, password, userAgent, ipAddress string) (*entities.User, *entities.Tokens, error)
 }
 
-func NewSignInUsecase(userRepo ports.UserRepository, tokenSvc ports.TokenService) SignInUsecase {
+func NewSignInUsecase(userRepo ports.UserRepository, sessionRepo ports.SessionRepository, tokenSvc ports.TokenService) SignInUsecase {
 	return &signInUsecase{
-		userRepo: userRepo,
-		tokenSvc: tokenSvc,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		tokenSvc:    tokenSvc,
 	}
 }
 
-func (u *signInUsecase) Execute(ctx context.Context, email, password string) (*entities.User, *entities.Tokens, error) {
+func (u *signInUsecase) Execute(ctx context.Context, email, password, userAgent, ipAddress string) (*entities.User, *entities.Tokens, error) {
 	user, err := u.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, nil, apperrors.ErrUserNotFound
@@ -38,8 +40,22 @@ func (u *signInUsecase) Execute(ctx context.Context, email, password string) (*e
 	if err != nil {
 		return nil, nil, apperrors.ErrInvalidCredentials
 	}
-	tokens, err := u.tokenSvc.GenerateTokens(user)
+
+	jti, err := entities.NewSessionID()
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка генерации идентификатора сессии")
+	}
+	tokens, err := u.tokenSvc.GenerateTokens(user, jti)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A fresh sign-in starts a new token family
+	session, err := entities.NewSession(jti, jti, user.ID, userAgent, ipAddress, tokens.RefreshExpiresAt)
 	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка создания сессии")
+	}
+	if err := u.sessionRepo.CreateSession(ctx, session); err != nil {
 		return nil, nil, err
 	}
 
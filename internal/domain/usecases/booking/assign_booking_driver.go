@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type assignBookingDriverUsecase struct {
+	bookingRepo ports.BookingRepository
+	carRepo     ports.CarRepository
+	driverRepo  ports.DriverRepository
+	location    *time.Location
+}
+
+type AssignBookingDriverUsecase interface {
+	// Execute assigns the driver to the booking, or removes the current one
+	// when driverID is nil. The price quote is kept as agreed.
+	Execute(ctx context.Context, bookingID int64, driverID *int64) (*entities.Booking, error)
+}
+
+func NewAssignBookingDriverUsecase(
+	bookingRepo ports.BookingRepository,
+	carRepo ports.CarRepository,
+	driverRepo ports.DriverRepository,
+	location *time.Location,
+) AssignBookingDriverUsecase {
+	return &assignBookingDriverUsecase{
+		bookingRepo: bookingRepo,
+		carRepo:     carRepo,
+		driverRepo:  driverRepo,
+		location:    location,
+	}
+}
+
+func (u *assignBookingDriverUsecase) Execute(ctx context.Context, bookingID int64, driverID *int64) (*entities.Booking, error) {
+	booking, err := u.bookingRepo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.IsFinal() {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "driver of a completed or cancelled booking cannot be changed")
+	}
+
+	if driverID == nil {
+		car, err := u.carRepo.GetCarByID(ctx, booking.CarID)
+		if err != nil {
+			return nil, err
+		}
+		if car.OnlyWithDriver {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "this car can only be booked with a driver")
+		}
+	} else {
+		if booking.DriverID != nil && *booking.DriverID == *driverID {
+			return booking, nil
+		}
+		if _, err := u.driverRepo.GetDriverByID(ctx, *driverID); err != nil {
+			return nil, err
+		}
+		if err := ensureDriverAvailable(ctx, u.driverRepo, *driverID, booking.StartDate, booking.EndDate, u.location); err != nil {
+			return nil, err
+		}
+	}
+
+	booking.AssignDriver(driverID)
+	if err := u.bookingRepo.UpdateBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	pricingUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/pricing"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// CreateBookingInput describes a new booking. When LeadID is set, empty
+// customer fields and zero dates are taken from the lead.
+type CreateBookingInput struct {
+	CarID         int64
+	DriverID      *int64
+	LeadID        *int64
+	CustomerName  string
+	CustomerPhone string
+	StartDate     time.Time
+	EndDate       time.Time
+	Comment       string
+}
+
+type createBookingUsecase struct {
+	bookingRepo      ports.BookingRepository
+	carRepo          ports.CarRepository
+	driverRepo       ports.DriverRepository
+	leadRepo         ports.LeadRepository
+	availabilityRepo ports.CarAvailabilityRepository
+	getQuote         pricingUsecases.GetPriceQuoteUsecase
+	transactor       ports.Transactor
+	location         *time.Location
+}
+
+type CreateBookingUsecase interface {
+	Execute(ctx context.Context, input CreateBookingInput) (*entities.Booking, error)
+}
+
+func NewCreateBookingUsecase(
+	bookingRepo ports.BookingRepository,
+	carRepo ports.CarRepository,
+	driverRepo ports.DriverRepository,
+	leadRepo ports.LeadRepository,
+	availabilityRepo ports.CarAvailabilityRepository,
+	getQuote pricingUsecases.GetPriceQuoteUsecase,
+	transactor ports.Transactor,
+	location *time.Location,
+) CreateBookingUsecase {
+	return &createBookingUsecase{
+		bookingRepo:      bookingRepo,
+		carRepo:          carRepo,
+		driverRepo:       driverRepo,
+		leadRepo:         leadRepo,
+		availabilityRepo: availabilityRepo,
+		getQuote:         getQuote,
+		transactor:       transactor,
+		location:         location,
+	}
+}
+
+func (u *createBookingUsecase) Execute(ctx context.Context, input CreateBookingInput) (*entities.Booking, error) {
+	car, err := u.carRepo.GetCarByID(ctx, input.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DriverID != nil {
+		if _, err := u.driverRepo.GetDriverByID(ctx, *input.DriverID); err != nil {
+			return nil, err
+		}
+	} else if car.OnlyWithDriver {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "this car can only be booked with a driver")
+	}
+
+	if input.LeadID != nil {
+		lead, err := u.leadRepo.GetLeadByID(ctx, *input.LeadID)
+		if err != nil {
+			return nil, err
+		}
+		if input.CustomerName == "" {
+			input.CustomerName = lead.FullName
+		}
+		if input.CustomerPhone == "" {
+			input.CustomerPhone = lead.Phone
+		}
+		if input.StartDate.IsZero() {
+			input.StartDate = lead.StartDate
+		}
+		if input.EndDate.IsZero() {
+			input.EndDate = lead.EndDate
+		}
+	}
+
+	booking, err := entities.NewBooking(
+		input.CarID,
+		input.DriverID,
+		input.LeadID,
+		input.CustomerName,
+		input.CustomerPhone,
+		input.StartDate,
+		input.EndDate,
+		input.Comment,
+	)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	if booking.DriverID != nil {
+		if err := ensureDriverAvailable(ctx, u.driverRepo, *booking.DriverID, booking.StartDate, booking.EndDate, u.location); err != nil {
+			return nil, err
+		}
+	}
+
+	quote, err := u.getQuote.Execute(ctx, booking.CarID, booking.StartDate, booking.EndDate, booking.DriverID != nil)
+	if err != nil {
+		return nil, err
+	}
+	booking.SetQuote(quote)
+
+	// Overlapping bookings are rejected by the exclusion constraint, but
+	// maintenance blocks live in their own table and have to be checked here,
+	// under the car row lock so a block cannot be added in between
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.availabilityRepo.LockCar(ctx, booking.CarID); err != nil {
+			return err
+		}
+
+		busy, err := u.availabilityRepo.ListBusyIntervals(ctx, booking.CarID, booking.StartDate, booking.EndDate)
+		if err != nil {
+			return err
+		}
+		for _, interval := range busy {
+			if interval.Kind == entities.BusyIntervalKindMaintenance {
+				return apperrors.ErrCarUnavailable
+			}
+		}
+
+		return u.bookingRepo.CreateBooking(ctx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
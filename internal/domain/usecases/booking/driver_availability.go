@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// ensureDriverAvailable checks that the driver works during [start, end) and
+// is not on a day off or another booking. The bookings exclusion constraint
+// still guards against two assignments racing each other. Shifts are read in
+// loc, the business timezone.
+func ensureDriverAvailable(ctx context.Context, driverRepo ports.DriverRepository, driverID int64, start, end time.Time, loc *time.Location) error {
+	busy, err := driverRepo.ListBusyIntervals(ctx, driverID, start, end)
+	if err != nil {
+		return err
+	}
+	for _, interval := range busy {
+		if interval.Kind == entities.BusyIntervalKindBooking {
+			return apperrors.ErrDriverAlreadyBooked
+		}
+	}
+	if len(busy) > 0 {
+		return apperrors.ErrDriverUnavailable
+	}
+
+	schedule, err := driverRepo.GetSchedule(ctx, driverID)
+	if err != nil {
+		return err
+	}
+	if !schedule.Covers(start, end, loc) {
+		return apperrors.ErrDriverUnavailable
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getBookingByIdUsecase struct {
+	bookingRepo ports.BookingRepository
+}
+
+type GetBookingByIdUsecase interface {
+	Execute(ctx context.Context, id int64) (*entities.Booking, error)
+}
+
+func NewGetBookingByIdUsecase(bookingRepo ports.BookingRepository) GetBookingByIdUsecase {
+	return &getBookingByIdUsecase{bookingRepo: bookingRepo}
+}
+
+func (u *getBookingByIdUsecase) Execute(ctx context.Context, id int64) (*entities.Booking, error) {
+	return u.bookingRepo.GetBookingByID(ctx, id)
+}
@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type listBookingsUsecase struct {
+	bookingRepo ports.BookingRepository
+}
+
+type ListBookingsUsecase interface {
+	Execute(ctx context.Context, offset, limit int64, filter ports.BookingFilter) (int64, []*entities.Booking, error)
+}
+
+func NewListBookingsUsecase(bookingRepo ports.BookingRepository) ListBookingsUsecase {
+	return &listBookingsUsecase{bookingRepo: bookingRepo}
+}
+
+func (u *listBookingsUsecase) Execute(ctx context.Context, offset, limit int64, filter ports.BookingFilter) (int64, []*entities.Booking, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return 0, nil, apperrors.New(apperrors.ErrCodeValidation, "invalid booking status")
+	}
+	return u.bookingRepo.ListBookings(ctx, offset, limit, filter)
+}
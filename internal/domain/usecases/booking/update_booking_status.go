@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type updateBookingStatusUsecase struct {
+	bookingRepo ports.BookingRepository
+}
+
+type UpdateBookingStatusUsecase interface {
+	Execute(ctx context.Context, id int64, status entities.BookingStatus) (*entities.Booking, error)
+}
+
+func NewUpdateBookingStatusUsecase(bookingRepo ports.BookingRepository) UpdateBookingStatusUsecase {
+	return &updateBookingStatusUsecase{bookingRepo: bookingRepo}
+}
+
+func (u *updateBookingStatusUsecase) Execute(ctx context.Context, id int64, status entities.BookingStatus) (*entities.Booking, error) {
+	booking, err := u.bookingRepo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := booking.TransitionTo(status); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, err.Error())
+	}
+
+	if err := u.bookingRepo.UpdateBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"io"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
@@ -9,36 +10,42 @@ import (
 )
 
 type createCarImageUsecase struct {
-	carImageRepo ports.CarImageRepository
-	imageService ports.ImageService
+	carImageRepo    ports.CarImageRepository
+	imageService    ports.ImageService
+	uploadValidator ports.ImageUploadValidator
 }
 
 type CreateCarImageUsecase interface {
-	Execute(ctx context.Context, carID int64, fileData []byte, fileName string) (*entities.CarImage, error)
+	Execute(ctx context.Context, carID int64, file io.Reader, fileName string) (*entities.CarImage, error)
 }
 
-func NewCreateCarImageUsecase(carImageRepo ports.CarImageRepository, imageService ports.ImageService) CreateCarImageUsecase {
+func NewCreateCarImageUsecase(
+	carImageRepo ports.CarImageRepository,
+	imageService ports.ImageService,
+	uploadValidator ports.ImageUploadValidator,
+) CreateCarImageUsecase {
 	return &createCarImageUsecase{
-		carImageRepo: carImageRepo,
-		imageService: imageService,
+		carImageRepo:    carImageRepo,
+		imageService:    imageService,
+		uploadValidator: uploadValidator,
 	}
 }
 
-func (u *createCarImageUsecase) Execute(ctx context.Context, carID int64, fileData []byte, fileName string) (*entities.CarImage, error) {
-
-	if len(fileData) == 0 {
-		return nil, apperrors.New(apperrors.ErrCodeValidation, "image file is empty")
+func (u *createCarImageUsecase) Execute(ctx context.Context, carID int64, file io.Reader, fileName string) (*entities.CarImage, error) {
+	upload, err := u.uploadValidator.ReadImage(file, fileName)
+	if err != nil {
+		return nil, err
 	}
 
-	imagePath, err := u.imageService.SaveImage(fileData, "cars", fileName)
+	imagePath, variants, err := u.imageService.SaveImageVariants(upload.Data, "cars", upload.FileName)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to save image: %v")
+		return nil, err
 	}
 	var carImage *entities.CarImage
-	carImage, err = u.carImageRepo.Save(ctx, carID, imagePath)
+	carImage, err = u.carImageRepo.Save(ctx, carID, imagePath, variants)
 
 	if err != nil {
-		err = u.imageService.DeleteImage(imagePath)
+		err = u.imageService.DeleteImageWithVariants(imagePath, variants)
 		if err != nil {
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to cleanup image after DB failure")
 		}
@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type createCarMaintenanceBlockUsecase struct {
+	carRepo          ports.CarRepository
+	availabilityRepo ports.CarAvailabilityRepository
+	transactor       ports.Transactor
+}
+
+type CreateCarMaintenanceBlockUsecase interface {
+	Execute(ctx context.Context, carID int64, startDate, endDate time.Time, reason string) (*entities.CarMaintenanceBlock, error)
+}
+
+func NewCreateCarMaintenanceBlockUsecase(
+	carRepo ports.CarRepository,
+	availabilityRepo ports.CarAvailabilityRepository,
+	transactor ports.Transactor,
+) CreateCarMaintenanceBlockUsecase {
+	return &createCarMaintenanceBlockUsecase{
+		carRepo:          carRepo,
+		availabilityRepo: availabilityRepo,
+		transactor:       transactor,
+	}
+}
+
+func (u *createCarMaintenanceBlockUsecase) Execute(ctx context.Context, carID int64, startDate, endDate time.Time, reason string) (*entities.CarMaintenanceBlock, error) {
+	if _, err := u.carRepo.GetCarByID(ctx, carID); err != nil {
+		return nil, err
+	}
+
+	block, err := entities.NewCarMaintenanceBlock(carID, startDate, endDate, reason)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	// Bookings have to be moved or cancelled before the car can be taken out.
+	// The car row lock keeps a booking from slipping in between the check
+	// and the insert
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.availabilityRepo.LockCar(ctx, carID); err != nil {
+			return err
+		}
+
+		busy, err := u.availabilityRepo.ListBusyIntervals(ctx, carID, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		for _, interval := range busy {
+			if interval.Kind == entities.BusyIntervalKindBooking {
+				return apperrors.ErrCarAlreadyBooked
+			}
+		}
+
+		return u.availabilityRepo.CreateMaintenanceBlock(ctx, block)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
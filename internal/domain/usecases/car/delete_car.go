@@ -35,19 +35,16 @@ func (u *deleteCarUsecase) Execute(ctx context.Context, carID int64) error {
 		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to get car images")
 	}
 
-	for _, image := range images {
-		if err := u.imageService.DeleteImage(image.ImagePath); err != nil {
-			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image file")
-		}
-
-		if err := u.carImageRepo.Delete(ctx, image.ID); err != nil {
-			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image from database")
-		}
+	// A car with bookings cannot be deleted, so the row goes first and the
+	// gallery stays intact when that fails
+	if err := u.carRepo.DeleteCar(ctx, carID); err != nil {
+		return err
 	}
 
-	err = u.carRepo.DeleteCar(ctx, carID)
-	if err != nil {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "failed to delete car")
+	// The image rows are removed by the cascade; the files are cleaned up
+	// once the car is gone
+	for _, image := range images {
+		_ = u.imageService.DeleteImageWithVariants(image.ImagePath, image.Variants)
 	}
 	return nil
 }
@@ -29,7 +29,7 @@ func (u *deleteCarImageUsecase) Execute(ctx context.Context, imageID int64) erro
 		return apperrors.New(apperrors.ErrCodeInternal, "failed to get car image from repository")
 	}
 
-	err = u.imageService.DeleteImage(image.ImagePath)
+	err = u.imageService.DeleteImageWithVariants(image.ImagePath, image.Variants)
 
 	if err != nil {
 		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete car image file")
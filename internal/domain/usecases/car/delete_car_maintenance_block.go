@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type deleteCarMaintenanceBlockUsecase struct {
+	availabilityRepo ports.CarAvailabilityRepository
+}
+
+type DeleteCarMaintenanceBlockUsecase interface {
+	Execute(ctx context.Context, carID, blockID int64) error
+}
+
+func NewDeleteCarMaintenanceBlockUsecase(availabilityRepo ports.CarAvailabilityRepository) DeleteCarMaintenanceBlockUsecase {
+	return &deleteCarMaintenanceBlockUsecase{availabilityRepo: availabilityRepo}
+}
+
+func (u *deleteCarMaintenanceBlockUsecase) Execute(ctx context.Context, carID, blockID int64) error {
+	return u.availabilityRepo.DeleteMaintenanceBlock(ctx, carID, blockID)
+}
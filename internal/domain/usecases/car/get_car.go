@@ -5,7 +5,6 @@ import (
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
-	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type getCarByIdUsecase struct {
@@ -23,7 +22,7 @@ func NewGetCarByIdUsecase(carRepo ports.CarRepository) GetCarByIdUsecase {
 func (u *getCarByIdUsecase) Execute(ctx context.Context, carID int64) (*entities.Car, error) {
 	car, err := u.carRepo.GetCarByID(ctx, carID)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeNotFound, "car not found")
+		return nil, err
 	}
 	return car, nil
 }
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getCarCalendarUsecase struct {
+	carRepo          ports.CarRepository
+	availabilityRepo ports.CarAvailabilityRepository
+}
+
+type GetCarCalendarUsecase interface {
+	// Execute returns the busy intervals of the car within the given month (UTC)
+	Execute(ctx context.Context, carID int64, year int, month time.Month) (*entities.CarCalendar, error)
+}
+
+func NewGetCarCalendarUsecase(carRepo ports.CarRepository, availabilityRepo ports.CarAvailabilityRepository) GetCarCalendarUsecase {
+	return &getCarCalendarUsecase{
+		carRepo:          carRepo,
+		availabilityRepo: availabilityRepo,
+	}
+}
+
+func (u *getCarCalendarUsecase) Execute(ctx context.Context, carID int64, year int, month time.Month) (*entities.CarCalendar, error) {
+	if _, err := u.carRepo.GetCarByID(ctx, carID); err != nil {
+		return nil, err
+	}
+
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	busy, err := u.availabilityRepo.ListBusyIntervals(ctx, carID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.CarCalendar{
+		CarID: carID,
+		From:  from,
+		To:    to,
+		Busy:  busy,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type getCarFacetsUsecase struct {
+	carRepo ports.CarRepository
+}
+
+type GetCarFacetsUsecase interface {
+	Execute(ctx context.Context, filter ports.CarListFilter) (*entities.CarFacets, error)
+}
+
+func NewGetCarFacetsUsecase(carRepo ports.CarRepository) GetCarFacetsUsecase {
+	return &getCarFacetsUsecase{carRepo: carRepo}
+}
+
+func (u *getCarFacetsUsecase) Execute(ctx context.Context, filter ports.CarListFilter) (*entities.CarFacets, error) {
+	if err := validateCarListFilter(filter); err != nil {
+		return nil, err
+	}
+
+	facets, err := u.carRepo.GetCarFacets(ctx, filter)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get car facets")
+	}
+	return facets, nil
+}
@@ -13,17 +13,45 @@ type getListCarsUsecase struct {
 }
 
 type GetListCarsUsecase interface {
-	Execute(ctx context.Context, offset int64, limit int64, name string, markID int64, categoryID int64) (int64, []*entities.Car, error)
+	Execute(ctx context.Context, offset int64, limit int64, filter ports.CarListFilter) (int64, []*entities.Car, error)
 }
 
 func NewGetListCarsUsecase(carRepo ports.CarRepository) GetListCarsUsecase {
 	return &getListCarsUsecase{carRepo: carRepo}
 }
 
-func (u *getListCarsUsecase) Execute(ctx context.Context, offset int64, limit int64, name string, markID int64, categoryID int64) (int64, []*entities.Car, error) {
-	total, cars, err := u.carRepo.ListCars(ctx, offset, limit, name, markID, categoryID)
+func (u *getListCarsUsecase) Execute(ctx context.Context, offset int64, limit int64, filter ports.CarListFilter) (int64, []*entities.Car, error) {
+	if err := validateCarListFilter(filter); err != nil {
+		return 0, nil, err
+	}
+
+	total, cars, err := u.carRepo.ListCars(ctx, offset, limit, filter)
 	if err != nil {
 		return 0, nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get cars list")
 	}
 	return total, cars, nil
 }
+
+// validateCarListFilter rejects filter combinations the repository cannot
+// express. It is shared by the car list and its facets.
+func validateCarListFilter(filter ports.CarListFilter) error {
+	if filter.AvailableFrom.IsZero() != filter.AvailableTo.IsZero() {
+		return apperrors.New(apperrors.ErrCodeValidation, "available_from and available_to must be set together")
+	}
+	if !filter.AvailableFrom.IsZero() && !filter.AvailableTo.After(filter.AvailableFrom) {
+		return apperrors.New(apperrors.ErrCodeValidation, "available_to must be after available_from")
+	}
+	if filter.PriceFrom < 0 || filter.PriceTo < 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "price range cannot be negative")
+	}
+	if filter.PriceTo != 0 && filter.PriceFrom > filter.PriceTo {
+		return apperrors.New(apperrors.ErrCodeValidation, "price_from cannot be greater than price_to")
+	}
+	if filter.TagMatch != "" && !filter.TagMatch.IsValid() {
+		return apperrors.New(apperrors.ErrCodeValidation, "tag_match must be any or all")
+	}
+	if filter.Sort != "" && !filter.Sort.IsValid() {
+		return apperrors.New(apperrors.ErrCodeValidation, "invalid sort")
+	}
+	return nil
+}
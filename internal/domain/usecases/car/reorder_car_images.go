@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type reorderCarImagesUsecase struct {
+	carImageRepo ports.CarImageRepository
+}
+
+type ReorderCarImagesUsecase interface {
+	Execute(ctx context.Context, carID int64, imageIDs []int64) ([]*entities.CarImage, error)
+}
+
+func NewReorderCarImagesUsecase(carImageRepository ports.CarImageRepository) ReorderCarImagesUsecase {
+	return &reorderCarImagesUsecase{
+		carImageRepo: carImageRepository,
+	}
+}
+
+// Execute stores the gallery in the order of imageIDs, which must contain
+// every image of the car exactly once.
+func (u *reorderCarImagesUsecase) Execute(ctx context.Context, carID int64, imageIDs []int64) ([]*entities.CarImage, error) {
+	if len(imageIDs) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "image_ids cannot be empty")
+	}
+
+	seen := make(map[int64]struct{}, len(imageIDs))
+	for _, id := range imageIDs {
+		if id <= 0 {
+			return nil, apperrors.New(apperrors.ErrCodeValidation, "image_ids must be positive")
+		}
+		if _, ok := seen[id]; ok {
+			return nil, apperrors.New(apperrors.ErrCodeValidation, "image_ids must not contain duplicates")
+		}
+		seen[id] = struct{}{}
+	}
+
+	return u.carImageRepo.Reorder(ctx, carID, imageIDs)
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 100
+)
+
+type searchCarsUsecase struct {
+	carRepo ports.CarRepository
+}
+
+type SearchCarsUsecase interface {
+	Execute(ctx context.Context, query string, offset int64, limit int64) (int64, []*entities.CarSearchResult, error)
+}
+
+func NewSearchCarsUsecase(carRepo ports.CarRepository) SearchCarsUsecase {
+	return &searchCarsUsecase{carRepo: carRepo}
+}
+
+func (u *searchCarsUsecase) Execute(ctx context.Context, query string, offset int64, limit int64) (int64, []*entities.CarSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return 0, nil, apperrors.New(apperrors.ErrCodeValidation, "search query must be between 2 and 100 characters")
+	}
+
+	total, results, err := u.carRepo.SearchCars(ctx, query, offset, limit)
+	if err != nil {
+		return 0, nil, apperrors.New(apperrors.ErrCodeInternal, "failed to search cars")
+	}
+	return total, results, nil
+}
@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type setCarImageCoverUsecase struct {
+	carImageRepo ports.CarImageRepository
+}
+
+type SetCarImageCoverUsecase interface {
+	Execute(ctx context.Context, carID, imageID int64) (*entities.CarImage, error)
+}
+
+func NewSetCarImageCoverUsecase(carImageRepository ports.CarImageRepository) SetCarImageCoverUsecase {
+	return &setCarImageCoverUsecase{
+		carImageRepo: carImageRepository,
+	}
+}
+
+func (u *setCarImageCoverUsecase) Execute(ctx context.Context, carID, imageID int64) (*entities.CarImage, error) {
+	if err := u.carImageRepo.SetCover(ctx, carID, imageID); err != nil {
+		return nil, err
+	}
+
+	return u.carImageRepo.GetByID(ctx, imageID)
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type addCelebrityCarUsecase struct {
+	celebrityCarRepo ports.CelebrityCarRepository
+}
+
+type AddCelebrityCarUsecase interface {
+	Execute(ctx context.Context, celebrityID, carID int64, quote string, rentedAt *time.Time) (*entities.CelebrityCar, error)
+}
+
+func NewAddCelebrityCarUsecase(celebrityCarRepo ports.CelebrityCarRepository) AddCelebrityCarUsecase {
+	return &addCelebrityCarUsecase{celebrityCarRepo: celebrityCarRepo}
+}
+
+func (u *addCelebrityCarUsecase) Execute(ctx context.Context, celebrityID, carID int64, quote string, rentedAt *time.Time) (*entities.CelebrityCar, error) {
+	link, err := entities.NewCelebrityCar(celebrityID, carID, quote, rentedAt)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+	}
+
+	if err := u.celebrityCarRepo.AddCelebrityCar(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"io"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type addCelebrityImageUsecase struct {
+	celebrityImageRepo ports.CelebrityImageRepository
+	imageService       ports.ImageService
+	uploadValidator    ports.ImageUploadValidator
+}
+
+type AddCelebrityImageUsecase interface {
+	Execute(ctx context.Context, celebrityID int64, file io.Reader, fileName string) (*entities.CelebrityImage, error)
+}
+
+func NewAddCelebrityImageUsecase(
+	celebrityImageRepo ports.CelebrityImageRepository,
+	imageService ports.ImageService,
+	uploadValidator ports.ImageUploadValidator,
+) AddCelebrityImageUsecase {
+	return &addCelebrityImageUsecase{
+		celebrityImageRepo: celebrityImageRepo,
+		imageService:       imageService,
+		uploadValidator:    uploadValidator,
+	}
+}
+
+// Execute stores the photo and appends it to the gallery of the celebrity
+func (u *addCelebrityImageUsecase) Execute(ctx context.Context, celebrityID int64, file io.Reader, fileName string) (*entities.CelebrityImage, error) {
+	upload, err := u.uploadValidator.ReadImage(file, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	imagePath, variants, err := u.imageService.SaveImageVariants(upload.Data, "celebrities", upload.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := u.celebrityImageRepo.Save(ctx, celebrityID, imagePath, variants)
+	if err != nil {
+		if cleanupErr := u.imageService.DeleteImageWithVariants(imagePath, variants); cleanupErr != nil {
+			return nil, apperrors.Wrap(cleanupErr, apperrors.ErrCodeInternal, "failed to cleanup image after DB failure")
+		}
+		return nil, err
+	}
+
+	return image, nil
+}
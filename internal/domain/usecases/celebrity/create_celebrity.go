@@ -8,23 +8,37 @@ import (
 	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
+// CelebrityInput is the editable profile of a celebrity. Photos are uploaded
+// separately.
+type CelebrityInput struct {
+	Name        string
+	Profession  string
+	Bio         string
+	SocialLinks []entities.SocialLink
+	IsFeatured  bool
+}
+
 type createCelebrityUsecase struct {
 	celebrityRepo ports.CelebrityRepository
 }
 
 type CreateCelebrityUsecase interface {
-	Execute(ctx context.Context, name string) (*entities.Celebrity, error)
+	Execute(ctx context.Context, input CelebrityInput) (*entities.Celebrity, error)
 }
 
 func NewCreateCelebrityUsecase(celebrityRepo ports.CelebrityRepository) CreateCelebrityUsecase {
 	return &createCelebrityUsecase{celebrityRepo: celebrityRepo}
 }
 
-func (u *createCelebrityUsecase) Execute(ctx context.Context, name string) (*entities.Celebrity, error) {
-	celebrity, err := entities.NewCelebrity(name)
+func (u *createCelebrityUsecase) Execute(ctx context.Context, input CelebrityInput) (*entities.Celebrity, error) {
+	celebrity, err := entities.NewCelebrity(input.Name)
 	if err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
+	if err := celebrity.SetProfile(input.Profession, input.Bio, input.SocialLinks); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+	celebrity.SetFeatured(input.IsFeatured)
 
 	err = u.celebrityRepo.CreateCelebrity(ctx, celebrity)
 	if err != nil {
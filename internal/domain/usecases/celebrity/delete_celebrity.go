@@ -26,14 +26,20 @@ func NewDeleteCelebrityUsecase(celebrityRepo ports.CelebrityRepository, imageSer
 func (u *deleteCelebrityUsecase) Execute(ctx context.Context, id int64) error {
 	celebrity, err := u.celebrityRepo.GetCelebrityByID(ctx, id)
 	if err != nil {
-		return apperrors.New(apperrors.ErrCodeNotFound, "celebrity not found")
-	}
-	if celebrity.Image != "" {
-		_ = u.imageService.DeleteImage(celebrity.Image)
+		return err
 	}
 	err = u.celebrityRepo.DeleteCelebrity(ctx, id)
 	if err != nil {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "failed to delete celebrity")
 	}
+
+	// The gallery rows are removed by the cascade; the files are cleaned up
+	// once the celebrity is gone
+	if celebrity.Image != "" {
+		_ = u.imageService.DeleteImageWithVariants(celebrity.Image, celebrity.ImageVariants)
+	}
+	for _, image := range celebrity.Images {
+		_ = u.imageService.DeleteImageWithVariants(image.ImagePath, image.Variants)
+	}
 	return nil
 }
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type deleteCelebrityCarUsecase struct {
+	celebrityCarRepo ports.CelebrityCarRepository
+}
+
+type DeleteCelebrityCarUsecase interface {
+	Execute(ctx context.Context, celebrityID, carID int64) error
+}
+
+func NewDeleteCelebrityCarUsecase(celebrityCarRepo ports.CelebrityCarRepository) DeleteCelebrityCarUsecase {
+	return &deleteCelebrityCarUsecase{celebrityCarRepo: celebrityCarRepo}
+}
+
+func (u *deleteCelebrityCarUsecase) Execute(ctx context.Context, celebrityID, carID int64) error {
+	return u.celebrityCarRepo.DeleteCelebrityCar(ctx, celebrityID, carID)
+}
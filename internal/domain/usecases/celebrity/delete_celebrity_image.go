@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type deleteCelebrityImageUsecase struct {
+	celebrityImageRepo ports.CelebrityImageRepository
+	imageService       ports.ImageService
+}
+
+type DeleteCelebrityImageUsecase interface {
+	Execute(ctx context.Context, celebrityID, imageID int64) error
+}
+
+func NewDeleteCelebrityImageUsecase(celebrityImageRepo ports.CelebrityImageRepository, imageService ports.ImageService) DeleteCelebrityImageUsecase {
+	return &deleteCelebrityImageUsecase{
+		celebrityImageRepo: celebrityImageRepo,
+		imageService:       imageService,
+	}
+}
+
+// Execute removes the photo from the gallery first and the files after, so a
+// storage failure never leaves a broken image in the gallery.
+func (u *deleteCelebrityImageUsecase) Execute(ctx context.Context, celebrityID, imageID int64) error {
+	image, err := u.celebrityImageRepo.GetByID(ctx, imageID)
+	if err != nil {
+		return err
+	}
+	if image.CelebrityID != celebrityID {
+		return apperrors.ErrCelebrityImageNotFound
+	}
+
+	if err := u.celebrityImageRepo.Delete(ctx, imageID); err != nil {
+		return err
+	}
+
+	_ = u.imageService.DeleteImageWithVariants(image.ImagePath, image.Variants)
+	return nil
+}
@@ -22,5 +22,9 @@ func NewGetCelebrityByIdUsecase(celebrityRepo ports.CelebrityRepository) GetCele
 }
 
 func (u *getCelebrityByIdUsecase) Execute(ctx context.Context, id int64) (*entities.Celebrity, error) {
-	return u.celebrityRepo.GetCelebrityByID(ctx, id)
+	celebrity, err := u.celebrityRepo.GetCelebrityByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return celebrity, nil
 }
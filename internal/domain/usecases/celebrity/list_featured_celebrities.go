@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type listFeaturedCelebritiesUsecase struct {
+	celebrityRepo ports.CelebrityRepository
+}
+
+type ListFeaturedCelebritiesUsecase interface {
+	Execute(ctx context.Context) ([]*entities.Celebrity, error)
+}
+
+func NewListFeaturedCelebritiesUsecase(celebrityRepo ports.CelebrityRepository) ListFeaturedCelebritiesUsecase {
+	return &listFeaturedCelebritiesUsecase{celebrityRepo: celebrityRepo}
+}
+
+// Execute returns the homepage carousel in display order
+func (u *listFeaturedCelebritiesUsecase) Execute(ctx context.Context) ([]*entities.Celebrity, error) {
+	return u.celebrityRepo.ListFeaturedCelebrities(ctx)
+}
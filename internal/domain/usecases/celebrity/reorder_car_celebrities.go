@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type reorderCarCelebritiesUsecase struct {
+	celebrityCarRepo ports.CelebrityCarRepository
+}
+
+type ReorderCarCelebritiesUsecase interface {
+	Execute(ctx context.Context, carID int64, celebrityIDs []int64) ([]*entities.CarRenter, error)
+}
+
+func NewReorderCarCelebritiesUsecase(celebrityCarRepo ports.CelebrityCarRepository) ReorderCarCelebritiesUsecase {
+	return &reorderCarCelebritiesUsecase{celebrityCarRepo: celebrityCarRepo}
+}
+
+// Execute stores the "Rented by" list of the car in the order of
+// celebrityIDs, which must contain every celebrity of the car exactly once.
+func (u *reorderCarCelebritiesUsecase) Execute(ctx context.Context, carID int64, celebrityIDs []int64) ([]*entities.CarRenter, error) {
+	if err := validateOrderIDs(celebrityIDs, "celebrity_ids"); err != nil {
+		return nil, err
+	}
+	return u.celebrityCarRepo.ReorderCarCelebrities(ctx, carID, celebrityIDs)
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type reorderCelebrityCarsUsecase struct {
+	celebrityCarRepo ports.CelebrityCarRepository
+}
+
+type ReorderCelebrityCarsUsecase interface {
+	Execute(ctx context.Context, celebrityID int64, carIDs []int64) ([]*entities.RentedCar, error)
+}
+
+func NewReorderCelebrityCarsUsecase(celebrityCarRepo ports.CelebrityCarRepository) ReorderCelebrityCarsUsecase {
+	return &reorderCelebrityCarsUsecase{celebrityCarRepo: celebrityCarRepo}
+}
+
+// Execute stores the "Their choice" list in the order of carIDs, which must
+// contain every car of the celebrity exactly once.
+func (u *reorderCelebrityCarsUsecase) Execute(ctx context.Context, celebrityID int64, carIDs []int64) ([]*entities.RentedCar, error) {
+	if err := validateOrderIDs(carIDs, "car_ids"); err != nil {
+		return nil, err
+	}
+	return u.celebrityCarRepo.ReorderCelebrityCars(ctx, celebrityID, carIDs)
+}
+
+func validateOrderIDs(ids []int64, field string) error {
+	if len(ids) == 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, field+" cannot be empty")
+	}
+
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return apperrors.New(apperrors.ErrCodeValidation, field+" must be positive")
+		}
+		if _, ok := seen[id]; ok {
+			return apperrors.New(apperrors.ErrCodeValidation, field+" must not contain duplicates")
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
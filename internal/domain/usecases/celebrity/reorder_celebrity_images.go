@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type reorderCelebrityImagesUsecase struct {
+	celebrityImageRepo ports.CelebrityImageRepository
+}
+
+type ReorderCelebrityImagesUsecase interface {
+	Execute(ctx context.Context, celebrityID int64, imageIDs []int64) ([]*entities.CelebrityImage, error)
+}
+
+func NewReorderCelebrityImagesUsecase(celebrityImageRepo ports.CelebrityImageRepository) ReorderCelebrityImagesUsecase {
+	return &reorderCelebrityImagesUsecase{celebrityImageRepo: celebrityImageRepo}
+}
+
+// Execute stores the gallery in the order of imageIDs, which must contain
+// every image of the celebrity exactly once.
+func (u *reorderCelebrityImagesUsecase) Execute(ctx context.Context, celebrityID int64, imageIDs []int64) ([]*entities.CelebrityImage, error) {
+	if err := validateOrderIDs(imageIDs, "image_ids"); err != nil {
+		return nil, err
+	}
+	return u.celebrityImageRepo.Reorder(ctx, celebrityID, imageIDs)
+}
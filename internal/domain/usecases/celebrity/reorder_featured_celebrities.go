@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type reorderFeaturedCelebritiesUsecase struct {
+	celebrityRepo ports.CelebrityRepository
+}
+
+type ReorderFeaturedCelebritiesUsecase interface {
+	Execute(ctx context.Context, celebrityIDs []int64) ([]*entities.Celebrity, error)
+}
+
+func NewReorderFeaturedCelebritiesUsecase(celebrityRepo ports.CelebrityRepository) ReorderFeaturedCelebritiesUsecase {
+	return &reorderFeaturedCelebritiesUsecase{celebrityRepo: celebrityRepo}
+}
+
+// Execute stores the carousel in the order of celebrityIDs, which must
+// contain every featured celebrity exactly once.
+func (u *reorderFeaturedCelebritiesUsecase) Execute(ctx context.Context, celebrityIDs []int64) ([]*entities.Celebrity, error) {
+	if err := validateOrderIDs(celebrityIDs, "celebrity_ids"); err != nil {
+		return nil, err
+	}
+	return u.celebrityRepo.ReorderFeaturedCelebrities(ctx, celebrityIDs)
+}
@@ -13,21 +13,25 @@ type updateCelebrityUsecase struct {
 }
 
 type UpdateCelebrityUsecase interface {
-	Execute(ctx context.Context, id int64, name string) (*entities.Celebrity, error)
+	Execute(ctx context.Context, id int64, input CelebrityInput) (*entities.Celebrity, error)
 }
 
 func NewUpdateCelebrityUsecase(celebrityRepo ports.CelebrityRepository) UpdateCelebrityUsecase {
 	return &updateCelebrityUsecase{celebrityRepo: celebrityRepo}
 }
 
-func (u *updateCelebrityUsecase) Execute(ctx context.Context, id int64, name string) (*entities.Celebrity, error) {
+func (u *updateCelebrityUsecase) Execute(ctx context.Context, id int64, input CelebrityInput) (*entities.Celebrity, error) {
 	celebrity, err := u.celebrityRepo.GetCelebrityByID(ctx, id)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeNotFound, "celebrity not found")
+		return nil, err
 	}
-	if err := celebrity.SetName(name); err != nil {
+	if err := celebrity.SetName(input.Name); err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
+	if err := celebrity.SetProfile(input.Profession, input.Bio, input.SocialLinks); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+	celebrity.SetFeatured(input.IsFeatured)
 	if err := celebrity.Validate(); err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
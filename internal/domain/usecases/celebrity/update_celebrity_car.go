@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type updateCelebrityCarUsecase struct {
+	celebrityCarRepo ports.CelebrityCarRepository
+}
+
+type UpdateCelebrityCarUsecase interface {
+	Execute(ctx context.Context, celebrityID, carID int64, quote string, rentedAt *time.Time) (*entities.CelebrityCar, error)
+}
+
+func NewUpdateCelebrityCarUsecase(celebrityCarRepo ports.CelebrityCarRepository) UpdateCelebrityCarUsecase {
+	return &updateCelebrityCarUsecase{celebrityCarRepo: celebrityCarRepo}
+}
+
+func (u *updateCelebrityCarUsecase) Execute(ctx context.Context, celebrityID, carID int64, quote string, rentedAt *time.Time) (*entities.CelebrityCar, error) {
+	link, err := u.celebrityCarRepo.GetCelebrityCar(ctx, celebrityID, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := link.Update(quote, rentedAt); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+	}
+
+	if err := u.celebrityCarRepo.UpdateCelebrityCar(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
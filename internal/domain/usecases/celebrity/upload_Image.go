@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"io"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
@@ -9,49 +10,55 @@ import (
 )
 
 type uploadCelebrityImageUsecase struct {
-	celebrityRepo ports.CelebrityRepository
-	imageService  ports.ImageService
+	celebrityRepo   ports.CelebrityRepository
+	imageService    ports.ImageService
+	uploadValidator ports.ImageUploadValidator
 }
 
 type UploadCelebrityImageUsecase interface {
-	Execute(ctx context.Context, id int64, imageData []byte, fileName string) (*entities.Celebrity, error)
+	Execute(ctx context.Context, id int64, file io.Reader, fileName string) (*entities.Celebrity, error)
 }
 
-func NewUploadCelebrityImageUsecase(celebrityRepo ports.CelebrityRepository, imageService ports.ImageService) UploadCelebrityImageUsecase {
+func NewUploadCelebrityImageUsecase(
+	celebrityRepo ports.CelebrityRepository,
+	imageService ports.ImageService,
+	uploadValidator ports.ImageUploadValidator,
+) UploadCelebrityImageUsecase {
 	return &uploadCelebrityImageUsecase{
-		celebrityRepo: celebrityRepo,
-		imageService:  imageService,
+		celebrityRepo:   celebrityRepo,
+		imageService:    imageService,
+		uploadValidator: uploadValidator,
 	}
 }
 
-func (u *uploadCelebrityImageUsecase) Execute(ctx context.Context, id int64, fileData []byte, fileName string) (*entities.Celebrity, error) {
-	if len(fileData) == 0 {
-		return nil, apperrors.New(apperrors.ErrCodeValidation, "image file is empty")
+func (u *uploadCelebrityImageUsecase) Execute(ctx context.Context, id int64, file io.Reader, fileName string) (*entities.Celebrity, error) {
+	upload, err := u.uploadValidator.ReadImage(file, fileName)
+	if err != nil {
+		return nil, err
 	}
 
-	var celebrity *entities.Celebrity
-	var err error
-
-	celebrity, err = u.celebrityRepo.GetCelebrityByID(ctx, id)
+	celebrity, err := u.celebrityRepo.GetCelebrityByID(ctx, id)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeInternal, "celebrity not founds")
-	}
-	if celebrity.Image != "" {
-		err = u.imageService.DeleteImage(celebrity.Image)
-		if err != nil {
-			return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to delete old celebrity image")
-		}
+		return nil, err
 	}
+	oldImage, oldVariants := celebrity.Image, celebrity.ImageVariants
 
-	imagePath, err := u.imageService.SaveImage(fileData, "celebrities", fileName)
+	imagePath, variants, err := u.imageService.SaveImageVariants(upload.Data, "celebrities", upload.FileName)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to save image")
+		return nil, err
 	}
 
-	celebrity, err = u.celebrityRepo.UploadImage(ctx, id, imagePath)
-
+	updated, err := u.celebrityRepo.UploadImage(ctx, id, imagePath, variants)
 	if err != nil {
+		_ = u.imageService.DeleteImageWithVariants(imagePath, variants)
 		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to upload celebrity image")
 	}
-	return celebrity, nil
+
+	// The old photo is removed only once the new one is stored, so a failed
+	// upload leaves the celebrity with the previous photo. A leftover file is
+	// harmless, hence the ignored error.
+	if oldImage != "" {
+		_ = u.imageService.DeleteImageWithVariants(oldImage, oldVariants)
+	}
+	return updated, nil
 }
@@ -13,21 +13,28 @@ type createDriverUsecase struct {
 }
 
 type CreateDriverUsecase interface {
-	Execute(ctx context.Context, fullName, about, experienceYears string) (*entities.Driver, error)
+	Execute(ctx context.Context, fullName, about, experienceYears string, qualifications entities.DriverQualifications) (*entities.Driver, error)
 }
 
 func NewCreateDriverUsecase(driverRepo ports.DriverRepository) CreateDriverUsecase {
 	return &createDriverUsecase{driverRepo: driverRepo}
 }
 
-func (u *createDriverUsecase) Execute(ctx context.Context, fullName, about, experienceYears string) (*entities.Driver, error) {
+func (u *createDriverUsecase) Execute(ctx context.Context, fullName, about, experienceYears string, qualifications entities.DriverQualifications) (*entities.Driver, error) {
 	driver, err := entities.NewDriver(fullName, about, experienceYears)
 	if err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
 
+	if err := driver.SetQualifications(qualifications); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
 	err = u.driverRepo.CreateDriver(ctx, driver)
 	if err != nil {
+		if appErr, ok := apperrors.AsAppError(err); ok && appErr.Code != apperrors.ErrCodeDatabase {
+			return nil, appErr
+		}
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "failed to create driver")
 	}
 
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type createDriverDayOffUsecase struct {
+	driverRepo ports.DriverRepository
+}
+
+type CreateDriverDayOffUsecase interface {
+	Execute(ctx context.Context, driverID int64, startDate, endDate time.Time, reason string) (*entities.DriverDayOff, error)
+}
+
+func NewCreateDriverDayOffUsecase(driverRepo ports.DriverRepository) CreateDriverDayOffUsecase {
+	return &createDriverDayOffUsecase{driverRepo: driverRepo}
+}
+
+func (u *createDriverDayOffUsecase) Execute(ctx context.Context, driverID int64, startDate, endDate time.Time, reason string) (*entities.DriverDayOff, error) {
+	if _, err := u.driverRepo.GetDriverByID(ctx, driverID); err != nil {
+		return nil, err
+	}
+
+	dayOff, err := entities.NewDriverDayOff(driverID, startDate, endDate, reason)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	// Bookings have to be reassigned before the driver can take the time off
+	busy, err := u.driverRepo.ListBusyIntervals(ctx, driverID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	for _, interval := range busy {
+		if interval.Kind == entities.BusyIntervalKindBooking {
+			return nil, apperrors.ErrDriverAlreadyBooked
+		}
+	}
+
+	if err := u.driverRepo.CreateDayOff(ctx, dayOff); err != nil {
+		return nil, err
+	}
+
+	return dayOff, nil
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type deleteDriverDayOffUsecase struct {
+	driverRepo ports.DriverRepository
+}
+
+type DeleteDriverDayOffUsecase interface {
+	Execute(ctx context.Context, driverID, dayOffID int64) error
+}
+
+func NewDeleteDriverDayOffUsecase(driverRepo ports.DriverRepository) DeleteDriverDayOffUsecase {
+	return &deleteDriverDayOffUsecase{driverRepo: driverRepo}
+}
+
+func (u *deleteDriverDayOffUsecase) Execute(ctx context.Context, driverID, dayOffID int64) error {
+	return u.driverRepo.DeleteDayOff(ctx, driverID, dayOffID)
+}
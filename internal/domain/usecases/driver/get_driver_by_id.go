@@ -20,5 +20,9 @@ func NewGetDriverByIdUsecase(driverRepo ports.DriverRepository) GetDriverByIdUse
 }
 
 func (u *getDriverByIdUsecase) Execute(ctx context.Context, id int64) (*entities.Driver, error) {
-	return u.driverRepo.GetDriverByID(ctx, id)
+	driver, err := u.driverRepo.GetDriverByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return driver, nil
 }
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getDriverCalendarUsecase struct {
+	driverRepo ports.DriverRepository
+}
+
+type GetDriverCalendarUsecase interface {
+	// Execute returns the shifts and busy intervals of the driver within the given month (UTC)
+	Execute(ctx context.Context, driverID int64, year int, month time.Month) (*entities.DriverCalendar, error)
+}
+
+func NewGetDriverCalendarUsecase(driverRepo ports.DriverRepository) GetDriverCalendarUsecase {
+	return &getDriverCalendarUsecase{driverRepo: driverRepo}
+}
+
+func (u *getDriverCalendarUsecase) Execute(ctx context.Context, driverID int64, year int, month time.Month) (*entities.DriverCalendar, error) {
+	if _, err := u.driverRepo.GetDriverByID(ctx, driverID); err != nil {
+		return nil, err
+	}
+
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	schedule, err := u.driverRepo.GetSchedule(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	busy, err := u.driverRepo.ListBusyIntervals(ctx, driverID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.DriverCalendar{
+		DriverID: driverID,
+		From:     from,
+		To:       to,
+		Shifts:   schedule.Shifts,
+		Busy:     busy,
+	}, nil
+}
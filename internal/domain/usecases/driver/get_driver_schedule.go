@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getDriverScheduleUsecase struct {
+	driverRepo ports.DriverRepository
+}
+
+type GetDriverScheduleUsecase interface {
+	Execute(ctx context.Context, driverID int64) (*entities.DriverSchedule, error)
+}
+
+func NewGetDriverScheduleUsecase(driverRepo ports.DriverRepository) GetDriverScheduleUsecase {
+	return &getDriverScheduleUsecase{driverRepo: driverRepo}
+}
+
+func (u *getDriverScheduleUsecase) Execute(ctx context.Context, driverID int64) (*entities.DriverSchedule, error) {
+	if _, err := u.driverRepo.GetDriverByID(ctx, driverID); err != nil {
+		return nil, err
+	}
+	return u.driverRepo.GetSchedule(ctx, driverID)
+}
@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type listAvailableDriversUsecase struct {
+	driverRepo ports.DriverRepository
+	location   *time.Location
+}
+
+type ListAvailableDriversUsecase interface {
+	// Execute returns drivers who work during [startDate, endDate) and have
+	// no booking or day off overlapping it
+	Execute(ctx context.Context, startDate, endDate time.Time) ([]*entities.Driver, error)
+}
+
+// NewListAvailableDriversUsecase reads shifts in location, the business
+// timezone.
+func NewListAvailableDriversUsecase(driverRepo ports.DriverRepository, location *time.Location) ListAvailableDriversUsecase {
+	return &listAvailableDriversUsecase{
+		driverRepo: driverRepo,
+		location:   location,
+	}
+}
+
+func (u *listAvailableDriversUsecase) Execute(ctx context.Context, startDate, endDate time.Time) ([]*entities.Driver, error) {
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "dates cannot be zero")
+	}
+	if !endDate.After(startDate) {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "end date must be after start date")
+	}
+
+	free, err := u.driverRepo.ListAvailableDrivers(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(free) == 0 {
+		return free, nil
+	}
+
+	ids := make([]int64, 0, len(free))
+	for _, driver := range free {
+		ids = append(ids, driver.ID)
+	}
+	schedules, err := u.driverRepo.ListSchedules(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	drivers := make([]*entities.Driver, 0, len(free))
+	for _, driver := range free {
+		if schedules[driver.ID].Covers(startDate, endDate, u.location) {
+			drivers = append(drivers, driver)
+		}
+	}
+
+	return drivers, nil
+}
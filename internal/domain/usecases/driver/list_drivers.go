@@ -2,9 +2,11 @@ package usecases
 
 import (
 	"context"
+	"strings"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type listDriversUsecase struct {
@@ -12,13 +14,28 @@ type listDriversUsecase struct {
 }
 
 type ListDriversUsecase interface {
-	Execute(ctx context.Context, offset, limit int64) (int64, []*entities.Driver, error)
+	Execute(ctx context.Context, offset, limit int64, filter ports.DriverFilter) (int64, []*entities.Driver, error)
 }
 
 func NewListDriversUsecase(driverRepo ports.DriverRepository) ListDriversUsecase {
 	return &listDriversUsecase{driverRepo: driverRepo}
 }
 
-func (u *listDriversUsecase) Execute(ctx context.Context, offset, limit int64) (int64, []*entities.Driver, error) {
-	return u.driverRepo.ListDrivers(ctx, offset, limit)
+func (u *listDriversUsecase) Execute(ctx context.Context, offset, limit int64, filter ports.DriverFilter) (int64, []*entities.Driver, error) {
+	languages := make([]string, 0, len(filter.Languages))
+	for _, language := range filter.Languages {
+		if language = strings.ToLower(strings.TrimSpace(language)); language != "" {
+			languages = append(languages, language)
+		}
+	}
+	filter.Languages = languages
+
+	if filter.LicenseCategory != "" {
+		filter.LicenseCategory = entities.DriverLicenseCategory(strings.ToUpper(string(filter.LicenseCategory)))
+		if !filter.LicenseCategory.IsValid() {
+			return 0, nil, apperrors.New(apperrors.ErrCodeBadRequest, "unknown license category")
+		}
+	}
+
+	return u.driverRepo.ListDrivers(ctx, offset, limit, filter)
 }
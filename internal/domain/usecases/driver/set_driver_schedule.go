@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type setDriverScheduleUsecase struct {
+	driverRepo ports.DriverRepository
+}
+
+type SetDriverScheduleUsecase interface {
+	// Execute replaces the weekly schedule of the driver. An empty list of
+	// shifts clears the schedule so the driver counts as working every day.
+	// Existing bookings are not re-checked.
+	Execute(ctx context.Context, driverID int64, shifts []entities.DriverShift) (*entities.DriverSchedule, error)
+}
+
+func NewSetDriverScheduleUsecase(driverRepo ports.DriverRepository) SetDriverScheduleUsecase {
+	return &setDriverScheduleUsecase{driverRepo: driverRepo}
+}
+
+func (u *setDriverScheduleUsecase) Execute(ctx context.Context, driverID int64, shifts []entities.DriverShift) (*entities.DriverSchedule, error) {
+	if _, err := u.driverRepo.GetDriverByID(ctx, driverID); err != nil {
+		return nil, err
+	}
+
+	schedule, err := entities.NewDriverSchedule(driverID, shifts)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	if err := u.driverRepo.ReplaceSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
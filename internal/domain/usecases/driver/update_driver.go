@@ -13,17 +13,17 @@ type updateDriverUsecase struct {
 }
 
 type UpdateDriverUsecase interface {
-	Execute(ctx context.Context, id int64, fullName, about, experienceYears string) (*entities.Driver, error)
+	Execute(ctx context.Context, id int64, fullName, about, experienceYears string, qualifications entities.DriverQualifications) (*entities.Driver, error)
 }
 
 func NewUpdateDriverUsecase(driverRepo ports.DriverRepository) UpdateDriverUsecase {
 	return &updateDriverUsecase{driverRepo: driverRepo}
 }
 
-func (u *updateDriverUsecase) Execute(ctx context.Context, id int64, fullName, about, experienceYears string) (*entities.Driver, error) {
+func (u *updateDriverUsecase) Execute(ctx context.Context, id int64, fullName, about, experienceYears string, qualifications entities.DriverQualifications) (*entities.Driver, error) {
 	driver, err := u.driverRepo.GetDriverByID(ctx, id)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeNotFound, "driver not found")
+		return nil, err
 	}
 
 	if err := driver.SetFullName(fullName); err != nil {
@@ -38,12 +38,19 @@ func (u *updateDriverUsecase) Execute(ctx context.Context, id int64, fullName, a
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
 
+	if err := driver.SetQualifications(qualifications); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
 	if err := driver.Validate(); err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
 
 	err = u.driverRepo.UpdateDriver(ctx, driver)
 	if err != nil {
+		if appErr, ok := apperrors.AsAppError(err); ok && appErr.Code != apperrors.ErrCodeDatabase {
+			return nil, appErr
+		}
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "failed to update driver")
 	}
 
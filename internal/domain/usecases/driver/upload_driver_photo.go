@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"io"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
@@ -9,44 +10,51 @@ import (
 )
 
 type uploadDriverPhotoUsecase struct {
-	driverRepo   ports.DriverRepository
-	imageService ports.ImageService
+	driverRepo      ports.DriverRepository
+	imageService    ports.ImageService
+	uploadValidator ports.ImageUploadValidator
 }
 
 type UploadDriverPhotoUsecase interface {
-	Execute(ctx context.Context, driverID int64, imageData []byte, fileName string) (*entities.Driver, error)
+	Execute(ctx context.Context, driverID int64, file io.Reader, fileName string) (*entities.Driver, error)
 }
 
-func NewUploadDriverPhotoUsecase(driverRepo ports.DriverRepository, imageService ports.ImageService) UploadDriverPhotoUsecase {
+func NewUploadDriverPhotoUsecase(
+	driverRepo ports.DriverRepository,
+	imageService ports.ImageService,
+	uploadValidator ports.ImageUploadValidator,
+) UploadDriverPhotoUsecase {
 	return &uploadDriverPhotoUsecase{
-		driverRepo:   driverRepo,
-		imageService: imageService,
+		driverRepo:      driverRepo,
+		imageService:    imageService,
+		uploadValidator: uploadValidator,
 	}
 }
 
-func (u *uploadDriverPhotoUsecase) Execute(ctx context.Context, driverID int64, fileData []byte, fileName string) (*entities.Driver, error) {
-	if len(fileData) == 0 {
-		return nil, apperrors.New(apperrors.ErrCodeValidation, "photo file is empty")
+func (u *uploadDriverPhotoUsecase) Execute(ctx context.Context, driverID int64, file io.Reader, fileName string) (*entities.Driver, error) {
+	upload, err := u.uploadValidator.ReadImage(file, fileName)
+	if err != nil {
+		return nil, err
 	}
 
 	driver, err := u.driverRepo.GetDriverByID(ctx, driverID)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeNotFound, "driver not found")
+		return nil, err
 	}
 
 	if driver.PhotoURL != "" {
-		err = u.imageService.DeleteImage(driver.PhotoURL)
+		err = u.imageService.DeleteImageWithVariants(driver.PhotoURL, driver.PhotoVariants)
 		if err != nil {
 			return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to delete old driver photo")
 		}
 	}
 
-	imagePath, err := u.imageService.SaveImage(fileData, "drivers", fileName)
+	imagePath, variants, err := u.imageService.SaveImageVariants(upload.Data, "drivers", upload.FileName)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to save photo")
+		return nil, err
 	}
 
-	driver.SetPhotoURL(imagePath)
+	driver.SetPhoto(imagePath, variants)
 
 	if err := u.driverRepo.UpdateDriver(ctx, driver); err != nil {
 		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to update driver")
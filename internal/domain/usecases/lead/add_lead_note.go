@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type addLeadNoteUsecase struct {
+	noteRepo ports.LeadNoteRepository
+}
+
+type AddLeadNoteUsecase interface {
+	Execute(ctx context.Context, leadID, authorID int64, body string) (*entities.LeadNote, error)
+}
+
+func NewAddLeadNoteUsecase(noteRepo ports.LeadNoteRepository) AddLeadNoteUsecase {
+	return &addLeadNoteUsecase{noteRepo: noteRepo}
+}
+
+func (u *addLeadNoteUsecase) Execute(ctx context.Context, leadID, authorID int64, body string) (*entities.LeadNote, error) {
+	note, err := entities.NewLeadNote(leadID, authorID, body)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+	}
+
+	if err := u.noteRepo.CreateNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type assignLeadUsecase struct {
+	leadRepo ports.LeadRepository
+	userRepo ports.UserRepository
+}
+
+type AssignLeadUsecase interface {
+	// Execute assigns the lead to a staff member; a nil assigneeID unassigns it
+	Execute(ctx context.Context, id int64, assigneeID *int64) (*entities.Lead, error)
+}
+
+func NewAssignLeadUsecase(leadRepo ports.LeadRepository, userRepo ports.UserRepository) AssignLeadUsecase {
+	return &assignLeadUsecase{
+		leadRepo: leadRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (u *assignLeadUsecase) Execute(ctx context.Context, id int64, assigneeID *int64) (*entities.Lead, error) {
+	lead, err := u.leadRepo.GetLeadByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if assigneeID != nil {
+		user, err := u.userRepo.GetUserById(ctx, *assigneeID)
+		if err != nil || user == nil {
+			return nil, apperrors.ErrUserNotFound
+		}
+		if user.Role != entities.UserRoleAdmin && user.Role != entities.UserRoleManager {
+			return nil, apperrors.New(apperrors.ErrCodeValidation, "leads can only be assigned to admins or managers")
+		}
+	}
+
+	lead.AssignTo(assigneeID)
+	if err := u.leadRepo.UpdateLeadAssignee(ctx, lead); err != nil {
+		return nil, err
+	}
+
+	return lead, nil
+}
@@ -2,35 +2,118 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	pricingUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/pricing"
 	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
+// CreateLeadInput describes an inquiry. CarID is optional; when it is set the
+// lead gets a price quote for the requested period.
+type CreateLeadInput struct {
+	FullName   string
+	Phone      string
+	StartDate  time.Time
+	EndDate    time.Time
+	CarID      *int64
+	WithDriver bool
+}
+
+// PhoneRegion is the ISO 3166 region assumed for phone numbers typed without
+// a country code
+type PhoneRegion string
+
 type createLeadUsecase struct {
-	leadRepo ports.LeadRepository
+	leadRepo    ports.LeadRepository
+	getQuote    pricingUsecases.GetPriceQuoteUsecase
+	outboxRepo  ports.OutboxRepository
+	notifier    ports.StaffNotificationRouter
+	transactor  ports.Transactor
+	phoneRegion PhoneRegion
 }
 
 type CreateLeadUsecase interface {
-	Execute(ctx context.Context, fullName, phone string, startDate, endDate time.Time) (*entities.Lead, error)
+	Execute(ctx context.Context, input CreateLeadInput) (*entities.Lead, error)
 }
 
-func NewCreateLeadUsecase(leadRepo ports.LeadRepository) CreateLeadUsecase {
-	return &createLeadUsecase{leadRepo: leadRepo}
+func NewCreateLeadUsecase(leadRepo ports.LeadRepository, getQuote pricingUsecases.GetPriceQuoteUsecase, outboxRepo ports.OutboxRepository, notifier ports.StaffNotificationRouter, transactor ports.Transactor, phoneRegion PhoneRegion) CreateLeadUsecase {
+	return &createLeadUsecase{
+		leadRepo:    leadRepo,
+		getQuote:    getQuote,
+		outboxRepo:  outboxRepo,
+		notifier:    notifier,
+		transactor:  transactor,
+		phoneRegion: phoneRegion,
+	}
 }
 
-func (u *createLeadUsecase) Execute(ctx context.Context, fullName, phone string, startDate, endDate time.Time) (*entities.Lead, error) {
-	lead, err := entities.NewLead(fullName, phone, startDate, endDate)
+func (u *createLeadUsecase) Execute(ctx context.Context, input CreateLeadInput) (*entities.Lead, error) {
+	lead, err := entities.NewLead(input.FullName, input.Phone, string(u.phoneRegion), input.StartDate, input.EndDate)
 	if err != nil {
+		var fieldErrs entities.ValidationErrors
+		if errors.As(err, &fieldErrs) {
+			return nil, apperrors.NewFieldErrors(fieldErrs)
+		}
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
 	}
 
-	err = u.leadRepo.CreateLead(ctx, lead)
+	if input.CarID != nil {
+		quote, err := u.getQuote.Execute(ctx, *input.CarID, lead.StartDate, lead.EndDate, input.WithDriver)
+		if err != nil {
+			return nil, err
+		}
+		lead.AttachQuote(quote)
+	}
+
+	// Staff are notified from the outbox, so the lead is stored even when
+	// every notification channel is down. Each channel gets its own message,
+	// so a failing channel does not resend the lead over the others.
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.flagDuplicate(ctx, lead); err != nil {
+			return err
+		}
+
+		if err := u.leadRepo.CreateLead(ctx, lead); err != nil {
+			return apperrors.New(apperrors.ErrCodeBadRequest, "failed to create lead")
+		}
+
+		for _, channel := range u.notifier.Channels() {
+			msg, err := entities.NewOutboxMessage(entities.OutboxTopicLeadCreated, entities.LeadNotificationPayload{
+				Channel: channel,
+				Lead:    lead,
+			})
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to create lead")
+			}
+			if err := u.outboxRepo.Enqueue(ctx, msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "failed to create lead")
+		return nil, err
 	}
 
 	return lead, nil
 }
+
+// flagDuplicate marks lead as a duplicate of the oldest recent lead it likely
+// repeats. Duplicates are only flagged; managers merge them.
+func (u *createLeadUsecase) flagDuplicate(ctx context.Context, lead *entities.Lead) error {
+	candidates, err := u.leadRepo.FindDuplicateCandidates(ctx, lead, lead.CreatedAt.Add(-entities.DuplicateLookback))
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		if lead.IsLikelyDuplicateOf(candidate) {
+			lead.MarkDuplicateOf(candidate)
+			return nil
+		}
+	}
+	return nil
+}
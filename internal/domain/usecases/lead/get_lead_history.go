@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getLeadHistoryUsecase struct {
+	leadRepo ports.LeadRepository
+}
+
+type GetLeadHistoryUsecase interface {
+	Execute(ctx context.Context, leadID int64) ([]*entities.LeadStatusChange, error)
+}
+
+func NewGetLeadHistoryUsecase(leadRepo ports.LeadRepository) GetLeadHistoryUsecase {
+	return &getLeadHistoryUsecase{leadRepo: leadRepo}
+}
+
+func (u *getLeadHistoryUsecase) Execute(ctx context.Context, leadID int64) ([]*entities.LeadStatusChange, error) {
+	if _, err := u.leadRepo.GetLeadByID(ctx, leadID); err != nil {
+		return nil, err
+	}
+	return u.leadRepo.ListLeadStatusHistory(ctx, leadID)
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type listLeadDuplicatesUsecase struct {
+	leadRepo ports.LeadRepository
+}
+
+type ListLeadDuplicatesUsecase interface {
+	// Execute returns the other leads of the duplicate group of the lead:
+	// the lead it duplicates and every lead flagged against the same one
+	Execute(ctx context.Context, leadID int64) ([]*entities.Lead, error)
+}
+
+func NewListLeadDuplicatesUsecase(leadRepo ports.LeadRepository) ListLeadDuplicatesUsecase {
+	return &listLeadDuplicatesUsecase{leadRepo: leadRepo}
+}
+
+func (u *listLeadDuplicatesUsecase) Execute(ctx context.Context, leadID int64) ([]*entities.Lead, error) {
+	lead, err := u.leadRepo.GetLeadByID(ctx, leadID)
+	if err != nil {
+		return nil, err
+	}
+
+	rootID := lead.ID
+	if lead.DuplicateOfID != nil {
+		rootID = *lead.DuplicateOfID
+	}
+
+	group, err := u.leadRepo.ListDuplicateGroup(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates := make([]*entities.Lead, 0, len(group))
+	for _, l := range group {
+		if l.ID != lead.ID {
+			duplicates = append(duplicates, l)
+		}
+	}
+	return duplicates, nil
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type listLeadNotesUsecase struct {
+	leadRepo ports.LeadRepository
+	noteRepo ports.LeadNoteRepository
+}
+
+type ListLeadNotesUsecase interface {
+	Execute(ctx context.Context, leadID int64) ([]*entities.LeadNote, error)
+}
+
+func NewListLeadNotesUsecase(leadRepo ports.LeadRepository, noteRepo ports.LeadNoteRepository) ListLeadNotesUsecase {
+	return &listLeadNotesUsecase{
+		leadRepo: leadRepo,
+		noteRepo: noteRepo,
+	}
+}
+
+func (u *listLeadNotesUsecase) Execute(ctx context.Context, leadID int64) ([]*entities.LeadNote, error) {
+	if _, err := u.leadRepo.GetLeadByID(ctx, leadID); err != nil {
+		return nil, err
+	}
+	return u.noteRepo.ListNotes(ctx, leadID)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type listLeadsUsecase struct {
@@ -12,13 +13,19 @@ type listLeadsUsecase struct {
 }
 
 type ListLeadsUsecase interface {
-	Execute(ctx context.Context, offset, limit int64) (int64, []*entities.Lead, error)
+	Execute(ctx context.Context, offset, limit int64, filter ports.LeadFilter) (int64, []*entities.Lead, error)
 }
 
 func NewListLeadsUsecase(leadRepo ports.LeadRepository) ListLeadsUsecase {
 	return &listLeadsUsecase{leadRepo: leadRepo}
 }
 
-func (u *listLeadsUsecase) Execute(ctx context.Context, offset, limit int64) (int64, []*entities.Lead, error) {
-	return u.leadRepo.ListLeads(ctx, offset, limit)
+func (u *listLeadsUsecase) Execute(ctx context.Context, offset, limit int64, filter ports.LeadFilter) (int64, []*entities.Lead, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return 0, nil, apperrors.New(apperrors.ErrCodeValidation, "invalid lead status")
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedTo.After(filter.CreatedFrom) {
+		return 0, nil, apperrors.New(apperrors.ErrCodeValidation, "created_to must be after created_from")
+	}
+	return u.leadRepo.ListLeads(ctx, offset, limit, filter)
 }
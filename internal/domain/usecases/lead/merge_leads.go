@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// maxMergeSources keeps the merge note within the note length limit
+const maxMergeSources = 10
+
+type mergeLeadsUsecase struct {
+	leadRepo   ports.LeadRepository
+	noteRepo   ports.LeadNoteRepository
+	transactor ports.Transactor
+}
+
+type MergeLeadsUsecase interface {
+	// Execute merges the source leads into the target lead. The sources stay
+	// stored with their history and notes, which are shown on the target.
+	Execute(ctx context.Context, targetID int64, sourceIDs []int64, mergedBy int64) (*entities.Lead, error)
+}
+
+func NewMergeLeadsUsecase(leadRepo ports.LeadRepository, noteRepo ports.LeadNoteRepository, transactor ports.Transactor) MergeLeadsUsecase {
+	return &mergeLeadsUsecase{
+		leadRepo:   leadRepo,
+		noteRepo:   noteRepo,
+		transactor: transactor,
+	}
+}
+
+func (u *mergeLeadsUsecase) Execute(ctx context.Context, targetID int64, sourceIDs []int64, mergedBy int64) (*entities.Lead, error) {
+	slices.Sort(sourceIDs)
+	sourceIDs = slices.Compact(sourceIDs)
+	if len(sourceIDs) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "no leads to merge")
+	}
+	if len(sourceIDs) > maxMergeSources {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("at most %d leads can be merged at once", maxMergeSources))
+	}
+
+	var target *entities.Lead
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		target, err = u.leadRepo.GetLeadByID(ctx, targetID)
+		if err != nil {
+			return err
+		}
+
+		sources := make([]*entities.Lead, 0, len(sourceIDs))
+		for _, id := range sourceIDs {
+			src, err := u.leadRepo.GetLeadByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, src)
+		}
+
+		if err := target.Merge(sources); err != nil {
+			return apperrors.New(apperrors.ErrCodeValidation, err.Error())
+		}
+		if err := u.leadRepo.MergeLeads(ctx, target, sources); err != nil {
+			return err
+		}
+
+		note, err := entities.NewLeadNote(target.ID, mergedBy, mergeNoteBody(sources))
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка объединения заявок")
+		}
+		return u.noteRepo.CreateNote(ctx, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// mergeNoteBody records on the target what the merged leads looked like
+func mergeNoteBody(sources []*entities.Lead) string {
+	var b strings.Builder
+	b.WriteString("Объединены заявки:")
+	for _, src := range sources {
+		fmt.Fprintf(&b, "\n#%d %s, %s, %s — %s",
+			src.ID, src.FullName, src.Phone,
+			src.StartDate.Format("02.01.2006"), src.EndDate.Format("02.01.2006"))
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
+)
+
+// SubmitLeadLimits configures how many anonymous submissions are allowed per
+// client IP and per phone number within Window.
+type SubmitLeadLimits struct {
+	PerIP    int
+	PerPhone int
+	Window   time.Duration
+}
+
+type SubmitLeadInput struct {
+	FullName     string
+	Phone        string
+	StartDate    time.Time
+	EndDate      time.Time
+	CarID        *int64
+	WithDriver   bool
+	CaptchaToken string
+	Honeypot     string
+	RemoteIP     string
+}
+
+type submitLeadUsecase struct {
+	createLead  CreateLeadUsecase
+	captcha     ports.CaptchaVerifier
+	rateLimiter ports.RateLimiter
+	limits      SubmitLeadLimits
+	phoneRegion PhoneRegion
+}
+
+type SubmitLeadUsecase interface {
+	Execute(ctx context.Context, input SubmitLeadInput) (*entities.Lead, error)
+}
+
+func NewSubmitLeadUsecase(
+	createLead CreateLeadUsecase,
+	captcha ports.CaptchaVerifier,
+	rateLimiter ports.RateLimiter,
+	limits SubmitLeadLimits,
+	phoneRegion PhoneRegion,
+) SubmitLeadUsecase {
+	return &submitLeadUsecase{
+		createLead:  createLead,
+		captcha:     captcha,
+		rateLimiter: rateLimiter,
+		limits:      limits,
+		phoneRegion: phoneRegion,
+	}
+}
+
+func (u *submitLeadUsecase) Execute(ctx context.Context, input SubmitLeadInput) (*entities.Lead, error) {
+	// Bots fill every field, humans never see the honeypot. Pretend the
+	// submission went through so the bot does not learn to skip it.
+	if strings.TrimSpace(input.Honeypot) != "" {
+		return nil, nil
+	}
+
+	if input.RemoteIP != "" {
+		allowed, err := u.rateLimiter.Allow(ctx, "lead:ip:"+input.RemoteIP, u.limits.PerIP, u.limits.Window)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+		}
+		if !allowed {
+			return nil, apperrors.ErrTooManyRequests
+		}
+	}
+
+	// The captcha provider is called only for clients within the IP limit
+	ok, err := u.captcha.Verify(ctx, input.CaptchaToken, input.RemoteIP)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, apperrors.ErrCaptchaFailed
+	}
+
+	// Counted only for submissions that passed the captcha, so nobody can
+	// use up someone else's phone quota. Every spelling of the same number is
+	// counted together; numbers that do not parse are rejected by CreateLead
+	// anyway
+	if phoneKey, err := utils.NormalizePhone(input.Phone, string(u.phoneRegion)); err == nil {
+		allowed, err := u.rateLimiter.Allow(ctx, "lead:phone:"+phoneKey, u.limits.PerPhone, u.limits.Window)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+		}
+		if !allowed {
+			return nil, apperrors.ErrTooManyRequests
+		}
+	}
+
+	return u.createLead.Execute(ctx, CreateLeadInput{
+		FullName:   input.FullName,
+		Phone:      input.Phone,
+		StartDate:  input.StartDate,
+		EndDate:    input.EndDate,
+		CarID:      input.CarID,
+		WithDriver: input.WithDriver,
+	})
+}
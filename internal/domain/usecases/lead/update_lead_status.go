@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type updateLeadStatusUsecase struct {
+	leadRepo ports.LeadRepository
+}
+
+type UpdateLeadStatusUsecase interface {
+	Execute(ctx context.Context, id int64, status entities.LeadStatus, reason string, changedBy int64) (*entities.Lead, error)
+}
+
+func NewUpdateLeadStatusUsecase(leadRepo ports.LeadRepository) UpdateLeadStatusUsecase {
+	return &updateLeadStatusUsecase{leadRepo: leadRepo}
+}
+
+func (u *updateLeadStatusUsecase) Execute(ctx context.Context, id int64, status entities.LeadStatus, reason string, changedBy int64) (*entities.Lead, error) {
+	lead, err := u.leadRepo.GetLeadByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.IsValid() {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "invalid lead status")
+	}
+
+	change, err := lead.TransitionTo(status, reason, changedBy)
+	if err != nil {
+		if lead.Status.CanTransitionTo(status) {
+			return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+		}
+		return nil, apperrors.New(apperrors.ErrCodeConflict, err.Error())
+	}
+
+	if err := u.leadRepo.UpdateLeadStatus(ctx, lead, change); err != nil {
+		return nil, err
+	}
+
+	return lead, nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type RatePlanInput struct {
+	Name                    string
+	CarID                   *int64
+	CategoryID              *int64
+	ShortDailyRate          int64
+	MediumDailyRate         int64
+	LongDailyRate           int64
+	WeekendSurchargePercent int64
+	ChauffeurDailyRate      int64
+	Seasons                 []*entities.SeasonalRate
+}
+
+type createRatePlanUsecase struct {
+	ratePlanRepo ports.RatePlanRepository
+}
+
+type CreateRatePlanUsecase interface {
+	Execute(ctx context.Context, input RatePlanInput) (*entities.RatePlan, error)
+}
+
+func NewCreateRatePlanUsecase(ratePlanRepo ports.RatePlanRepository) CreateRatePlanUsecase {
+	return &createRatePlanUsecase{ratePlanRepo: ratePlanRepo}
+}
+
+func (u *createRatePlanUsecase) Execute(ctx context.Context, input RatePlanInput) (*entities.RatePlan, error) {
+	plan, err := entities.NewRatePlan(
+		input.Name,
+		input.CarID,
+		input.CategoryID,
+		input.ShortDailyRate,
+		input.MediumDailyRate,
+		input.LongDailyRate,
+		input.WeekendSurchargePercent,
+		input.ChauffeurDailyRate,
+		input.Seasons,
+	)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	if err := u.ratePlanRepo.CreateRatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
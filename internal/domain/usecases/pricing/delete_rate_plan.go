@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type deleteRatePlanUsecase struct {
+	ratePlanRepo ports.RatePlanRepository
+}
+
+type DeleteRatePlanUsecase interface {
+	Execute(ctx context.Context, id int64) error
+}
+
+func NewDeleteRatePlanUsecase(ratePlanRepo ports.RatePlanRepository) DeleteRatePlanUsecase {
+	return &deleteRatePlanUsecase{ratePlanRepo: ratePlanRepo}
+}
+
+func (u *deleteRatePlanUsecase) Execute(ctx context.Context, id int64) error {
+	return u.ratePlanRepo.DeleteRatePlan(ctx, id)
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type getPriceQuoteUsecase struct {
+	carRepo      ports.CarRepository
+	ratePlanRepo ports.RatePlanRepository
+	location     *time.Location
+}
+
+type GetPriceQuoteUsecase interface {
+	Execute(ctx context.Context, carID int64, startDate, endDate time.Time, withDriver bool) (*entities.PriceQuote, error)
+}
+
+// NewGetPriceQuoteUsecase prices rentals by calendar days in location, the
+// business timezone.
+func NewGetPriceQuoteUsecase(carRepo ports.CarRepository, ratePlanRepo ports.RatePlanRepository, location *time.Location) GetPriceQuoteUsecase {
+	return &getPriceQuoteUsecase{
+		carRepo:      carRepo,
+		ratePlanRepo: ratePlanRepo,
+		location:     location,
+	}
+}
+
+func (u *getPriceQuoteUsecase) Execute(ctx context.Context, carID int64, startDate, endDate time.Time, withDriver bool) (*entities.PriceQuote, error) {
+	car, err := u.carRepo.GetCarByID(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID int64
+	if car.Category != nil {
+		categoryID = car.Category.ID
+	}
+
+	plan, err := u.ratePlanRepo.FindRatePlanForCar(ctx, car.ID, categoryID)
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrRatePlanNotFound) {
+			return nil, err
+		}
+		plan = entities.FlatRatePlan(car)
+	}
+
+	quote, err := plan.Quote(car, startDate, endDate, withDriver, u.location)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getRatePlanByIdUsecase struct {
+	ratePlanRepo ports.RatePlanRepository
+}
+
+type GetRatePlanByIdUsecase interface {
+	Execute(ctx context.Context, id int64) (*entities.RatePlan, error)
+}
+
+func NewGetRatePlanByIdUsecase(ratePlanRepo ports.RatePlanRepository) GetRatePlanByIdUsecase {
+	return &getRatePlanByIdUsecase{ratePlanRepo: ratePlanRepo}
+}
+
+func (u *getRatePlanByIdUsecase) Execute(ctx context.Context, id int64) (*entities.RatePlan, error) {
+	return u.ratePlanRepo.GetRatePlanByID(ctx, id)
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type listRatePlansUsecase struct {
+	ratePlanRepo ports.RatePlanRepository
+}
+
+type ListRatePlansUsecase interface {
+	Execute(ctx context.Context, offset, limit int64) (int64, []*entities.RatePlan, error)
+}
+
+func NewListRatePlansUsecase(ratePlanRepo ports.RatePlanRepository) ListRatePlansUsecase {
+	return &listRatePlansUsecase{ratePlanRepo: ratePlanRepo}
+}
+
+func (u *listRatePlansUsecase) Execute(ctx context.Context, offset, limit int64) (int64, []*entities.RatePlan, error) {
+	return u.ratePlanRepo.ListRatePlans(ctx, offset, limit)
+}
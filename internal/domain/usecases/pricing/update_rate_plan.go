@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type updateRatePlanUsecase struct {
+	ratePlanRepo ports.RatePlanRepository
+}
+
+type UpdateRatePlanUsecase interface {
+	// Execute replaces the rates and seasons of the plan. The car or category
+	// the plan is attached to cannot be changed.
+	Execute(ctx context.Context, id int64, input RatePlanInput) (*entities.RatePlan, error)
+}
+
+func NewUpdateRatePlanUsecase(ratePlanRepo ports.RatePlanRepository) UpdateRatePlanUsecase {
+	return &updateRatePlanUsecase{ratePlanRepo: ratePlanRepo}
+}
+
+func (u *updateRatePlanUsecase) Execute(ctx context.Context, id int64, input RatePlanInput) (*entities.RatePlan, error) {
+	plan, err := u.ratePlanRepo.GetRatePlanByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Name = strings.TrimSpace(input.Name)
+	plan.ShortDailyRate = input.ShortDailyRate
+	plan.MediumDailyRate = input.MediumDailyRate
+	plan.LongDailyRate = input.LongDailyRate
+	plan.WeekendSurchargePercent = input.WeekendSurchargePercent
+	plan.ChauffeurDailyRate = input.ChauffeurDailyRate
+	plan.Seasons = input.Seasons
+	if plan.Seasons == nil {
+		plan.Seasons = make([]*entities.SeasonalRate, 0)
+	}
+	plan.UpdatedAt = time.Now()
+
+	if err := plan.Validate(); err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, err.Error())
+	}
+
+	if err := u.ratePlanRepo.UpdateRatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
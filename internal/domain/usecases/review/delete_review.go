@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type deleteReviewUsecase struct {
+	reviewRepo   ports.ReviewRepository
+	imageService ports.ImageService
+}
+
+type DeleteReviewUsecase interface {
+	Execute(ctx context.Context, id int64) error
+}
+
+func NewDeleteReviewUsecase(reviewRepo ports.ReviewRepository, imageService ports.ImageService) DeleteReviewUsecase {
+	return &deleteReviewUsecase{
+		reviewRepo:   reviewRepo,
+		imageService: imageService,
+	}
+}
+
+func (u *deleteReviewUsecase) Execute(ctx context.Context, id int64) error {
+	review, err := u.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.reviewRepo.DeleteReview(ctx, id); err != nil {
+		return err
+	}
+
+	// The review is gone either way; leftover files are only wasted space
+	for _, photo := range review.Photos {
+		_ = u.imageService.DeleteImageWithVariants(photo.Path, photo.Variants)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type getReviewByIdUsecase struct {
+	reviewRepo ports.ReviewRepository
+}
+
+type GetReviewByIdUsecase interface {
+	Execute(ctx context.Context, id int64) (*entities.Review, error)
+}
+
+func NewGetReviewByIdUsecase(reviewRepo ports.ReviewRepository) GetReviewByIdUsecase {
+	return &getReviewByIdUsecase{reviewRepo: reviewRepo}
+}
+
+func (u *getReviewByIdUsecase) Execute(ctx context.Context, id int64) (*entities.Review, error) {
+	return u.reviewRepo.GetReviewByID(ctx, id)
+}
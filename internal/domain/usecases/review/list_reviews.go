@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type listReviewsUsecase struct {
+	reviewRepo ports.ReviewRepository
+}
+
+type ListReviewsUsecase interface {
+	Execute(ctx context.Context, offset, limit int64, filter ports.ReviewFilter) (int64, []*entities.Review, error)
+}
+
+func NewListReviewsUsecase(reviewRepo ports.ReviewRepository) ListReviewsUsecase {
+	return &listReviewsUsecase{reviewRepo: reviewRepo}
+}
+
+func (u *listReviewsUsecase) Execute(ctx context.Context, offset, limit int64, filter ports.ReviewFilter) (int64, []*entities.Review, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return 0, nil, apperrors.New(apperrors.ErrCodeValidation, "invalid review status")
+	}
+	return u.reviewRepo.ListReviews(ctx, offset, limit, filter)
+}
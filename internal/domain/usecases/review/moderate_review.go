@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type moderateReviewUsecase struct {
+	reviewRepo ports.ReviewRepository
+}
+
+type ModerateReviewUsecase interface {
+	Execute(ctx context.Context, id int64, status entities.ReviewStatus, reason string, moderatorID int64) (*entities.Review, error)
+}
+
+func NewModerateReviewUsecase(reviewRepo ports.ReviewRepository) ModerateReviewUsecase {
+	return &moderateReviewUsecase{reviewRepo: reviewRepo}
+}
+
+func (u *moderateReviewUsecase) Execute(ctx context.Context, id int64, status entities.ReviewStatus, reason string, moderatorID int64) (*entities.Review, error) {
+	review, err := u.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.IsValid() {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "invalid review status")
+	}
+
+	fromStatus := review.Status
+	if err := review.Moderate(status, reason, moderatorID); err != nil {
+		if review.Status.CanTransitionTo(status) {
+			return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+		}
+		return nil, apperrors.New(apperrors.ErrCodeConflict, err.Error())
+	}
+
+	if err := u.reviewRepo.UpdateReviewModeration(ctx, review, fromStatus); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	leadUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
+)
+
+// SubmitReviewLimits configures how many anonymous reviews are allowed per
+// client IP and how many attempts are allowed per booking within Window.
+type SubmitReviewLimits struct {
+	PerIP      int
+	PerBooking int
+	Window     time.Duration
+}
+
+type ReviewPhotoUpload struct {
+	File     io.Reader
+	FileName string
+}
+
+type SubmitReviewInput struct {
+	BookingID    int64
+	Phone        string
+	AuthorName   string
+	Rating       int
+	DriverRating *int
+	Text         string
+	Photos       []ReviewPhotoUpload
+	CaptchaToken string
+	Honeypot     string
+	RemoteIP     string
+}
+
+type submitReviewUsecase struct {
+	reviewRepo      ports.ReviewRepository
+	bookingRepo     ports.BookingRepository
+	imageService    ports.ImageService
+	uploadValidator ports.ImageUploadValidator
+	captcha         ports.CaptchaVerifier
+	rateLimiter     ports.RateLimiter
+	limits          SubmitReviewLimits
+	phoneRegion     leadUsecases.PhoneRegion
+}
+
+type SubmitReviewUsecase interface {
+	Execute(ctx context.Context, input SubmitReviewInput) (*entities.Review, error)
+}
+
+func NewSubmitReviewUsecase(
+	reviewRepo ports.ReviewRepository,
+	bookingRepo ports.BookingRepository,
+	imageService ports.ImageService,
+	uploadValidator ports.ImageUploadValidator,
+	captcha ports.CaptchaVerifier,
+	rateLimiter ports.RateLimiter,
+	limits SubmitReviewLimits,
+	phoneRegion leadUsecases.PhoneRegion,
+) SubmitReviewUsecase {
+	return &submitReviewUsecase{
+		reviewRepo:      reviewRepo,
+		bookingRepo:     bookingRepo,
+		imageService:    imageService,
+		uploadValidator: uploadValidator,
+		captcha:         captcha,
+		rateLimiter:     rateLimiter,
+		limits:          limits,
+		phoneRegion:     phoneRegion,
+	}
+}
+
+func (u *submitReviewUsecase) Execute(ctx context.Context, input SubmitReviewInput) (*entities.Review, error) {
+	// Same honeypot trick as the lead form
+	if strings.TrimSpace(input.Honeypot) != "" {
+		return nil, nil
+	}
+
+	if input.RemoteIP != "" {
+		allowed, err := u.rateLimiter.Allow(ctx, "review:ip:"+input.RemoteIP, u.limits.PerIP, u.limits.Window)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+		}
+		if !allowed {
+			return nil, apperrors.ErrTooManyRequests
+		}
+	}
+
+	// The captcha provider is called only for clients within the limits
+	ok, err := u.captcha.Verify(ctx, input.CaptchaToken, input.RemoteIP)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, apperrors.ErrCaptchaFailed
+	}
+
+	if len(input.Photos) > entities.MaxReviewPhotos {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("a review can have at most %d photos", entities.MaxReviewPhotos))
+	}
+
+	// Booking IDs are sequential, so the phone is all that guards a booking.
+	// Every attempt counts, whether the phone is right or not, so it cannot be
+	// guessed from many IPs.
+	allowed, err := u.rateLimiter.Allow(ctx, fmt.Sprintf("review:booking:%d", input.BookingID), u.limits.PerBooking, u.limits.Window)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка проверки лимита запросов")
+	}
+	if !allowed {
+		return nil, apperrors.ErrTooManyRequests
+	}
+
+	// The phone on the booking proves the reviewer is the customer. A wrong
+	// phone looks exactly like a missing booking so IDs cannot be probed.
+	booking, err := u.bookingRepo.GetBookingByID(ctx, input.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.samePhone(booking.CustomerPhone, input.Phone) {
+		return nil, apperrors.ErrBookingNotFound
+	}
+
+	review, err := entities.NewReview(booking, input.AuthorName, input.Rating, input.DriverRating, input.Text)
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+	}
+
+	if _, err := u.reviewRepo.GetReviewByBookingID(ctx, booking.ID); err == nil {
+		return nil, apperrors.ErrReviewAlreadyExists
+	} else if appErr, ok := apperrors.AsAppError(err); !ok || appErr.Code != apperrors.ErrCodeNotFound {
+		return nil, err
+	}
+
+	// Validate every upload before storing any of them
+	uploads := make([]*ports.UploadedImage, 0, len(input.Photos))
+	for _, photo := range input.Photos {
+		upload, err := u.uploadValidator.ReadImage(photo.File, photo.FileName)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, upload)
+	}
+
+	for _, upload := range uploads {
+		imagePath, variants, err := u.imageService.SaveImageVariants(upload.Data, "reviews", upload.FileName)
+		if err != nil {
+			u.deletePhotos(review.Photos)
+			return nil, err
+		}
+		if err := review.AddPhoto(imagePath, variants); err != nil {
+			_ = u.imageService.DeleteImageWithVariants(imagePath, variants)
+			u.deletePhotos(review.Photos)
+			return nil, apperrors.New(apperrors.ErrCodeValidation, err.Error())
+		}
+	}
+
+	if err := u.reviewRepo.CreateReview(ctx, review); err != nil {
+		u.deletePhotos(review.Photos)
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// samePhone compares the E.164 forms, so any spelling of the customer's
+// number matches and a number that does not parse never does
+func (u *submitReviewUsecase) samePhone(bookingPhone, phone string) bool {
+	region := string(u.phoneRegion)
+	normalized, err := utils.NormalizePhone(phone, region)
+	if err != nil {
+		return false
+	}
+	bookingNormalized, err := utils.NormalizePhone(bookingPhone, region)
+	if err != nil {
+		return false
+	}
+	return normalized == bookingNormalized
+}
+
+func (u *submitReviewUsecase) deletePhotos(photos []entities.ReviewPhoto) {
+	for _, photo := range photos {
+		_ = u.imageService.DeleteImageWithVariants(photo.Path, photo.Variants)
+	}
+}
@@ -27,28 +27,21 @@ func NewJWTTokenService(accessSecret, refreshSecret string, accessDuration, refr
 	}
 }
 
-func (s *jwtTokenService) GenerateTokens(user *entities.User) (*entities.Tokens, error) {
+func (s *jwtTokenService) GenerateTokens(user *entities.User, sessionID string) (*entities.Tokens, error) {
 	now := time.Now()
 
-	// Generate access token with accessSecret
-	accessClaims := jwt.MapClaims{
-		"sub":   strconv.FormatInt(user.ID, 10),
-		"email": user.Email,
-		"typ":   "access",
-		"exp":   now.Add(s.accessTokenDuration).Unix(),
-		"iat":   now.Unix(),
-	}
-	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessToken, err := accessTokenObj.SignedString([]byte(s.accessSecret))
+	accessToken, err := s.GenerateAccessToken(user)
 	if err != nil {
-		return nil, apperrors.New(apperrors.ErrCodeInternal, "Ошибка генерации access токена")
+		return nil, err
 	}
 
 	// Generate refresh token with refreshSecret
+	refreshExpiresAt := now.Add(s.refreshTokenDuration)
 	refreshClaims := jwt.MapClaims{
 		"sub": strconv.FormatInt(user.ID, 10),
+		"jti": sessionID,
 		"typ": "refresh",
-		"exp": now.Add(s.refreshTokenDuration).Unix(),
+		"exp": refreshExpiresAt.Unix(),
 		"iat": now.Unix(),
 	}
 	refreshTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
@@ -58,13 +51,34 @@ func (s *jwtTokenService) GenerateTokens(user *entities.User) (*entities.Tokens,
 	}
 
 	tokens := &entities.Tokens{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
 	}
 	return tokens, nil
 }
 
-func (s *jwtTokenService) ValidateAccessToken(tokenStr string) (int64, error) {
+func (s *jwtTokenService) GenerateAccessToken(user *entities.User) (string, error) {
+	now := time.Now()
+
+	// Generate access token with accessSecret
+	accessClaims := jwt.MapClaims{
+		"sub":   strconv.FormatInt(user.ID, 10),
+		"email": user.Email,
+		"role":  string(user.Role),
+		"typ":   "access",
+		"exp":   now.Add(s.accessTokenDuration).Unix(),
+		"iat":   now.Unix(),
+	}
+	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
+	accessToken, err := accessTokenObj.SignedString([]byte(s.accessSecret))
+	if err != nil {
+		return "", apperrors.New(apperrors.ErrCodeInternal, "Ошибка генерации access токена")
+	}
+	return accessToken, nil
+}
+
+func (s *jwtTokenService) ValidateAccessToken(tokenStr string) (*entities.AccessClaims, error) {
 	// Validate with accessSecret
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -73,31 +87,40 @@ func (s *jwtTokenService) ValidateAccessToken(tokenStr string) (int64, error) {
 		return []byte(s.accessSecret), nil
 	})
 	if err != nil || !token.Valid {
-		return 0, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 
 	if typ, _ := claims["typ"].(string); typ != "access" {
-		return 0, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 
 	sub, ok := claims["sub"].(string)
 	if !ok {
-		return 0, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 	id, err := strconv.ParseInt(sub, 10, 64)
 	if err != nil {
-		return 0, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
-	return id, nil
+
+	roleStr, _ := claims["role"].(string)
+	role := entities.UserRole(roleStr)
+	if !role.IsValid() {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	return &entities.AccessClaims{
+		UserID: id,
+		Role:   role,
+	}, nil
 }
 
-func (s *jwtTokenService) RefreshAccessToken(refreshTokenStr string) (string, error) {
-	// Validate refresh token with refreshSecret
+func (s *jwtTokenService) ParseRefreshToken(refreshTokenStr string) (*entities.RefreshClaims, error) {
 	token, err := jwt.Parse(refreshTokenStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "Invalid signing method")
@@ -105,33 +128,35 @@ func (s *jwtTokenService) RefreshAccessToken(refreshTokenStr string) (string, er
 		return []byte(s.refreshSecret), nil
 	})
 	if err != nil || !token.Valid {
-		return "", apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 	if typ, _ := claims["typ"].(string); typ != "refresh" {
-		return "", apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 	sub, ok := claims["sub"].(string)
 	if !ok {
-		return "", apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
-
-	// Generate new access token with accessSecret
-	now := time.Now()
-	accessClaims := jwt.MapClaims{
-		"sub": sub,
-		"typ": "access",
-		"exp": now.Add(s.accessTokenDuration).Unix(),
-		"iat": now.Unix(),
-	}
-	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessToken, err := accessTokenObj.SignedString([]byte(s.accessSecret))
+	id, err := strconv.ParseInt(sub, 10, 64)
 	if err != nil {
-		return "", apperrors.New(apperrors.ErrCodeInternal, "Ошибка генерации access токена")
+		return nil, apperrors.ErrUnauthorized
 	}
-	return accessToken, nil
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return nil, apperrors.ErrUnauthorized
+	}
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return &entities.RefreshClaims{
+		UserID:   id,
+		JTI:      jti,
+		IssuedAt: iat.Time,
+	}, nil
 }
@@ -0,0 +1,71 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// HTTPCaptchaVerifier talks to any "siteverify"-style endpoint. Cloudflare
+// Turnstile, hCaptcha and Google reCAPTCHA all share the same contract:
+// a form POST with secret, response and remoteip that returns {"success": bool}.
+type HTTPCaptchaVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func NewHTTPCaptchaVerifier(verifyURL, secret string) ports.CaptchaVerifier {
+	return &HTTPCaptchaVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (v *HTTPCaptchaVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	if strings.TrimSpace(token) == "" {
+		return false, nil
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования запроса проверки капчи")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, apperrors.Wrap(err, apperrors.ErrCodeExternal, "Сервис проверки капчи недоступен")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, apperrors.New(apperrors.ErrCodeExternal, "Сервис проверки капчи вернул ошибку")
+	}
+
+	var result siteVerifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, apperrors.Wrap(err, apperrors.ErrCodeExternal, "Неверный ответ сервиса проверки капчи")
+	}
+
+	return result.Success, nil
+}
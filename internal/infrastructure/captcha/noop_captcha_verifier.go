@@ -0,0 +1,19 @@
+package captcha
+
+import (
+	"context"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// NoopCaptchaVerifier accepts every token. It is meant for local runs and
+// tests where no captcha provider is configured.
+type NoopCaptchaVerifier struct{}
+
+func NewNoopCaptchaVerifier() ports.CaptchaVerifier {
+	return &NoopCaptchaVerifier{}
+}
+
+func (v *NoopCaptchaVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	return true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 )
 
@@ -38,3 +39,15 @@ func (s *ConsoleEmailService) SendPasswordResetCode(ctx context.Context, email,
 	fmt.Println("=====================================")
 	return nil
 }
+
+func (s *ConsoleEmailService) SendNewLeadNotification(ctx context.Context, email string, lead *entities.Lead) error {
+	data := newLeadTemplateData(lead)
+	fmt.Println("=====================================")
+	fmt.Println("📥 NEW LEAD NOTIFICATION")
+	fmt.Println("=====================================")
+	fmt.Printf("To: %s\n", email)
+	fmt.Printf("Lead: #%d %s, %s\n", data.ID, data.FullName, data.Phone)
+	fmt.Printf("Period: %s — %s\n", data.StartDate, data.EndDate)
+	fmt.Println("=====================================")
+	return nil
+}
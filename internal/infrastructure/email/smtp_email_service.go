@@ -10,6 +10,7 @@ import (
 	"mime"
 	"net/smtp"
 
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
@@ -65,6 +66,20 @@ func (s *SMTPEmailService) SendPasswordResetCode(ctx context.Context, email, cod
 	return s.sendMultipartEmail(email, subject, plainTextBody, htmlBody)
 }
 
+func (s *SMTPEmailService) SendNewLeadNotification(ctx context.Context, email string, lead *entities.Lead) error {
+	subject := fmt.Sprintf("Новая заявка #%d", lead.ID)
+	data := newLeadTemplateData(lead)
+
+	htmlBody, err := s.templateManager.Render("new_lead.html", data)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка рендеринга HTML шаблона")
+	}
+
+	plainTextBody := s.templateManager.GetLeadPlainText(data)
+
+	return s.sendMultipartEmail(email, subject, plainTextBody, htmlBody)
+}
+
 func (s *SMTPEmailService) sendMultipartEmail(to, subject, plainText, htmlBody string) error {
 	addr := fmt.Sprintf("%s:%s", s.config.Host, s.config.Port)
 	auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
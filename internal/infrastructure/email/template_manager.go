@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"html/template"
 	"path/filepath"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 )
 
 //go:embed templates/*.html
@@ -15,10 +17,38 @@ type TemplateData struct {
 	Code string
 }
 
+// LeadTemplateData fills the staff notification about a new lead
+type LeadTemplateData struct {
+	ID         int64
+	FullName   string
+	Phone      string
+	StartDate  string
+	EndDate    string
+	CarID      *int64
+	WithDriver bool
+	Total      *int64
+}
+
 type TemplateManager struct {
 	templates map[string]*template.Template
 }
 
+func newLeadTemplateData(lead *entities.Lead) LeadTemplateData {
+	data := LeadTemplateData{
+		ID:         lead.ID,
+		FullName:   lead.FullName,
+		Phone:      lead.Phone,
+		StartDate:  lead.StartDate.Format("02.01.2006 15:04"),
+		EndDate:    lead.EndDate.Format("02.01.2006 15:04"),
+		CarID:      lead.CarID,
+		WithDriver: lead.WithDriver,
+	}
+	if lead.PriceQuote != nil {
+		data.Total = &lead.PriceQuote.Total
+	}
+	return data
+}
+
 func NewTemplateManager() (*TemplateManager, error) {
 	tm := &TemplateManager{
 		templates: make(map[string]*template.Template),
@@ -27,6 +57,7 @@ func NewTemplateManager() (*TemplateManager, error) {
 	templateFiles := []string{
 		"templates/verification_code.html",
 		"templates/password_reset.html",
+		"templates/new_lead.html",
 	}
 
 	for _, tmplFile := range templateFiles {
@@ -42,7 +73,7 @@ func NewTemplateManager() (*TemplateManager, error) {
 	return tm, nil
 }
 
-func (tm *TemplateManager) Render(templateName string, data TemplateData) (string, error) {
+func (tm *TemplateManager) Render(templateName string, data any) (string, error) {
 	tmpl, exists := tm.templates[templateName]
 	if !exists {
 		return "", fmt.Errorf("template %s not found", templateName)
@@ -69,3 +100,27 @@ func (tm *TemplateManager) GetPlainText(code string) string {
 Команда Imperial
 `, code)
 }
+
+func (tm *TemplateManager) GetLeadPlainText(data LeadTemplateData) string {
+	text := fmt.Sprintf(`
+Новая заявка #%d
+
+Имя: %s
+Телефон: %s
+Период: %s — %s
+`, data.ID, data.FullName, data.Phone, data.StartDate, data.EndDate)
+
+	if data.CarID != nil {
+		text += fmt.Sprintf("Автомобиль: #%d\n", *data.CarID)
+	}
+	if data.WithDriver {
+		text += "С водителем: да\n"
+	}
+	if data.Total != nil {
+		text += fmt.Sprintf("Расчетная стоимость: %d\n", *data.Total)
+	}
+
+	return text + `
+Команда Imperial
+`
+}
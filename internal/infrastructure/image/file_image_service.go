@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/pkg/errors"
 )
@@ -14,15 +15,17 @@ import (
 type FileImageService struct {
 	storagePath string
 	baseURL     string
+	processor   *Processor
 }
 
-func NewFileImageService(storagePath, baseURL string) (ports.ImageService, error) {
+func NewFileImageService(storagePath, baseURL string, processor *Processor) (ports.ImageService, error) {
 	if err := os.MkdirAll(storagePath, 0755); err != nil {
 		return nil, errors.Wrap(err, errors.ErrCodeInternal, "failed to create storage directory")
 	}
 	return &FileImageService{
 		storagePath: storagePath,
 		baseURL:     baseURL,
+		processor:   processor,
 	}, nil
 }
 
@@ -31,9 +34,17 @@ func (s *FileImageService) SaveImage(fileData []byte, folderName, fileName strin
 	if err := os.MkdirAll(carDir, 0755); err != nil {
 		return "", errors.Wrap(err, errors.ErrCodeInternal, "failed to create"+folderName+" directory")
 	}
-	ext := filepath.Ext(fileName)
-	timestamp := time.Now().Unix()
-	newFileName := fmt.Sprintf("%d_%s%s", timestamp, strings.TrimSuffix(fileName, ext), ext)
+	// The stored extension always follows the real content, so a file can
+	// never be served from /uploads as HTML or a script
+	format := detectFormat(fileData)
+	if format == nil {
+		return "", errors.New(errors.ErrCodeValidation, "unsupported file type")
+	}
+	name, err := safeFileName(fileName, format.ext)
+	if err != nil {
+		return "", errors.Wrap(err, errors.ErrCodeInternal, "failed to generate file name")
+	}
+	newFileName := fmt.Sprintf("%d_%s", time.Now().Unix(), name)
 	filePath := filepath.Join(carDir, newFileName)
 	if err := os.WriteFile(filePath, fileData, 0644); err != nil {
 		return "", errors.Wrap(err, errors.ErrCodeInternal, "failed to save image file")
@@ -42,6 +53,41 @@ func (s *FileImageService) SaveImage(fileData []byte, folderName, fileName strin
 	return relativePath, nil
 }
 
+func (s *FileImageService) SaveImageVariants(fileData []byte, folderName, fileName string) (string, []entities.ImageVariant, error) {
+	dir := filepath.Join(s.storagePath, folderName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", nil, errors.Wrap(err, errors.ErrCodeInternal, "failed to create "+folderName+" directory")
+	}
+
+	return s.processor.storeVariants(fileData, folderName, fileName,
+		func(relPath string, data []byte) error {
+			return os.WriteFile(filepath.Join(s.storagePath, relPath), data, 0644)
+		},
+		func(relPath string) error {
+			return os.Remove(filepath.Join(s.storagePath, relPath))
+		},
+	)
+}
+
+func (s *FileImageService) DeleteImageWithVariants(imagePath string, variants []entities.ImageVariant) error {
+	paths := make([]string, 0, len(variants)+1)
+	if imagePath != "" {
+		paths = append(paths, imagePath)
+	}
+	for _, v := range variants {
+		if v.Path != imagePath {
+			paths = append(paths, v.Path)
+		}
+	}
+
+	for _, p := range paths {
+		if err := os.Remove(filepath.Join(s.storagePath, p)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, errors.ErrCodeInternal, "failed to delete image")
+		}
+	}
+	return nil
+}
+
 func (s *FileImageService) DeleteImage(imagePath string) error {
 	fullPath := filepath.Join(s.storagePath, imagePath)
 	if err := os.Remove(fullPath); err != nil {
@@ -55,6 +101,9 @@ func (s *FileImageService) DeleteImage(imagePath string) error {
 
 func (s *FileImageService) GetFullImagePath(imagePath string) string {
 	// imagePath это "cars/filename.png"
-	// return "images/cars/filename.png"
-	return filepath.Join("images", imagePath)
+	// return "http://localhost:8080/uploads/cars/filename.png"
+	if imagePath == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.baseURL, "/") + "/" + filepath.ToSlash(imagePath)
 }
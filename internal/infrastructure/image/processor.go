@@ -0,0 +1,150 @@
+package image
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type variantSpec struct {
+	name      entities.ImageVariantName
+	maxWidth  int
+	maxHeight int
+}
+
+// variantSpecs are the bounding boxes each variant is scaled into.
+var variantSpecs = []variantSpec{
+	{name: entities.ImageVariantThumb, maxWidth: 320, maxHeight: 320},
+	{name: entities.ImageVariantCard, maxWidth: 800, maxHeight: 800},
+	{name: entities.ImageVariantFull, maxWidth: 1920, maxHeight: 1920},
+}
+
+// encodedVariant is a variant ready to be written to storage.
+type encodedVariant struct {
+	name   entities.ImageVariantName
+	format string
+	ext    string
+	width  int
+	height int
+	data   []byte
+}
+
+// Processor turns an uploaded image into the set of variants served to the
+// site. Decoding and re-encoding drops EXIF and every other metadata block.
+type Processor struct {
+	jpegQuality int
+	webp        *WebPEncoder
+}
+
+// NewProcessor creates a processor. webp may be nil, in which case only
+// JPEG/PNG variants are produced.
+func NewProcessor(jpegQuality int, webp *WebPEncoder) *Processor {
+	if jpegQuality <= 0 || jpegQuality > 100 {
+		jpegQuality = jpeg.DefaultQuality
+	}
+	return &Processor{jpegQuality: jpegQuality, webp: webp}
+}
+
+func (p *Processor) process(data []byte) ([]encodedVariant, error) {
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeValidation, "unsupported or corrupted image")
+	}
+
+	src := applyOrientation(toNRGBA(decoded), jpegOrientation(data))
+	opaque := src.Opaque()
+
+	variants := make([]encodedVariant, 0, len(variantSpecs)*2)
+	for _, spec := range variantSpecs {
+		w, h := fitWithin(src.Bounds().Dx(), src.Bounds().Dy(), spec.maxWidth, spec.maxHeight)
+		resized := resizeArea(src, w, h)
+
+		var buf bytes.Buffer
+		v := encodedVariant{name: spec.name, width: w, height: h}
+		if opaque {
+			v.format, v.ext = "jpeg", ".jpg"
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: p.jpegQuality})
+		} else {
+			v.format, v.ext = "png", ".png"
+			err = png.Encode(&buf, resized)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrCodeInternal, "failed to encode image variant")
+		}
+		v.data = buf.Bytes()
+		variants = append(variants, v)
+
+		if p.webp == nil {
+			continue
+		}
+		webpData, err := p.webp.Encode(resized)
+		if err != nil {
+			// WebP is an optimisation; the JPEG/PNG variant is still served
+			log.Printf("webp encoding of %s variant failed: %v", spec.name, err)
+			continue
+		}
+		variants = append(variants, encodedVariant{
+			name:   spec.name,
+			format: "webp",
+			ext:    ".webp",
+			width:  w,
+			height: h,
+			data:   webpData,
+		})
+	}
+
+	return variants, nil
+}
+
+// storeVariants processes data and hands every variant to write under
+// folder. Variant files share a timestamped base name, e.g.
+// "cars/1700000000_s-class_card.jpg". If any write fails, the variants
+// already written are removed with remove.
+func (p *Processor) storeVariants(
+	data []byte,
+	folder, fileName string,
+	write func(relPath string, data []byte) error,
+	remove func(relPath string) error,
+) (string, []entities.ImageVariant, error) {
+	encoded, err := p.process(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	base := fmt.Sprintf("%d_%s", time.Now().Unix(), strings.TrimSuffix(fileName, filepath.Ext(fileName)))
+
+	var primary string
+	variants := make([]entities.ImageVariant, 0, len(encoded))
+	for _, v := range encoded {
+		relPath := path.Join(folder, fmt.Sprintf("%s_%s%s", base, v.name, v.ext))
+		if err := write(relPath, v.data); err != nil {
+			for _, written := range variants {
+				_ = remove(written.Path)
+			}
+			return "", nil, errors.Wrap(err, errors.ErrCodeInternal, "failed to save image variant")
+		}
+		variants = append(variants, entities.ImageVariant{
+			Name:   v.name,
+			Format: v.format,
+			Path:   relPath,
+			Width:  v.width,
+			Height: v.height,
+		})
+		if v.name == entities.ImageVariantFull && v.format != "webp" {
+			primary = relPath
+		}
+	}
+
+	return primary, variants, nil
+}
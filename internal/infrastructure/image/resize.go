@@ -0,0 +1,113 @@
+package image
+
+import (
+	"image"
+	"math"
+)
+
+// fitWithin scales (w, h) down to fit inside (maxW, maxH) keeping the aspect
+// ratio. Images are never upscaled.
+func fitWithin(w, h, maxW, maxH int) (int, int) {
+	if w <= maxW && h <= maxH {
+		return w, h
+	}
+	scale := math.Min(float64(maxW)/float64(w), float64(maxH)/float64(h))
+	nw := int(math.Round(float64(w) * scale))
+	nh := int(math.Round(float64(h) * scale))
+	return max(nw, 1), max(nh, 1)
+}
+
+type contribution struct {
+	start   int
+	weights []float64
+}
+
+// areaWeights computes, for every destination pixel, the source pixels it
+// covers and how much of each it covers. Averaging over the covered area
+// gives clean results for the downscaling we do.
+func areaWeights(src, dst int) []contribution {
+	scale := float64(src) / float64(dst)
+	out := make([]contribution, dst)
+	for i := range out {
+		lo := float64(i) * scale
+		hi := lo + scale
+		start := int(math.Floor(lo))
+		end := min(int(math.Ceil(hi)), src)
+		weights := make([]float64, end-start)
+		var sum float64
+		for j := start; j < end; j++ {
+			w := math.Min(hi, float64(j+1)) - math.Max(lo, float64(j))
+			weights[j-start] = w
+			sum += w
+		}
+		for k := range weights {
+			weights[k] /= sum
+		}
+		out[i] = contribution{start: start, weights: weights}
+	}
+	return out
+}
+
+// resizeArea resamples src to (dw, dh). Colour channels are averaged
+// premultiplied by alpha so transparent pixels do not darken edges.
+func resizeArea(src *image.NRGBA, dw, dh int) *image.NRGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	if sw == dw && sh == dh {
+		return src
+	}
+
+	xw := areaWeights(sw, dw)
+	yw := areaWeights(sh, dh)
+
+	// Horizontal pass into a premultiplied float buffer of dw x sh
+	tmp := make([]float64, dw*sh*4)
+	for y := 0; y < sh; y++ {
+		row := src.Pix[y*src.Stride:]
+		for x, c := range xw {
+			var r, g, b, a float64
+			for k, w := range c.weights {
+				p := row[(c.start+k)*4:]
+				pa := float64(p[3]) * w
+				r += float64(p[0]) * pa
+				g += float64(p[1]) * pa
+				b += float64(p[2]) * pa
+				a += pa
+			}
+			o := (y*dw + x) * 4
+			tmp[o], tmp[o+1], tmp[o+2], tmp[o+3] = r, g, b, a
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y, c := range yw {
+		for x := 0; x < dw; x++ {
+			var r, g, b, a float64
+			for k, w := range c.weights {
+				o := ((c.start+k)*dw + x) * 4
+				r += tmp[o] * w
+				g += tmp[o+1] * w
+				b += tmp[o+2] * w
+				a += tmp[o+3] * w
+			}
+			d := dst.PixOffset(x, y)
+			if a > 0 {
+				dst.Pix[d] = clampByte(r / a)
+				dst.Pix[d+1] = clampByte(g / a)
+				dst.Pix[d+2] = clampByte(b / a)
+			}
+			dst.Pix[d+3] = clampByte(a)
+		}
+	}
+
+	return dst
+}
+
+func clampByte(v float64) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return uint8(v + 0.5)
+}
@@ -0,0 +1,283 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3TimeFormat    = "20060102T150405Z"
+	s3DateFormat    = "20060102"
+	s3UnsignedBody  = "UNSIGNED-PAYLOAD"
+	s3MaxPresignTTL = 7 * 24 * time.Hour
+)
+
+// S3Options configures access to an S3-compatible bucket (AWS S3, MinIO, ...).
+type S3Options struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// UsePathStyle addresses objects as endpoint/bucket/key, which MinIO
+	// requires unless it is set up with virtual-host DNS
+	UsePathStyle bool
+}
+
+// S3Client is a minimal Signature V4 client covering the object operations
+// the image storage needs.
+type S3Client struct {
+	endpoint   *url.URL
+	opts       S3Options
+	httpClient *http.Client
+}
+
+// S3Error is a non-2xx response from the object storage.
+type S3Error struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *S3Error) Error() string {
+	return fmt.Sprintf("s3 request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+func NewS3Client(opts S3Options) (*S3Client, error) {
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q: expected http(s)://host[:port]", opts.Endpoint)
+	}
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	return &S3Client{
+		endpoint:   endpoint,
+		opts:       opts,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+func (c *S3Client) PutObject(ctx context.Context, key string, data []byte, contentType string) error {
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	return c.do(ctx, http.MethodPut, key, header, data)
+}
+
+func (c *S3Client) DeleteObject(ctx context.Context, key string) error {
+	return c.do(ctx, http.MethodDelete, key, nil, nil)
+}
+
+// ObjectExists reports whether key is present in the bucket.
+func (c *S3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
+	err := c.do(ctx, http.MethodHead, key, nil, nil)
+	if err == nil {
+		return true, nil
+	}
+	if s3Err, ok := err.(*S3Error); ok && s3Err.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return false, err
+}
+
+// PresignGetObject returns a URL that allows anyone to download key until it
+// expires. S3 caps the lifetime at seven days.
+func (c *S3Client) PresignGetObject(key string, expires time.Duration) string {
+	return c.presignGetObject(key, expires, time.Now().UTC())
+}
+
+func (c *S3Client) presignGetObject(key string, expires time.Duration, now time.Time) string {
+	if expires <= 0 || expires > s3MaxPresignTTL {
+		expires = s3MaxPresignTTL
+	}
+	u := c.objectURL(key)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", c.opts.AccessKey+"/"+c.scope(now))
+	query.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalQuery := canonicalQueryString(query)
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedBody,
+	}, "\n")
+
+	u.RawQuery = canonicalQuery + "&X-Amz-Signature=" + c.signature(now, canonicalRequest)
+	return u.String()
+}
+
+func (c *S3Client) do(ctx context.Context, method, key string, header http.Header, body []byte) error {
+	now := time.Now().UTC()
+
+	req, err := http.NewRequestWithContext(ctx, method, c.objectURL(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.ContentLength = int64(len(body))
+	c.sign(req, sha256Hex(body), now)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &S3Error{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(msg))}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// sign adds the date, payload hash and Authorization headers to req. Every
+// header already set on req is signed, so callers must not add headers after.
+func (c *S3Client) sign(req *http.Request, payloadHash string, now time.Time) {
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders, canonicalHeaders := canonicalHeaderList(req.URL.Host, req.Header)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, c.opts.AccessKey, c.scope(now), signedHeaders, c.signature(now, canonicalRequest)))
+}
+
+// objectURL builds the address of key with every segment escaped the way
+// Signature V4 expects, so EscapedPath can be used as the canonical URI.
+func (c *S3Client) objectURL(key string) *url.URL {
+	segments := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	for i, s := range segments {
+		segments[i] = s3Escape(s)
+	}
+	escapedKey := strings.Join(segments, "/")
+
+	u := *c.endpoint
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if c.opts.UsePathStyle {
+		u.RawPath = basePath + "/" + s3Escape(c.opts.Bucket) + "/" + escapedKey
+	} else {
+		u.Host = c.opts.Bucket + "." + u.Host
+		u.RawPath = basePath + "/" + escapedKey
+	}
+	u.Path, _ = url.PathUnescape(u.RawPath)
+	u.RawQuery = ""
+	return &u
+}
+
+func (c *S3Client) scope(t time.Time) string {
+	return strings.Join([]string{t.Format(s3DateFormat), c.opts.Region, s3Service, "aws4_request"}, "/")
+}
+
+func (c *S3Client) signature(t time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format(s3TimeFormat),
+		c.scope(t),
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.opts.SecretKey), t.Format(s3DateFormat))
+	key = hmacSHA256(key, c.opts.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func canonicalHeaderList(host string, header http.Header) (string, string) {
+	values := map[string]string{"host": host}
+	for name, v := range header {
+		values[strings.ToLower(name)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + values[name] + "\n")
+	}
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func canonicalQueryString(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Escape percent-encodes everything except RFC 3986 unreserved characters.
+func s3Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch >= 'A' && ch <= 'Z' || ch >= 'a' && ch <= 'z' || ch >= '0' && ch <= '9' ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", ch)
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
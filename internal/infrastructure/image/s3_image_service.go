@@ -0,0 +1,151 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+const s3RequestTimeout = 30 * time.Second
+
+// S3ImageService keeps images in an S3-compatible bucket so every API replica
+// sees the same files. Object keys are the same relative paths the local
+// storage uses ("cars/1700000000_s-class_full.jpg"), which keeps the paths
+// already stored in the database valid after migrating.
+type S3ImageService struct {
+	client        *S3Client
+	publicURL     string
+	presignExpiry time.Duration
+	processor     *Processor
+}
+
+// NewS3ImageService creates the service. When publicURL is set (a public
+// bucket or a CDN in front of it) GetFullImagePath returns plain links,
+// otherwise it returns presigned URLs valid for presignExpiry.
+func NewS3ImageService(client *S3Client, publicURL string, presignExpiry time.Duration, processor *Processor) ports.ImageService {
+	return &S3ImageService{
+		client:        client,
+		publicURL:     strings.TrimSuffix(publicURL, "/"),
+		presignExpiry: presignExpiry,
+		processor:     processor,
+	}
+}
+
+func (s *S3ImageService) SaveImage(fileData []byte, folderName, fileName string) (string, error) {
+	format := detectFormat(fileData)
+	if format == nil {
+		return "", errors.New(errors.ErrCodeValidation, "unsupported file type")
+	}
+	name, err := safeFileName(fileName, format.ext)
+	if err != nil {
+		return "", errors.Wrap(err, errors.ErrCodeInternal, "failed to generate file name")
+	}
+	key := path.Join(folderName, fmt.Sprintf("%d_%s", time.Now().Unix(), name))
+
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+	if err := s.client.PutObject(ctx, key, fileData, format.contentType); err != nil {
+		return "", errors.Wrap(err, errors.ErrCodeInternal, "failed to save image file")
+	}
+	return key, nil
+}
+
+func (s *S3ImageService) SaveImageVariants(fileData []byte, folderName, fileName string) (string, []entities.ImageVariant, error) {
+	return s.processor.storeVariants(fileData, folderName, fileName,
+		func(relPath string, data []byte) error {
+			ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+			defer cancel()
+			return s.client.PutObject(ctx, relPath, data, ContentTypeByPath(relPath))
+		},
+		func(relPath string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+			defer cancel()
+			return s.client.DeleteObject(ctx, relPath)
+		},
+	)
+}
+
+func (s *S3ImageService) DeleteImageWithVariants(imagePath string, variants []entities.ImageVariant) error {
+	paths := make([]string, 0, len(variants)+1)
+	if imagePath != "" {
+		paths = append(paths, imagePath)
+	}
+	for _, v := range variants {
+		if v.Path != imagePath {
+			paths = append(paths, v.Path)
+		}
+	}
+
+	for _, p := range paths {
+		// S3 answers 204 for missing keys, so files that are already gone
+		// need no special handling
+		if err := s.deleteObject(p); err != nil {
+			return errors.Wrap(err, errors.ErrCodeInternal, "failed to delete image")
+		}
+	}
+	return nil
+}
+
+func (s *S3ImageService) DeleteImage(imagePath string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	exists, err := s.client.ObjectExists(ctx, imagePath)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeInternal, "failed to delete image")
+	}
+	if !exists {
+		return errors.New(errors.ErrCodeNotFound, "image not found")
+	}
+	if err := s.client.DeleteObject(ctx, imagePath); err != nil {
+		return errors.Wrap(err, errors.ErrCodeInternal, "failed to delete image")
+	}
+	return nil
+}
+
+func (s *S3ImageService) GetFullImagePath(imagePath string) string {
+	if imagePath == "" {
+		return ""
+	}
+	if s.publicURL != "" {
+		return s.publicURL + "/" + strings.TrimPrefix(imagePath, "/")
+	}
+	return s.client.PresignGetObject(imagePath, s.presignExpiry)
+}
+
+func (s *S3ImageService) deleteObject(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	err := s.client.DeleteObject(ctx, key)
+	if s3Err, ok := err.(*S3Error); ok && s3Err.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// ContentTypeByPath picks the Content-Type objects are stored with, so the
+// bucket serves images inline instead of as downloads.
+func ContentTypeByPath(p string) string {
+	ext := strings.ToLower(path.Ext(p))
+	for _, f := range uploadFormats {
+		if f.ext == ext {
+			return f.contentType
+		}
+	}
+	if ext == ".webp" {
+		return "image/webp"
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,183 @@
+package image
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type uploadFormat struct {
+	name        string
+	contentType string
+	ext         string
+	magic       [][]byte
+}
+
+// uploadFormats are the formats the processor can decode. Anything else,
+// including HTML, SVG and executables, is rejected by its magic bytes.
+var uploadFormats = []uploadFormat{
+	{name: "jpeg", contentType: "image/jpeg", ext: ".jpg", magic: [][]byte{{0xFF, 0xD8, 0xFF}}},
+	{name: "png", contentType: "image/png", ext: ".png", magic: [][]byte{{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}}},
+	{name: "gif", contentType: "image/gif", ext: ".gif", magic: [][]byte{[]byte("GIF87a"), []byte("GIF89a")}},
+}
+
+// SupportedUploadFormats lists the format names accepted in configuration.
+func SupportedUploadFormats() []string {
+	names := make([]string, 0, len(uploadFormats))
+	for _, f := range uploadFormats {
+		names = append(names, f.name)
+	}
+	return names
+}
+
+type UploadValidator struct {
+	maxBytes     int64
+	maxPixels    int64
+	maxDimension int
+	allowed      map[string]bool
+}
+
+func NewUploadValidator(maxBytes, maxPixels int64, maxDimension int, allowedFormats []string) (ports.ImageUploadValidator, error) {
+	allowed := make(map[string]bool, len(allowedFormats))
+	for _, name := range allowedFormats {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if detectFormatByName(name) == nil {
+			return nil, fmt.Errorf("unsupported upload format %q (supported: %s)", name, strings.Join(SupportedUploadFormats(), ", "))
+		}
+		allowed[name] = true
+	}
+	if len(allowed) == 0 {
+		return nil, fmt.Errorf("at least one upload format must be allowed")
+	}
+
+	return &UploadValidator{
+		maxBytes:     maxBytes,
+		maxPixels:    maxPixels,
+		maxDimension: maxDimension,
+		allowed:      allowed,
+	}, nil
+}
+
+func (v *UploadValidator) ReadImage(r io.Reader, originalName string) (*ports.UploadedImage, error) {
+	// Read one byte past the limit so oversized files are detected without
+	// buffering them completely
+	data, err := io.ReadAll(io.LimitReader(r, v.maxBytes+1))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeBadRequest, "failed to read uploaded file")
+	}
+	if len(data) == 0 {
+		return nil, errors.New(errors.ErrCodeValidation, "uploaded file is empty")
+	}
+	if int64(len(data)) > v.maxBytes {
+		return nil, errors.New(errors.ErrCodeValidation, fmt.Sprintf("file is too large, maximum size is %d MB", v.maxBytes>>20))
+	}
+
+	format := detectFormat(data)
+	if format == nil || !v.allowed[format.name] {
+		return nil, errors.New(errors.ErrCodeValidation, "unsupported file type, allowed formats: "+v.allowedList())
+	}
+
+	// DecodeConfig only parses the header, so dimension limits are enforced
+	// before a decompression bomb is ever expanded in memory
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeValidation, "unsupported or corrupted image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New(errors.ErrCodeValidation, "unsupported or corrupted image")
+	}
+	if cfg.Width > v.maxDimension || cfg.Height > v.maxDimension {
+		return nil, errors.New(errors.ErrCodeValidation, fmt.Sprintf("image is too large, maximum side is %d px", v.maxDimension))
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > v.maxPixels {
+		return nil, errors.New(errors.ErrCodeValidation, fmt.Sprintf("image is too large, maximum is %d megapixels", v.maxPixels/1_000_000))
+	}
+
+	name, err := safeFileName(originalName, format.ext)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeInternal, "failed to generate file name")
+	}
+
+	return &ports.UploadedImage{
+		Data:        data,
+		FileName:    name,
+		Format:      format.name,
+		ContentType: format.contentType,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+	}, nil
+}
+
+func (v *UploadValidator) allowedList() string {
+	names := make([]string, 0, len(v.allowed))
+	for _, f := range uploadFormats {
+		if v.allowed[f.name] {
+			names = append(names, f.name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func detectFormat(data []byte) *uploadFormat {
+	for i := range uploadFormats {
+		for _, magic := range uploadFormats[i].magic {
+			if bytes.HasPrefix(data, magic) {
+				return &uploadFormats[i]
+			}
+		}
+	}
+	return nil
+}
+
+func detectFormatByName(name string) *uploadFormat {
+	for i := range uploadFormats {
+		if uploadFormats[i].name == name {
+			return &uploadFormats[i]
+		}
+	}
+	return nil
+}
+
+const maxSlugLength = 40
+
+// safeFileName builds "<slug>-<random><ext>" where slug keeps only ASCII
+// letters, digits and dashes of the original base name. The extension always
+// comes from the detected format, never from the client.
+func safeFileName(originalName, ext string) (string, error) {
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	suffix := hex.EncodeToString(random)
+
+	base := strings.TrimSuffix(filepath.Base(filepath.ToSlash(originalName)), filepath.Ext(originalName))
+	var slug strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(base) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			slug.WriteRune(r)
+			lastDash = false
+		case !lastDash:
+			slug.WriteByte('-')
+			lastDash = true
+		}
+		if slug.Len() >= maxSlugLength {
+			break
+		}
+	}
+
+	name := strings.Trim(slug.String(), "-")
+	if name == "" {
+		return suffix + ext, nil
+	}
+	return name + "-" + suffix + ext, nil
+}
@@ -0,0 +1,68 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const webpEncodeTimeout = 30 * time.Second
+
+// WebPEncoder shells out to the cwebp tool from libwebp. The standard library
+// has no WebP encoder, and cwebp is available as a package on every platform
+// we deploy to.
+type WebPEncoder struct {
+	binPath string
+	quality int
+}
+
+// NewWebPEncoder resolves binPath (a path or a name looked up in PATH).
+func NewWebPEncoder(binPath string, quality int) (*WebPEncoder, error) {
+	resolved, err := exec.LookPath(binPath)
+	if err != nil {
+		return nil, fmt.Errorf("webp encoder %q not found: %w", binPath, err)
+	}
+	if quality <= 0 || quality > 100 {
+		quality = 80
+	}
+	return &WebPEncoder{binPath: resolved, quality: quality}, nil
+}
+
+func (e *WebPEncoder) Encode(img image.Image) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "imperial-webp-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "in.png")
+	output := filepath.Join(dir, "out.webp")
+
+	f, err := os.Create(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webpEncodeTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, e.binPath, "-quiet", "-metadata", "none", "-q", strconv.Itoa(e.quality), input, "-o", output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("cwebp failed: %w: %s", err, out)
+	}
+
+	return os.ReadFile(output)
+}
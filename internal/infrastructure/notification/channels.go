@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// Channel is one named delivery route of the Router
+type Channel struct {
+	Name     string
+	Notifier ports.StaffNotifier
+}
+
+// Router sends a notification to one channel by name. The outbox keeps a
+// message per channel, so a failing channel is retried on its own.
+type Router struct {
+	channels []Channel
+}
+
+func NewRouter(channels []Channel) ports.StaffNotificationRouter {
+	return &Router{channels: channels}
+}
+
+func (r *Router) Channels() []string {
+	names := make([]string, 0, len(r.channels))
+	for _, ch := range r.channels {
+		names = append(names, ch.Name)
+	}
+	return names
+}
+
+func (r *Router) NotifyNewLead(ctx context.Context, channel string, lead *entities.Lead) error {
+	for _, ch := range r.channels {
+		if ch.Name == channel {
+			return ch.Notifier.NotifyNewLead(ctx, lead)
+		}
+	}
+	return fmt.Errorf("%w: %s", ports.ErrUnknownStaffChannel, channel)
+}
@@ -0,0 +1,23 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// ConsoleNotifier prints notifications to stdout for local development
+type ConsoleNotifier struct{}
+
+func NewConsoleNotifier() ports.StaffNotifier {
+	return &ConsoleNotifier{}
+}
+
+func (n *ConsoleNotifier) NotifyNewLead(ctx context.Context, lead *entities.Lead) error {
+	fmt.Println("=====================================")
+	fmt.Print(leadMessage(lead))
+	fmt.Println("=====================================")
+	return nil
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// EmailNotifier mails notifications to a fixed list of staff addresses
+// through the configured EmailService
+type EmailNotifier struct {
+	emailService ports.EmailService
+	recipients   []string
+}
+
+func NewEmailNotifier(emailService ports.EmailService, recipients []string) ports.StaffNotifier {
+	return &EmailNotifier{
+		emailService: emailService,
+		recipients:   recipients,
+	}
+}
+
+func (n *EmailNotifier) NotifyNewLead(ctx context.Context, lead *entities.Lead) error {
+	var errs []error
+	for _, recipient := range n.recipients {
+		if err := n.emailService.SendNewLeadNotification(ctx, recipient, lead); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+const EventLeadCreated = "lead.created"
+
+// leadMessage renders the short plain-text summary used by chat-like channels
+func leadMessage(lead *entities.Lead) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "📥 Новая заявка #%d\n", lead.ID)
+	fmt.Fprintf(&b, "Имя: %s\n", lead.FullName)
+	fmt.Fprintf(&b, "Телефон: %s\n", lead.Phone)
+	fmt.Fprintf(&b, "Период: %s — %s\n", lead.StartDate.Format("02.01.2006 15:04"), lead.EndDate.Format("02.01.2006 15:04"))
+	if lead.CarID != nil {
+		fmt.Fprintf(&b, "Автомобиль: #%d", *lead.CarID)
+		if lead.WithDriver {
+			b.WriteString(", с водителем")
+		}
+		b.WriteString("\n")
+	}
+	if lead.PriceQuote != nil {
+		fmt.Fprintf(&b, "Расчетная стоимость: %d\n", lead.PriceQuote.Total)
+	}
+	return b.String()
+}
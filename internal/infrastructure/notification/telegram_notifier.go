@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// TelegramNotifier sends messages to chats through the Telegram Bot API.
+// The bot has to be added to each chat (or started by each user) first.
+type TelegramNotifier struct {
+	apiURL  string
+	token   string
+	chatIDs []string
+	client  *http.Client
+}
+
+type telegramMessage struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+func NewTelegramNotifier(apiURL, token string, chatIDs []string) ports.StaffNotifier {
+	return &TelegramNotifier{
+		apiURL:  strings.TrimSuffix(apiURL, "/"),
+		token:   token,
+		chatIDs: chatIDs,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *TelegramNotifier) NotifyNewLead(ctx context.Context, lead *entities.Lead) error {
+	text := leadMessage(lead)
+
+	var errs []error
+	for _, chatID := range n.chatIDs {
+		if err := n.sendMessage(ctx, chatID, text); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *TelegramNotifier) sendMessage(ctx context.Context, chatID, text string) error {
+	body, err := json.Marshal(telegramMessage{ChatID: chatID, Text: text})
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования сообщения Telegram")
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", n.apiURL, n.token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования сообщения Telegram")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// The error text contains the URL and with it the bot token
+		return apperrors.New(apperrors.ErrCodeExternal, "Telegram Bot API недоступен")
+	}
+	defer resp.Body.Close()
+
+	var result telegramResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeExternal, "Неверный ответ Telegram Bot API")
+	}
+	if !result.OK {
+		return apperrors.New(apperrors.ErrCodeExternal, fmt.Sprintf("Telegram Bot API отклонил сообщение для чата %s: %s", chatID, result.Description))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body when a
+// webhook secret is configured, so receivers can verify the sender
+const SignatureHeader = "X-Imperial-Signature"
+
+// WebhookNotifier POSTs a JSON event to every configured URL
+type WebhookNotifier struct {
+	urls   []string
+	secret string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	Event     string         `json:"event"`
+	Text      string         `json:"text"`
+	Lead      *entities.Lead `json:"lead"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func NewWebhookNotifier(urls []string, secret string) ports.StaffNotifier {
+	return &WebhookNotifier{
+		urls:   urls,
+		secret: secret,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *WebhookNotifier) NotifyNewLead(ctx context.Context, lead *entities.Lead) error {
+	body, err := json.Marshal(webhookPayload{
+		Event:     EventLeadCreated,
+		Text:      leadMessage(lead),
+		Lead:      lead,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования webhook")
+	}
+
+	var errs []error
+	for _, url := range n.urls {
+		if err := n.post(ctx, url, body); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "Ошибка формирования webhook")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeExternal, fmt.Sprintf("Webhook %s недоступен", url))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return apperrors.New(apperrors.ErrCodeExternal, fmt.Sprintf("Webhook %s вернул статус %d", url, resp.StatusCode))
+	}
+	return nil
+}
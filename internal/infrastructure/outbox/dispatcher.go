@@ -0,0 +1,197 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// leaseMargin is added to the handler timeout so a claimed message is not
+// handed to another worker while its handler can still be running
+const leaseMargin = time.Minute
+
+// purgeInterval is how often delivered messages past retention are deleted
+const purgeInterval = time.Hour
+
+// Handler delivers one message. Returning an error schedules a retry unless
+// the error is wrapped with Permanent.
+type Handler func(ctx context.Context, msg *entities.OutboxMessage) error
+
+type Config struct {
+	PollInterval   time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	BackoffBase    time.Duration
+	BackoffMax     time.Duration
+	HandlerTimeout time.Duration
+	Retention      time.Duration
+}
+
+// Dispatcher polls the outbox and delivers due messages through the handler
+// registered for their topic. Failed deliveries are retried with exponential
+// backoff; after MaxAttempts the message is moved to the dead state and kept
+// for inspection.
+type Dispatcher struct {
+	repo     ports.OutboxRepository
+	cfg      Config
+	handlers map[string]Handler
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewDispatcher(repo ports.OutboxRepository, cfg Config) *Dispatcher {
+	return &Dispatcher{
+		repo:     repo,
+		cfg:      cfg,
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Handle registers the handler of a topic. It must be called before Start.
+func (d *Dispatcher) Handle(topic string, handler Handler) {
+	d.handlers[topic] = handler
+}
+
+// Start runs the polling loop in the background until Stop is called or ctx
+// is cancelled
+func (d *Dispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+
+	go d.run(ctx)
+	log.Printf("📤 Outbox dispatcher started (poll every %s)", d.cfg.PollInterval)
+}
+
+// Stop stops polling and waits for the messages in flight to finish
+func (d *Dispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	<-d.done
+	log.Println("📤 Outbox dispatcher stopped")
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Keep draining while batches come back full
+		for ctx.Err() == nil {
+			if d.dispatchBatch(ctx) < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastPurge) >= purgeInterval {
+			lastPurge = time.Now()
+			d.purge(ctx)
+		}
+	}
+}
+
+// dispatchBatch delivers one batch concurrently and returns its size
+func (d *Dispatcher) dispatchBatch(ctx context.Context) int {
+	messages, err := d.repo.ClaimDue(ctx, d.cfg.BatchSize, d.cfg.HandlerTimeout+leaseMargin)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("❌ Outbox: failed to claim messages: %v", err)
+		}
+		return 0
+	}
+
+	// Messages already claimed are finished even when Stop is called meanwhile
+	deliverCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for _, msg := range messages {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(deliverCtx, msg)
+		}()
+	}
+	wg.Wait()
+
+	return len(messages)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, msg *entities.OutboxMessage) {
+	handler, ok := d.handlers[msg.Topic]
+	if !ok {
+		d.markDead(ctx, msg, "no handler registered for topic "+msg.Topic)
+		return
+	}
+
+	handlerCtx, cancel := context.WithTimeout(ctx, d.cfg.HandlerTimeout)
+	err := handler(handlerCtx, msg)
+	cancel()
+
+	if err == nil {
+		if err := d.repo.MarkSent(ctx, msg.ID); err != nil {
+			log.Printf("❌ Outbox: message #%d delivered but not marked as sent: %v", msg.ID, err)
+		}
+		return
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) || msg.Attempts >= d.cfg.MaxAttempts {
+		d.markDead(ctx, msg, err.Error())
+		return
+	}
+
+	next := time.Now().Add(d.backoff(msg.Attempts))
+	log.Printf("⚠️  Outbox: message #%d (%s) attempt %d failed, retry at %s: %v",
+		msg.ID, msg.Topic, msg.Attempts, next.Format(time.RFC3339), err)
+	if err := d.repo.MarkRetry(ctx, msg.ID, next, err.Error()); err != nil {
+		log.Printf("❌ Outbox: failed to reschedule message #%d: %v", msg.ID, err)
+	}
+}
+
+func (d *Dispatcher) markDead(ctx context.Context, msg *entities.OutboxMessage, reason string) {
+	log.Printf("❌ Outbox: message #%d (%s) moved to dead letters after %d attempts: %s",
+		msg.ID, msg.Topic, msg.Attempts, reason)
+	if err := d.repo.MarkDead(ctx, msg.ID, reason); err != nil {
+		log.Printf("❌ Outbox: failed to mark message #%d as dead: %v", msg.ID, err)
+	}
+}
+
+// backoff returns BackoffBase doubled for every attempt after the first,
+// capped at BackoffMax, plus up to 20% jitter so failed messages spread out
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BackoffBase
+	for i := 1; i < attempt && delay < d.cfg.BackoffMax; i++ {
+		delay *= 2
+	}
+	delay = min(delay, d.cfg.BackoffMax)
+
+	return delay + rand.N(delay/5+1)
+}
+
+func (d *Dispatcher) purge(ctx context.Context) {
+	deleted, err := d.repo.DeleteSentBefore(ctx, time.Now().Add(-d.cfg.Retention))
+	if err != nil {
+		log.Printf("❌ Outbox: failed to delete delivered messages: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("🧹 Outbox: deleted %d delivered messages", deleted)
+	}
+}
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a delivery error that a retry cannot fix, such as a
+// malformed payload; the message goes straight to the dead state
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// EmailCodeHandler delivers an EmailCodePayload with send
+func EmailCodeHandler(send func(ctx context.Context, email, code string) error) Handler {
+	return func(ctx context.Context, msg *entities.OutboxMessage) error {
+		var payload entities.EmailCodePayload
+		if err := msg.DecodePayload(&payload); err != nil {
+			return Permanent(err)
+		}
+		return send(ctx, payload.Email, payload.Code)
+	}
+}
+
+// LeadCreatedHandler tells the staff about a new lead over the channel of the
+// message
+func LeadCreatedHandler(router ports.StaffNotificationRouter) Handler {
+	return func(ctx context.Context, msg *entities.OutboxMessage) error {
+		var payload entities.LeadNotificationPayload
+		if err := msg.DecodePayload(&payload); err != nil {
+			return Permanent(err)
+		}
+		if payload.Lead == nil {
+			return Permanent(errors.New("lead notification has no lead"))
+		}
+
+		err := router.NotifyNewLead(ctx, payload.Channel, payload.Lead)
+		if errors.Is(err, ports.ErrUnknownStaffChannel) {
+			return Permanent(err)
+		}
+		return err
+	}
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type bookingRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBookingRepository(db *pgxpool.Pool) ports.BookingRepository {
+	return &bookingRepository{db: db}
+}
+
+const bookingColumns = `
+	id, car_id, driver_id, lead_id, customer_name, customer_phone,
+	lower(period), upper(period), status, comment, total_price, price_quote, created_at, updated_at
+`
+
+func scanBooking(row pgx.Row, booking *entities.Booking) error {
+	return row.Scan(
+		&booking.ID,
+		&booking.CarID,
+		&booking.DriverID,
+		&booking.LeadID,
+		&booking.CustomerName,
+		&booking.CustomerPhone,
+		&booking.StartDate,
+		&booking.EndDate,
+		&booking.Status,
+		&booking.Comment,
+		&booking.TotalPrice,
+		&booking.PriceQuote,
+		&booking.CreatedAt,
+		&booking.UpdatedAt,
+	)
+}
+
+func (r *bookingRepository) CreateBooking(ctx context.Context, booking *entities.Booking) error {
+	query := `
+		INSERT INTO bookings (car_id, driver_id, lead_id, customer_name, customer_phone, period, status, comment, total_price, price_quote, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, tstzrange($6, $7, '[)'), $8, $9, $10, $11, $12, $13)
+		RETURNING id
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		booking.CarID,
+		booking.DriverID,
+		booking.LeadID,
+		booking.CustomerName,
+		booking.CustomerPhone,
+		booking.StartDate,
+		booking.EndDate,
+		booking.Status,
+		booking.Comment,
+		booking.TotalPrice,
+		booking.PriceQuote,
+		booking.CreatedAt,
+		booking.UpdatedAt,
+	).Scan(&booking.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *bookingRepository) GetBookingByID(ctx context.Context, id int64) (*entities.Booking, error) {
+	query := `SELECT ` + bookingColumns + ` FROM bookings WHERE id = $1`
+
+	booking := &entities.Booking{}
+	if err := scanBooking(r.db.QueryRow(ctx, query, id), booking); err != nil {
+		return nil, r.handleError(err)
+	}
+	return booking, nil
+}
+
+func (r *bookingRepository) ListBookings(ctx context.Context, offset, limit int64, filter ports.BookingFilter) (int64, []*entities.Booking, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	conditions := []string{"1=1"}
+	args := []any{}
+	argPos := 1
+
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argPos))
+		args = append(args, filter.Status)
+		argPos++
+	}
+	if filter.CarID != 0 {
+		conditions = append(conditions, fmt.Sprintf("car_id = $%d", argPos))
+		args = append(args, filter.CarID)
+		argPos++
+	}
+	if filter.DriverID != 0 {
+		conditions = append(conditions, fmt.Sprintf("driver_id = $%d", argPos))
+		args = append(args, filter.DriverID)
+		argPos++
+	}
+	if filter.LeadID != 0 {
+		conditions = append(conditions, fmt.Sprintf("lead_id = $%d", argPos))
+		args = append(args, filter.LeadID)
+		argPos++
+	}
+
+	whereSQL := strings.Join(conditions, " AND ")
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM bookings WHERE %s`, whereSQL)
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	if total == 0 {
+		return 0, []*entities.Booking{}, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM bookings
+		WHERE %s
+		ORDER BY lower(period) DESC
+		LIMIT $%d OFFSET $%d
+	`, bookingColumns, whereSQL, argPos, argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	bookings := make([]*entities.Booking, 0)
+	for rows.Next() {
+		booking := &entities.Booking{}
+		if err := scanBooking(rows, booking); err != nil {
+			return 0, nil, r.handleError(err)
+		}
+		bookings = append(bookings, booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	return total, bookings, nil
+}
+
+func (r *bookingRepository) UpdateBooking(ctx context.Context, booking *entities.Booking) error {
+	query := `
+		UPDATE bookings
+		SET driver_id = $1,
+			customer_name = $2,
+			customer_phone = $3,
+			period = tstzrange($4, $5, '[)'),
+			status = $6,
+			comment = $7,
+			total_price = $8,
+			price_quote = $9,
+			updated_at = $10
+		WHERE id = $11
+	`
+	result, err := r.db.Exec(ctx, query,
+		booking.DriverID,
+		booking.CustomerName,
+		booking.CustomerPhone,
+		booking.StartDate,
+		booking.EndDate,
+		booking.Status,
+		booking.Comment,
+		booking.TotalPrice,
+		booking.PriceQuote,
+		booking.UpdatedAt,
+		booking.ID,
+	)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrBookingNotFound
+	}
+	return nil
+}
+
+func (r *bookingRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrBookingNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23P01": // exclusion_violation
+			if pgErr.ConstraintName == "bookings_driver_period_excl" {
+				return apperrors.ErrDriverAlreadyBooked
+			}
+			return apperrors.ErrCarAlreadyBooked
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "Связанная запись не найдена")
+		case "23514": // check_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeValidation, "Неверный период бронирования")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
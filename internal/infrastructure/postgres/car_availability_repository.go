@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type carAvailabilityRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCarAvailabilityRepository(db *pgxpool.Pool) ports.CarAvailabilityRepository {
+	return &carAvailabilityRepository{db: db}
+}
+
+func (r *carAvailabilityRepository) CreateMaintenanceBlock(ctx context.Context, block *entities.CarMaintenanceBlock) error {
+	query := `
+		INSERT INTO car_maintenance_blocks (car_id, period, reason, created_at)
+		VALUES ($1, tstzrange($2, $3, '[)'), $4, $5)
+		RETURNING id
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		block.CarID,
+		block.StartDate,
+		block.EndDate,
+		block.Reason,
+		block.CreatedAt,
+	).Scan(&block.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *carAvailabilityRepository) LockCar(ctx context.Context, carID int64) error {
+	var id int64
+	err := conn(ctx, r.db).QueryRow(ctx, `SELECT id FROM cars WHERE id = $1 FOR UPDATE`, carID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrCarNotFound
+	}
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *carAvailabilityRepository) DeleteMaintenanceBlock(ctx context.Context, carID, blockID int64) error {
+	query := `DELETE FROM car_maintenance_blocks WHERE id = $1 AND car_id = $2`
+	result, err := r.db.Exec(ctx, query, blockID, carID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrMaintenanceNotFound
+	}
+	return nil
+}
+
+func (r *carAvailabilityRepository) ListBusyIntervals(ctx context.Context, carID int64, from, to time.Time) ([]*entities.BusyInterval, error) {
+	query := `
+		SELECT lower(period), upper(period), 'booking' AS kind
+		FROM bookings
+		WHERE car_id = $1
+			AND status <> 'cancelled'
+			AND period && tstzrange($2, $3, '[)')
+		UNION ALL
+		SELECT lower(period), upper(period), 'maintenance' AS kind
+		FROM car_maintenance_blocks
+		WHERE car_id = $1
+			AND period && tstzrange($2, $3, '[)')
+		ORDER BY 1
+	`
+	rows, err := conn(ctx, r.db).Query(ctx, query, carID, from, to)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	intervals := make([]*entities.BusyInterval, 0)
+	for rows.Next() {
+		interval := &entities.BusyInterval{}
+		if err := rows.Scan(&interval.Start, &interval.End, &interval.Kind); err != nil {
+			return nil, r.handleError(err)
+		}
+		intervals = append(intervals, interval)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return intervals, nil
+}
+
+func (r *carAvailabilityRepository) handleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23P01": // exclusion_violation
+			return apperrors.New(apperrors.ErrCodeConflict, "Период обслуживания пересекается с существующим")
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeNotFound, "Автомобиль не найден")
+		case "23514": // check_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeValidation, "Неверный период обслуживания")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+func (r CarRepositoryImpl) GetCarFacets(ctx context.Context, filter ports.CarListFilter) (*entities.CarFacets, error) {
+	facets := &entities.CarFacets{}
+
+	priceFilter := filter
+	priceFilter.PriceFrom, priceFilter.PriceTo = 0, 0
+	query, args := carFacetsFiltered(priceFilter)
+	if err := r.db.QueryRow(ctx, query+`
+		SELECT COALESCE(MIN(price), 0), COALESCE(MAX(price), 0)
+		FROM filtered
+	`, args...).Scan(&facets.Price.Min, &facets.Price.Max); err != nil {
+		return nil, err
+	}
+
+	driverFilter := filter
+	driverFilter.OnlyWithDriver = nil
+	query, args = carFacetsFiltered(driverFilter)
+	if err := r.db.QueryRow(ctx, query+`
+		SELECT
+			COUNT(*) FILTER (WHERE only_with_driver),
+			COUNT(*) FILTER (WHERE NOT only_with_driver)
+		FROM filtered
+	`, args...).Scan(&facets.Driver.WithDriver, &facets.Driver.SelfDrive); err != nil {
+		return nil, err
+	}
+
+	var err error
+	markFilter := filter
+	markFilter.MarkID = 0
+	query, args = carFacetsFiltered(markFilter)
+	facets.Marks, err = r.queryFacetCounts(ctx, query+`
+		SELECT cm.id, cm.name, COUNT(*)
+		FROM filtered f
+		JOIN car_marks cm ON cm.id = f.car_mark_id
+		GROUP BY cm.id, cm.name
+		ORDER BY COUNT(*) DESC, cm.name
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryFilter := filter
+	categoryFilter.CategoryID = 0
+	query, args = carFacetsFiltered(categoryFilter)
+	facets.Categories, err = r.queryFacetCounts(ctx, query+`
+		SELECT cc.id, cc.name, COUNT(*)
+		FROM filtered f
+		JOIN car_categories cc ON cc.id = f.car_category_id
+		GROUP BY cc.id, cc.name
+		ORDER BY COUNT(*) DESC, cc.name
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+
+	tagFilter := filter
+	tagFilter.TagIDs = nil
+	query, args = carFacetsFiltered(tagFilter)
+	facets.Tags, err = r.queryFacetCounts(ctx, query+`
+		SELECT ct.id, ct.name, COUNT(*)
+		FROM filtered f
+		JOIN car_car_tags cct ON cct.car_id = f.id
+		JOIN car_tags ct ON ct.id = cct.car_tag_id
+		GROUP BY ct.id, ct.name
+		ORDER BY COUNT(*) DESC, ct.name
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// carFacetsFiltered renders the "filtered" CTE of the cars matching filter
+func carFacetsFiltered(filter ports.CarListFilter) (string, []any) {
+	whereSQL, args := buildCarListConditions(filter)
+	return fmt.Sprintf(`
+		WITH filtered AS (
+			SELECT c.id, c.car_mark_id, c.car_category_id, %s AS price, c.only_with_driver
+			FROM cars c
+			LEFT JOIN car_marks cm ON c.car_mark_id = cm.id
+			LEFT JOIN car_categories cc ON c.car_category_id = cc.id
+			WHERE %s
+		)
+	`, carEffectivePriceSQL, whereSQL), args
+}
+
+func (r CarRepositoryImpl) queryFacetCounts(ctx context.Context, query string, args []any) ([]entities.FacetCount, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]entities.FacetCount, 0)
+	for rows.Next() {
+		var fc entities.FacetCount
+		if err := rows.Scan(&fc.ID, &fc.Name, &fc.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, fc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
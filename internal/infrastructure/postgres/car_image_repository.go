@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
+const carImageColumns = `id, car_id, image_path, variants, position, is_cover, created_at`
+
 type carImageRepositoryImpl struct {
 	db *pgxpool.Pool
 }
@@ -17,54 +20,95 @@ func NewCarImageRepositoryImpl(db *pgxpool.Pool) ports.CarImageRepository {
 	return &carImageRepositoryImpl{db: db}
 }
 
-func (r *carImageRepositoryImpl) Save(ctx context.Context, carID int64, imageUrl string) (*entities.CarImage, error) {
+// Save appends the image to the end of the gallery. The first image of a car
+// becomes its cover.
+func (r *carImageRepositoryImpl) Save(ctx context.Context, carID int64, imageUrl string, variants []entities.ImageVariant) (*entities.CarImage, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to save car image")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCarGallery(ctx, tx, carID); err != nil {
+		return nil, err
+	}
+
 	query := `
-		INSERT INTO car_images (car_id, image_path)
-		VALUES ($1, $2)
-		RETURNING id, car_id, image_path, created_at
-	`
-	var image entities.CarImage
-	err := r.db.QueryRow(ctx, query, carID, imageUrl).
-		Scan(&image.ID, &image.CarID, &image.ImagePath, &image.CreatedAt)
+		INSERT INTO car_images (car_id, image_path, variants, position, is_cover)
+		SELECT $1, $2, $3,
+			COALESCE((SELECT MAX(position) + 1 FROM car_images WHERE car_id = $1), 0),
+			NOT EXISTS (SELECT 1 FROM car_images WHERE car_id = $1 AND is_cover)
+		RETURNING ` + carImageColumns
 
+	image, err := scanCarImage(tx.QueryRow(ctx, query, carID, imageUrl, nonNilVariants(variants)))
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to save car image")
 	}
 
-	return &image, nil
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to save car image")
+	}
+
+	return image, nil
 }
 
+// Delete removes the image. When it was the cover, the next image in the
+// gallery takes its place.
 func (r *carImageRepositoryImpl) Delete(ctx context.Context, imageID int64) error {
-	query := `DELETE FROM car_images WHERE id = $1`
-
-	result, err := r.db.Exec(ctx, query, imageID)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image")
 	}
+	defer tx.Rollback(ctx)
 
-	if result.RowsAffected() == 0 {
+	query := `DELETE FROM car_images WHERE id = $1 RETURNING car_id, is_cover`
+
+	var carID int64
+	var wasCover bool
+	err = tx.QueryRow(ctx, query, imageID).Scan(&carID, &wasCover)
+	if err == pgx.ErrNoRows {
 		return apperrors.New(apperrors.ErrCodeNotFound, "car image not found")
 	}
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image")
+	}
+
+	if wasCover {
+		promoteQuery := `
+			UPDATE car_images
+			SET is_cover = TRUE
+			WHERE id = (
+				SELECT id FROM car_images
+				WHERE car_id = $1
+				ORDER BY position ASC, id ASC
+				LIMIT 1
+			)
+		`
+		if _, err := tx.Exec(ctx, promoteQuery, carID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to delete car image")
+	}
 
 	return nil
 }
 
 func (r *carImageRepositoryImpl) GetByID(ctx context.Context, imageID int64) (*entities.CarImage, error) {
 	query := `
-		SELECT id, car_id, image_path, created_at
+		SELECT ` + carImageColumns + `
 		FROM car_images
 		WHERE id = $1
 	`
 
-	var image entities.CarImage
-	err := r.db.QueryRow(ctx, query, imageID).
-		Scan(&image.ID, &image.CarID, &image.ImagePath, &image.CreatedAt)
-
+	image, err := scanCarImage(r.db.QueryRow(ctx, query, imageID))
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeNotFound, "car image not found")
 	}
 
-	return &image, nil
+	return image, nil
 }
 
 func (r *carImageRepositoryImpl) GetList(ctx context.Context, carID int64, offset, limit int64) (int64, []*entities.CarImage, error) {
@@ -76,10 +120,10 @@ func (r *carImageRepositoryImpl) GetList(ctx context.Context, carID int64, offse
 		return 0, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to count car images")
 	}
 	query := `
-		SELECT id, car_id, image_path, created_at
+		SELECT ` + carImageColumns + `
 		FROM car_images
 		WHERE car_id = $1
-		ORDER BY created_at ASC
+		ORDER BY position ASC, id ASC
 		OFFSET $2
 		LIMIT $3
 	`
@@ -88,19 +132,10 @@ func (r *carImageRepositoryImpl) GetList(ctx context.Context, carID int64, offse
 	if err != nil {
 		return 0, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to list car images")
 	}
-	defer rows.Close()
 
-	var images []*entities.CarImage
-	for rows.Next() {
-		var image entities.CarImage
-		if err := rows.Scan(&image.ID, &image.CarID, &image.ImagePath, &image.CreatedAt); err != nil {
-			return 0, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to scan car image")
-		}
-		images = append(images, &image)
-	}
-
-	if err := rows.Err(); err != nil {
-		return 0, nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "error occurred during rows iteration")
+	images, err := collectCarImages(rows)
+	if err != nil {
+		return 0, nil, err
 	}
 
 	return totalCount, images, nil
@@ -108,25 +143,145 @@ func (r *carImageRepositoryImpl) GetList(ctx context.Context, carID int64, offse
 
 func (r *carImageRepositoryImpl) GetListByCar(ctx context.Context, carID int64) ([]*entities.CarImage, error) {
 	query := `
-		SELECT id, car_id, image_path, created_at
+		SELECT ` + carImageColumns + `
 		FROM car_images
 		WHERE car_id = $1
-		ORDER BY created_at ASC
+		ORDER BY position ASC, id ASC
 	`
 
 	rows, err := r.db.Query(ctx, query, carID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to list car images")
 	}
+
+	return collectCarImages(rows)
+}
+
+func (r *carImageRepositoryImpl) Reorder(ctx context.Context, carID int64, imageIDs []int64) ([]*entities.CarImage, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to reorder car images")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCarGallery(ctx, tx, carID); err != nil {
+		return nil, err
+	}
+
+	// The gallery is locked above, so the count cannot change before the
+	// update. Together with the caller rejecting duplicate IDs, matching
+	// both counts means imageIDs is a permutation of the gallery.
+	var total, matched int64
+	countQuery := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE id = ANY($2))
+		FROM car_images
+		WHERE car_id = $1
+	`
+	if err := tx.QueryRow(ctx, countQuery, carID, imageIDs).Scan(&total, &matched); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to reorder car images")
+	}
+	if matched != int64(len(imageIDs)) || total != matched {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "image_ids must list every image of the car exactly once")
+	}
+
+	updateQuery := `
+		UPDATE car_images ci
+		SET position = ordered.ord - 1
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS ordered(id, ord)
+		WHERE ci.id = ordered.id AND ci.car_id = $1
+	`
+	if _, err := tx.Exec(ctx, updateQuery, carID, imageIDs); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to reorder car images")
+	}
+
+	listQuery := `
+		SELECT ` + carImageColumns + `
+		FROM car_images
+		WHERE car_id = $1
+		ORDER BY position ASC, id ASC
+	`
+	rows, err := tx.Query(ctx, listQuery, carID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to list car images")
+	}
+	images, err := collectCarImages(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to reorder car images")
+	}
+
+	return images, nil
+}
+
+func (r *carImageRepositoryImpl) SetCover(ctx context.Context, carID, imageID int64) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to set car cover image")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCarGallery(ctx, tx, carID); err != nil {
+		return err
+	}
+
+	// Clear the old cover first: the partial unique index allows only one
+	// cover per car at any moment
+	unsetQuery := `UPDATE car_images SET is_cover = FALSE WHERE car_id = $1 AND is_cover AND id <> $2`
+	if _, err := tx.Exec(ctx, unsetQuery, carID, imageID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to set car cover image")
+	}
+
+	setQuery := `UPDATE car_images SET is_cover = TRUE WHERE id = $1 AND car_id = $2`
+	result, err := tx.Exec(ctx, setQuery, imageID, carID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to set car cover image")
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.New(apperrors.ErrCodeNotFound, "car image not found")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to set car cover image")
+	}
+
+	return nil
+}
+
+// lockCarGallery serializes gallery changes of one car by locking its row.
+func lockCarGallery(ctx context.Context, tx pgx.Tx, carID int64) error {
+	var id int64
+	err := tx.QueryRow(ctx, `SELECT id FROM cars WHERE id = $1 FOR UPDATE`, carID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return apperrors.New(apperrors.ErrCodeNotFound, "car not found")
+	}
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to lock car")
+	}
+	return nil
+}
+
+func scanCarImage(row pgx.Row) (*entities.CarImage, error) {
+	var image entities.CarImage
+	err := row.Scan(&image.ID, &image.CarID, &image.ImagePath, &image.Variants, &image.Position, &image.IsCover, &image.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func collectCarImages(rows pgx.Rows) ([]*entities.CarImage, error) {
 	defer rows.Close()
 
 	var images []*entities.CarImage
 	for rows.Next() {
-		var image entities.CarImage
-		if err := rows.Scan(&image.ID, &image.CarID, &image.ImagePath, &image.CreatedAt); err != nil {
+		image, err := scanCarImage(rows)
+		if err != nil {
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternal, "failed to scan car image")
 		}
-		images = append(images, &image)
+		images = append(images, image)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -135,3 +290,12 @@ func (r *carImageRepositoryImpl) GetListByCar(ctx context.Context, carID int64)
 
 	return images, nil
 }
+
+// nonNilVariants keeps a missing variant list from being written as SQL NULL
+// into the NOT NULL jsonb columns.
+func nonNilVariants(variants []entities.ImageVariant) []entities.ImageVariant {
+	if variants == nil {
+		return []entities.ImageVariant{}
+	}
+	return variants
+}
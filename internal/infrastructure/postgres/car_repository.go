@@ -2,15 +2,18 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	pgx "github.com/jackc/pgx/v5"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type CarRepositoryImpl struct {
@@ -38,9 +41,18 @@ func (r *CarRepositoryImpl) CreateCar(ctx context.Context, car *entities.Car) er
 			only_with_driver,
 			car_mark_id,
 			car_category_id,
-			price_per_day
+			price_per_day,
+			year,
+			seats,
+			transmission,
+			fuel_type,
+			engine_volume,
+			horsepower,
+			acceleration,
+			color,
+			drive_type
 		)
-		VALUES ($1, $2, $3, $4, $5)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING id, created_at, updated_at
 	`
 
@@ -60,6 +72,15 @@ func (r *CarRepositoryImpl) CreateCar(ctx context.Context, car *entities.Car) er
 		markID,
 		categoryID,
 		car.PricePerDay,
+		car.Specs.Year,
+		car.Specs.Seats,
+		car.Specs.Transmission,
+		car.Specs.FuelType,
+		car.Specs.EngineVolume,
+		car.Specs.Horsepower,
+		car.Specs.Acceleration,
+		car.Specs.Color,
+		car.Specs.DriveType,
 	).Scan(&car.ID, &car.CreatedAt, &car.UpdatedAt)
 	if err != nil {
 		return err
@@ -112,15 +133,40 @@ func (r *CarRepositoryImpl) CreateCar(ctx context.Context, car *entities.Car) er
 	return nil
 }
 
+// GetCarByID returns ErrCarNotFound for a missing car and a database error
+// for anything else, so callers can tell the two apart.
 func (r *CarRepositoryImpl) GetCarByID(ctx context.Context, id int64) (*entities.Car, error) {
+	car, err := r.getCarByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrCarNotFound
+	}
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	return car, nil
+}
+
+func (r *CarRepositoryImpl) getCarByID(ctx context.Context, id int64) (*entities.Car, error) {
 	const querySelect = `
 		SELECT
 			c.id,
 			c.name,
 			c.only_with_driver,
 			c.price_per_day,
+			` + carEffectivePriceSQL + `,
+			c.year,
+			c.seats,
+			c.transmission,
+			c.fuel_type,
+			c.engine_volume,
+			c.horsepower,
+			c.acceleration,
+			c.color,
+			c.drive_type,
 			c.created_at,
 			c.updated_at,
+			c.rating_average::float8,
+			c.review_count,
 			cm.id,
 			cm.name,
 			cm.created_at,
@@ -150,8 +196,20 @@ func (r *CarRepositoryImpl) GetCarByID(ctx context.Context, id int64) (*entities
 		&car.Name,
 		&car.OnlyWithDriver,
 		&car.PricePerDay,
+		&car.EffectivePricePerDay,
+		&car.Specs.Year,
+		&car.Specs.Seats,
+		&car.Specs.Transmission,
+		&car.Specs.FuelType,
+		&car.Specs.EngineVolume,
+		&car.Specs.Horsepower,
+		&car.Specs.Acceleration,
+		&car.Specs.Color,
+		&car.Specs.DriveType,
 		&car.CreatedAt,
 		&car.UpdatedAt,
+		&car.Rating.Average,
+		&car.Rating.Count,
 		&markID,
 		&markName,
 		&markCreatedAt,
@@ -222,6 +280,18 @@ func (r *CarRepositoryImpl) GetCarByID(ctx context.Context, id int64) (*entities
 		return nil, err
 	}
 	car.Images = images
+	for _, img := range images {
+		if img.IsCover {
+			car.Cover = img
+			break
+		}
+	}
+
+	renters, err := listCarRenters(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	car.RentedBy = renters
 
 	return &car, nil
 }
@@ -241,8 +311,17 @@ func (r CarRepositoryImpl) UpdateCar(ctx context.Context, car *entities.Car) err
 			car_mark_id = $3,
 			car_category_id = $4,
 			price_per_day = $5,
+			year = $6,
+			seats = $7,
+			transmission = $8,
+			fuel_type = $9,
+			engine_volume = $10,
+			horsepower = $11,
+			acceleration = $12,
+			color = $13,
+			drive_type = $14,
 			updated_at = NOW()
-		WHERE id = $6
+		WHERE id = $15
 	`
 
 	var markID any
@@ -263,6 +342,15 @@ func (r CarRepositoryImpl) UpdateCar(ctx context.Context, car *entities.Car) err
 		markID,
 		categoryID,
 		price,
+		car.Specs.Year,
+		car.Specs.Seats,
+		car.Specs.Transmission,
+		car.Specs.FuelType,
+		car.Specs.EngineVolume,
+		car.Specs.Horsepower,
+		car.Specs.Acceleration,
+		car.Specs.Color,
+		car.Specs.DriveType,
 		car.ID,
 	)
 	if err != nil {
@@ -328,16 +416,21 @@ func (r CarRepositoryImpl) DeleteCar(ctx context.Context, id int64) error {
 
 	cmdTag, err := r.db.Exec(ctx, deleteCarQuery, id)
 	if err != nil {
-		return err
+		// Bookings keep their car (ON DELETE RESTRICT)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return apperrors.ErrCarHasBookings
+		}
+		return r.handleError(err)
 	}
 	if cmdTag.RowsAffected() == 0 {
-		return pgx.ErrNoRows
+		return apperrors.ErrCarNotFound
 	}
 
 	return nil
 }
 
-func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, name string, markID int64, categoryID int64) (int64, []*entities.Car, error) {
+func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, filter ports.CarListFilter) (int64, []*entities.Car, error) {
 	if limit <= 0 {
 		limit = 20
 	}
@@ -345,34 +438,14 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 		offset = 0
 	}
 
-	conditions := []string{"1=1"}
-	args := []any{}
-	argPos := 1
-
-	if name != "" {
-		conditions = append(conditions, fmt.Sprintf("c.name ILIKE $%d", argPos))
-		args = append(args, "%"+name+"%")
-		argPos++
-	}
-
-	if markID != 0 {
-		conditions = append(conditions, fmt.Sprintf("cm.id = $%d", argPos))
-		args = append(args, markID)
-		argPos++
-	}
-
-	if categoryID != 0 {
-		conditions = append(conditions, fmt.Sprintf("cc.id = $%d", argPos))
-		args = append(args, categoryID)
-		argPos++
-	}
-
-	whereSQL := strings.Join(conditions, " AND ")
+	whereSQL, args := buildCarListConditions(filter)
+	argPos := len(args) + 1
 
 	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM cars c
 		LEFT JOIN car_marks cm ON c.car_mark_id = cm.id
+		LEFT JOIN car_categories cc ON c.car_category_id = cc.id
 		WHERE %s
 	`, whereSQL)
 
@@ -390,8 +463,20 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 			c.name,
 			c.only_with_driver,
 			c.price_per_day,
+			%s,
+			c.year,
+			c.seats,
+			c.transmission,
+			c.fuel_type,
+			c.engine_volume,
+			c.horsepower,
+			c.acceleration,
+			c.color,
+			c.drive_type,
 			c.created_at,
 			c.updated_at,
+			c.rating_average::float8,
+			c.review_count,
 			cm.id,
 			cm.name,
 			cm.created_at,
@@ -399,14 +484,24 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 			cc.id,
 			cc.name,
 			cc.created_at,
-			cc.updated_at
+			cc.updated_at,
+			cover.id,
+			cover.image_path,
+			cover.variants,
+			cover.position,
+			cover.created_at
 		FROM cars c
 		LEFT JOIN car_marks cm ON c.car_mark_id = cm.id
 		LEFT JOIN car_categories cc ON c.car_category_id = cc.id
+		LEFT JOIN LATERAL (
+			SELECT id, image_path, variants, position, created_at
+			FROM car_images
+			WHERE car_id = c.id AND is_cover
+		) cover ON TRUE
 		WHERE %s
-		ORDER BY c.created_at DESC
+		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, whereSQL, argPos, argPos+1)
+	`, carEffectivePriceSQL, whereSQL, carListOrderBy(filter.Sort), argPos, argPos+1)
 
 	args = append(args, limit, offset)
 
@@ -429,14 +524,31 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 		var categoryNamePtr *string
 		var categoryCreatedAtPtr *time.Time
 		var categoryUpdatedAtPtr *time.Time
+		var coverIDPtr *int64
+		var coverPathPtr *string
+		var coverVariants []entities.ImageVariant
+		var coverPositionPtr *int
+		var coverCreatedAtPtr *time.Time
 
 		if err := rows.Scan(
 			&car.ID,
 			&car.Name,
 			&car.OnlyWithDriver,
 			&car.PricePerDay,
+			&car.EffectivePricePerDay,
+			&car.Specs.Year,
+			&car.Specs.Seats,
+			&car.Specs.Transmission,
+			&car.Specs.FuelType,
+			&car.Specs.EngineVolume,
+			&car.Specs.Horsepower,
+			&car.Specs.Acceleration,
+			&car.Specs.Color,
+			&car.Specs.DriveType,
 			&car.CreatedAt,
 			&car.UpdatedAt,
+			&car.Rating.Average,
+			&car.Rating.Count,
 			&markIDPtr,
 			&markNamePtr,
 			&markCreatedAtPtr,
@@ -445,6 +557,11 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 			&categoryNamePtr,
 			&categoryCreatedAtPtr,
 			&categoryUpdatedAtPtr,
+			&coverIDPtr,
+			&coverPathPtr,
+			&coverVariants,
+			&coverPositionPtr,
+			&coverCreatedAtPtr,
 		); err != nil {
 			return 0, nil, err
 		}
@@ -472,11 +589,20 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 		}
 		car.Tags = tags
 
-		images, err := r.getImagesByCarID(ctx, car.ID)
-		if err != nil {
-			return 0, nil, err
+		car.Images = make([]*entities.CarImage, 0)
+		if coverIDPtr != nil {
+			car.Cover = &entities.CarImage{
+				ID:        *coverIDPtr,
+				CarID:     car.ID,
+				ImagePath: derefString(coverPathPtr),
+				Variants:  coverVariants,
+				IsCover:   true,
+				CreatedAt: derefTime(coverCreatedAtPtr),
+			}
+			if coverPositionPtr != nil {
+				car.Cover.Position = *coverPositionPtr
+			}
 		}
-		car.Images = images
 
 		c := car
 		cars = append(cars, &c)
@@ -489,6 +615,162 @@ func (r CarRepositoryImpl) ListCars(ctx context.Context, offset, limit int64, na
 	return total, cars, nil
 }
 
+// carEffectivePriceSQL is the daily price a car is quoted at for a short
+// rental: its own rate plan, then its category plan, then the legacy
+// price_per_day for cars without a plan. It expects cars as c.
+const carEffectivePriceSQL = `COALESCE((
+	SELECT rp.short_daily_rate
+	FROM rate_plans rp
+	WHERE rp.car_id = c.id OR rp.car_category_id = c.car_category_id
+	ORDER BY rp.car_id IS NULL
+	LIMIT 1
+), c.price_per_day)`
+
+// buildCarListConditions renders the WHERE clause shared by ListCars and
+// GetCarFacets. The clause expects cars as c, car_marks as cm and
+// car_categories as cc; placeholders start at $1.
+func buildCarListConditions(filter ports.CarListFilter) (string, []any) {
+	conditions := []string{"1=1"}
+	args := []any{}
+	argPos := 1
+
+	if filter.Name != "" {
+		conditions = append(conditions, fmt.Sprintf("c.name ILIKE $%d", argPos))
+		args = append(args, "%"+filter.Name+"%")
+		argPos++
+	}
+
+	if filter.MarkID != 0 {
+		conditions = append(conditions, fmt.Sprintf("cm.id = $%d", argPos))
+		args = append(args, filter.MarkID)
+		argPos++
+	}
+
+	if filter.CategoryID != 0 {
+		conditions = append(conditions, fmt.Sprintf("cc.id = $%d", argPos))
+		args = append(args, filter.CategoryID)
+		argPos++
+	}
+
+	if !filter.AvailableFrom.IsZero() && !filter.AvailableTo.IsZero() {
+		conditions = append(conditions, fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.car_id = c.id
+				AND b.status <> 'cancelled'
+				AND b.period && tstzrange($%[1]d, $%[2]d, '[)')
+		) AND NOT EXISTS (
+			SELECT 1 FROM car_maintenance_blocks mb
+			WHERE mb.car_id = c.id
+				AND mb.period && tstzrange($%[1]d, $%[2]d, '[)')
+		)`, argPos, argPos+1))
+		args = append(args, filter.AvailableFrom, filter.AvailableTo)
+		argPos += 2
+	}
+
+	addCondition := func(format string, value any) {
+		conditions = append(conditions, fmt.Sprintf(format, argPos))
+		args = append(args, value)
+		argPos++
+	}
+
+	if filter.YearFrom != 0 {
+		addCondition("c.year >= $%d", filter.YearFrom)
+	}
+	if filter.YearTo != 0 {
+		addCondition("c.year <= $%d", filter.YearTo)
+	}
+	if filter.SeatsFrom != 0 {
+		addCondition("c.seats >= $%d", filter.SeatsFrom)
+	}
+	if filter.SeatsTo != 0 {
+		addCondition("c.seats <= $%d", filter.SeatsTo)
+	}
+	if filter.HorsepowerFrom != 0 {
+		addCondition("c.horsepower >= $%d", filter.HorsepowerFrom)
+	}
+	if filter.HorsepowerTo != 0 {
+		addCondition("c.horsepower <= $%d", filter.HorsepowerTo)
+	}
+	if filter.EngineVolumeFrom != 0 {
+		addCondition("c.engine_volume >= $%d", filter.EngineVolumeFrom)
+	}
+	if filter.EngineVolumeTo != 0 {
+		addCondition("c.engine_volume <= $%d", filter.EngineVolumeTo)
+	}
+	if filter.AccelerationTo != 0 {
+		addCondition("c.acceleration <= $%d", filter.AccelerationTo)
+	}
+	if len(filter.Transmissions) > 0 {
+		addCondition("c.transmission::text = ANY($%d)", enumValues(filter.Transmissions))
+	}
+	if len(filter.FuelTypes) > 0 {
+		addCondition("c.fuel_type::text = ANY($%d)", enumValues(filter.FuelTypes))
+	}
+	if len(filter.DriveTypes) > 0 {
+		addCondition("c.drive_type::text = ANY($%d)", enumValues(filter.DriveTypes))
+	}
+	if filter.Color != "" {
+		addCondition("c.color ILIKE $%d", filter.Color)
+	}
+	if filter.PriceFrom != 0 {
+		addCondition(carEffectivePriceSQL+" >= $%d", filter.PriceFrom)
+	}
+	if filter.PriceTo != 0 {
+		addCondition(carEffectivePriceSQL+" <= $%d", filter.PriceTo)
+	}
+	if filter.OnlyWithDriver != nil {
+		addCondition("c.only_with_driver = $%d", *filter.OnlyWithDriver)
+	}
+	if len(filter.TagIDs) > 0 {
+		if filter.TagMatch == ports.CarTagMatchAll {
+			conditions = append(conditions, fmt.Sprintf(`(
+				SELECT COUNT(DISTINCT cct.car_tag_id) FROM car_car_tags cct
+				WHERE cct.car_id = c.id AND cct.car_tag_id = ANY($%d)
+			) = $%d`, argPos, argPos+1))
+			args = append(args, filter.TagIDs, int64(len(uniqueIDs(filter.TagIDs))))
+			argPos += 2
+		} else {
+			addCondition(`EXISTS (
+				SELECT 1 FROM car_car_tags cct
+				WHERE cct.car_id = c.id AND cct.car_tag_id = ANY($%d)
+			)`, filter.TagIDs)
+		}
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// carListOrderBy maps the requested sort to an ORDER BY clause. Popularity is
+// the number of live bookings plus leads for the car.
+func carListOrderBy(sort ports.CarListSort) string {
+	switch sort {
+	case ports.CarListSortPriceAsc:
+		return carEffectivePriceSQL + " ASC, c.id ASC"
+	case ports.CarListSortPriceDesc:
+		return carEffectivePriceSQL + " DESC, c.id ASC"
+	case ports.CarListSortPopular:
+		return `(
+			(SELECT COUNT(*) FROM bookings b WHERE b.car_id = c.id AND b.status <> 'cancelled') +
+			(SELECT COUNT(*) FROM leads l WHERE l.car_id = c.id)
+		) DESC, c.created_at DESC`
+	default:
+		return "c.created_at DESC"
+	}
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
 func (r CarRepositoryImpl) getTagsByCarID(ctx context.Context, carID int64) ([]*entities.CarTag, error) {
 	const query = `
 		SELECT
@@ -529,15 +811,63 @@ func (r CarRepositoryImpl) getTagsByCarID(ctx context.Context, carID int64) ([]*
 	return tags, nil
 }
 
+// getTagsByCarIDs loads the tags of several cars in one query, keyed by car id
+func getTagsByCarIDs(ctx context.Context, q querier, carIDs []int64) (map[int64][]*entities.CarTag, error) {
+	const query = `
+		SELECT
+			cct.car_id,
+			ct.id,
+			ct.name,
+			ct.created_at,
+			ct.updated_at
+		FROM car_tags ct
+		JOIN car_car_tags cct ON ct.id = cct.car_tag_id
+		WHERE cct.car_id = ANY($1)
+		ORDER BY ct.id
+	`
+
+	rows, err := q.Query(ctx, query, carIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[int64][]*entities.CarTag, len(carIDs))
+	for rows.Next() {
+		var carID int64
+		tag := &entities.CarTag{}
+		if err := rows.Scan(
+			&carID,
+			&tag.ID,
+			&tag.Name,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		tags[carID] = append(tags[carID], tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
 func (r CarRepositoryImpl) getImagesByCarID(ctx context.Context, carID int64) ([]*entities.CarImage, error) {
 	const query = `
 		SELECT
 			id,
+			car_id,
 			image_path,
+			variants,
+			position,
+			is_cover,
 			created_at
 		FROM car_images
 		WHERE car_id = $1
-		ORDER BY created_at ASC
+		ORDER BY position ASC, id ASC
 	`
 
 	rows, err := r.db.Query(ctx, query, carID)
@@ -552,7 +882,11 @@ func (r CarRepositoryImpl) getImagesByCarID(ctx context.Context, carID int64) ([
 		img := &entities.CarImage{}
 		if err := rows.Scan(
 			&img.ID,
+			&img.CarID,
 			&img.ImagePath,
+			&img.Variants,
+			&img.Position,
+			&img.IsCover,
 			&img.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -567,6 +901,14 @@ func (r CarRepositoryImpl) getImagesByCarID(ctx context.Context, carID int64) ([
 	return images, nil
 }
 
+func enumValues[T ~string](values []T) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, string(v))
+	}
+	return out
+}
+
 func derefString(s *string) string {
 	if s == nil {
 		return ""
@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+// carSearchSimilarity is the pg_trgm word similarity a car has to reach to be
+// returned when none of its stems match, which is what catches typos.
+const carSearchSimilarity = "0.4"
+
+// Private use code points never appear in car texts, so ts_headline marks
+// matches with them and the snippet can be HTML-escaped before they are
+// turned into <mark> tags.
+const (
+	headlineStartSel = "\ue000"
+	headlineStopSel  = "\ue001"
+)
+
+const headlineOptions = `StartSel="` + headlineStartSel + `", StopSel="` + headlineStopSel + `", MaxWords=20, MinWords=5, MaxFragments=2`
+
+var headlineMarkReplacer = strings.NewReplacer(
+	headlineStartSel, "<mark>",
+	headlineStopSel, "</mark>",
+)
+
+var searchTokenRegex = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'ә': "a", 'ғ': "g", 'қ': "k", 'ң': "n", 'ө': "o", 'ұ': "u", 'ү': "u",
+	'һ': "h", 'і': "i",
+}
+
+// transliterate spells Cyrillic letters in Latin, so "мерс" can also match
+// "mercedes". Other characters are kept as is.
+func transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// buildPrefixTSQuery turns free text into a to_tsquery expression where every
+// word is matched as a prefix, so "мерс" finds "мерседес". Cyrillic words
+// also match their Latin spelling.
+func buildPrefixTSQuery(query string) string {
+	tokens := searchTokenRegex.FindAllString(strings.ToLower(query), -1)
+	for i, token := range tokens {
+		latin := transliterate(token)
+		if latin == token || latin == "" {
+			tokens[i] = token + ":*"
+			continue
+		}
+		tokens[i] = "(" + token + ":* | " + latin + ":*)"
+	}
+	return strings.Join(tokens, " & ")
+}
+
+func (r CarRepositoryImpl) SearchCars(ctx context.Context, query string, offset, limit int64) (int64, []*entities.CarSearchResult, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	tsQuery := buildPrefixTSQuery(query)
+	if tsQuery == "" {
+		return 0, []*entities.CarSearchResult{}, nil
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	latinQuery := transliterate(query)
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Scoped to the transaction so the <% operator can use the trigram index
+	// with our threshold instead of the server default
+	if _, err := tx.Exec(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, carSearchSimilarity); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	const countQuery = `
+		WITH q AS (
+			SELECT to_tsquery('russian', $1) || to_tsquery('english', $1) AS query
+		)
+		SELECT COUNT(*)
+		FROM cars c, q
+		WHERE c.search_vector @@ q.query
+			OR $2 <% c.search_document
+			OR $3 <% c.search_document
+	`
+
+	var total int64
+	if err := tx.QueryRow(ctx, countQuery, tsQuery, query, latinQuery).Scan(&total); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	if total == 0 {
+		return 0, []*entities.CarSearchResult{}, nil
+	}
+
+	const selectQuery = `
+		WITH q AS (
+			SELECT to_tsquery('russian', $1) || to_tsquery('english', $1) AS query
+		)
+		SELECT
+			c.id,
+			c.name,
+			c.only_with_driver,
+			c.price_per_day,
+			` + carEffectivePriceSQL + `,
+			c.year,
+			c.seats,
+			c.transmission,
+			c.fuel_type,
+			c.engine_volume,
+			c.horsepower,
+			c.acceleration,
+			c.color,
+			c.drive_type,
+			c.created_at,
+			c.updated_at,
+			c.rating_average::float8,
+			c.review_count,
+			cm.id,
+			cm.name,
+			cm.created_at,
+			cm.updated_at,
+			cc.id,
+			cc.name,
+			cc.created_at,
+			cc.updated_at,
+			cover.id,
+			cover.image_path,
+			cover.variants,
+			cover.position,
+			cover.created_at,
+			ts_rank_cd(c.search_vector, q.query)
+				+ GREATEST(word_similarity($2, c.search_document), word_similarity($3, c.search_document)) AS rank,
+			ts_headline('russian', c.search_document, q.query, $4)
+		FROM cars c
+		CROSS JOIN q
+		LEFT JOIN car_marks cm ON c.car_mark_id = cm.id
+		LEFT JOIN car_categories cc ON c.car_category_id = cc.id
+		LEFT JOIN LATERAL (
+			SELECT id, image_path, variants, position, created_at
+			FROM car_images
+			WHERE car_id = c.id AND is_cover
+		) cover ON TRUE
+		WHERE c.search_vector @@ q.query
+			OR $2 <% c.search_document
+			OR $3 <% c.search_document
+		ORDER BY rank DESC, c.id
+		LIMIT $5 OFFSET $6
+	`
+
+	rows, err := tx.Query(ctx, selectQuery, tsQuery, query, latinQuery, headlineOptions, limit, offset)
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	results := make([]*entities.CarSearchResult, 0)
+	ids := make([]int64, 0)
+	for rows.Next() {
+		car := &entities.Car{}
+		result := &entities.CarSearchResult{Car: car}
+
+		var markIDPtr *int64
+		var markNamePtr *string
+		var markCreatedAtPtr *time.Time
+		var markUpdatedAtPtr *time.Time
+		var categoryIDPtr *int64
+		var categoryNamePtr *string
+		var categoryCreatedAtPtr *time.Time
+		var categoryUpdatedAtPtr *time.Time
+		var coverIDPtr *int64
+		var coverPathPtr *string
+		var coverVariants []entities.ImageVariant
+		var coverPositionPtr *int
+		var coverCreatedAtPtr *time.Time
+		var highlight string
+
+		if err := rows.Scan(
+			&car.ID,
+			&car.Name,
+			&car.OnlyWithDriver,
+			&car.PricePerDay,
+			&car.EffectivePricePerDay,
+			&car.Specs.Year,
+			&car.Specs.Seats,
+			&car.Specs.Transmission,
+			&car.Specs.FuelType,
+			&car.Specs.EngineVolume,
+			&car.Specs.Horsepower,
+			&car.Specs.Acceleration,
+			&car.Specs.Color,
+			&car.Specs.DriveType,
+			&car.CreatedAt,
+			&car.UpdatedAt,
+			&car.Rating.Average,
+			&car.Rating.Count,
+			&markIDPtr,
+			&markNamePtr,
+			&markCreatedAtPtr,
+			&markUpdatedAtPtr,
+			&categoryIDPtr,
+			&categoryNamePtr,
+			&categoryCreatedAtPtr,
+			&categoryUpdatedAtPtr,
+			&coverIDPtr,
+			&coverPathPtr,
+			&coverVariants,
+			&coverPositionPtr,
+			&coverCreatedAtPtr,
+			&result.Rank,
+			&highlight,
+		); err != nil {
+			rows.Close()
+			return 0, nil, r.handleError(err)
+		}
+
+		if markIDPtr != nil {
+			car.Mark = &entities.CarMark{
+				ID:        *markIDPtr,
+				Name:      derefString(markNamePtr),
+				CreatedAt: derefTime(markCreatedAtPtr),
+				UpdatedAt: derefTime(markUpdatedAtPtr),
+			}
+		}
+		if categoryIDPtr != nil {
+			car.Category = &entities.CarCategory{
+				ID:        *categoryIDPtr,
+				Name:      derefString(categoryNamePtr),
+				CreatedAt: derefTime(categoryCreatedAtPtr),
+				UpdatedAt: derefTime(categoryUpdatedAtPtr),
+			}
+		}
+
+		car.Images = make([]*entities.CarImage, 0)
+		if coverIDPtr != nil {
+			car.Cover = &entities.CarImage{
+				ID:        *coverIDPtr,
+				CarID:     car.ID,
+				ImagePath: derefString(coverPathPtr),
+				Variants:  coverVariants,
+				IsCover:   true,
+				CreatedAt: derefTime(coverCreatedAtPtr),
+			}
+			if coverPositionPtr != nil {
+				car.Cover.Position = *coverPositionPtr
+			}
+		}
+
+		// Car texts are editable by staff, so only our own markers may
+		// become HTML
+		result.Highlight = headlineMarkReplacer.Replace(html.EscapeString(highlight))
+
+		ids = append(ids, car.ID)
+		results = append(results, result)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	tags, err := getTagsByCarIDs(ctx, tx, ids)
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	for _, result := range results {
+		result.Car.Tags = tags[result.Car.ID]
+		if result.Car.Tags == nil {
+			result.Car.Tags = make([]*entities.CarTag, 0)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	return total, results, nil
+}
+
+func (r CarRepositoryImpl) handleError(err error) error {
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
@@ -0,0 +1,358 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type celebrityCarRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCelebrityCarRepository(db *pgxpool.Pool) ports.CelebrityCarRepository {
+	return &celebrityCarRepository{db: db}
+}
+
+func (r *celebrityCarRepository) AddCelebrityCar(ctx context.Context, link *entities.CelebrityCar) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock in the same order everywhere: celebrity first, then car
+	if err := lockCelebrity(ctx, tx, link.CelebrityID); err != nil {
+		return err
+	}
+	if err := lockCarGallery(ctx, tx, link.CarID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO celebrity_cars (celebrity_id, car_id, quote, rented_at, celebrity_position, car_position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4,
+			COALESCE((SELECT MAX(celebrity_position) + 1 FROM celebrity_cars WHERE celebrity_id = $1), 0),
+			COALESCE((SELECT MAX(car_position) + 1 FROM celebrity_cars WHERE car_id = $2), 0),
+			$5, $6)
+	`
+	if _, err := tx.Exec(ctx, query,
+		link.CelebrityID,
+		link.CarID,
+		link.Quote,
+		link.RentedAt,
+		link.CreatedAt,
+		link.UpdatedAt,
+	); err != nil {
+		return r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *celebrityCarRepository) GetCelebrityCar(ctx context.Context, celebrityID, carID int64) (*entities.CelebrityCar, error) {
+	query := `
+		SELECT celebrity_id, car_id, quote, rented_at, created_at, updated_at
+		FROM celebrity_cars
+		WHERE celebrity_id = $1 AND car_id = $2
+	`
+	link := &entities.CelebrityCar{}
+	err := r.db.QueryRow(ctx, query, celebrityID, carID).Scan(
+		&link.CelebrityID,
+		&link.CarID,
+		&link.Quote,
+		&link.RentedAt,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+	)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	return link, nil
+}
+
+func (r *celebrityCarRepository) UpdateCelebrityCar(ctx context.Context, link *entities.CelebrityCar) error {
+	query := `
+		UPDATE celebrity_cars
+		SET quote = $1, rented_at = $2, updated_at = $3
+		WHERE celebrity_id = $4 AND car_id = $5
+	`
+	result, err := r.db.Exec(ctx, query, link.Quote, link.RentedAt, link.UpdatedAt, link.CelebrityID, link.CarID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrCelebrityCarNotFound
+	}
+	return nil
+}
+
+func (r *celebrityCarRepository) DeleteCelebrityCar(ctx context.Context, celebrityID, carID int64) error {
+	query := `DELETE FROM celebrity_cars WHERE celebrity_id = $1 AND car_id = $2`
+	result, err := r.db.Exec(ctx, query, celebrityID, carID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrCelebrityCarNotFound
+	}
+	return nil
+}
+
+func (r *celebrityCarRepository) ReorderCelebrityCars(ctx context.Context, celebrityID int64, carIDs []int64) ([]*entities.RentedCar, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCelebrity(ctx, tx, celebrityID); err != nil {
+		return nil, err
+	}
+
+	// Same permutation check as the car gallery: with the celebrity locked
+	// and duplicates rejected by the caller, equal counts mean carIDs lists
+	// every car exactly once
+	var total, matched int64
+	countQuery := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE car_id = ANY($2))
+		FROM celebrity_cars
+		WHERE celebrity_id = $1
+	`
+	if err := tx.QueryRow(ctx, countQuery, celebrityID, carIDs).Scan(&total, &matched); err != nil {
+		return nil, r.handleError(err)
+	}
+	if matched != int64(len(carIDs)) || total != matched {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "car_ids must list every car of the celebrity exactly once")
+	}
+
+	updateQuery := `
+		UPDATE celebrity_cars cc
+		SET celebrity_position = ordered.ord - 1, updated_at = NOW()
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS ordered(id, ord)
+		WHERE cc.celebrity_id = $1 AND cc.car_id = ordered.id
+	`
+	if _, err := tx.Exec(ctx, updateQuery, celebrityID, carIDs); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	cars, err := listRentedCars(ctx, tx, celebrityID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, r.handleError(err)
+	}
+	return cars, nil
+}
+
+func (r *celebrityCarRepository) ReorderCarCelebrities(ctx context.Context, carID int64, celebrityIDs []int64) ([]*entities.CarRenter, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCarGallery(ctx, tx, carID); err != nil {
+		return nil, err
+	}
+
+	var total, matched int64
+	countQuery := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE celebrity_id = ANY($2))
+		FROM celebrity_cars
+		WHERE car_id = $1
+	`
+	if err := tx.QueryRow(ctx, countQuery, carID, celebrityIDs).Scan(&total, &matched); err != nil {
+		return nil, r.handleError(err)
+	}
+	if matched != int64(len(celebrityIDs)) || total != matched {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "celebrity_ids must list every celebrity of the car exactly once")
+	}
+
+	updateQuery := `
+		UPDATE celebrity_cars cc
+		SET car_position = ordered.ord - 1, updated_at = NOW()
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS ordered(id, ord)
+		WHERE cc.car_id = $1 AND cc.celebrity_id = ordered.id
+	`
+	if _, err := tx.Exec(ctx, updateQuery, carID, celebrityIDs); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	renters, err := listCarRenters(ctx, tx, carID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, r.handleError(err)
+	}
+	return renters, nil
+}
+
+// listRentedCars loads the cars of a celebrity with their cover images in
+// the order of the celebrity page. It is shared with the celebrity
+// repository, which includes the cars in GetCelebrityByID.
+func listRentedCars(ctx context.Context, q querier, celebrityID int64) ([]*entities.RentedCar, error) {
+	query := `
+		SELECT
+			c.id,
+			c.name,
+			cc.quote,
+			cc.rented_at,
+			cc.celebrity_position,
+			cover.id,
+			cover.image_path,
+			cover.variants,
+			cover.position,
+			cover.created_at
+		FROM celebrity_cars cc
+		JOIN cars c ON c.id = cc.car_id
+		LEFT JOIN LATERAL (
+			SELECT id, image_path, variants, position, created_at
+			FROM car_images
+			WHERE car_id = c.id AND is_cover
+		) cover ON TRUE
+		WHERE cc.celebrity_id = $1
+		ORDER BY cc.celebrity_position ASC, c.id ASC
+	`
+	rows, err := q.Query(ctx, query, celebrityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cars := make([]*entities.RentedCar, 0)
+	for rows.Next() {
+		car := &entities.RentedCar{}
+		var coverID *int64
+		var coverPath *string
+		var coverVariants []entities.ImageVariant
+		var coverPosition *int
+		var coverCreatedAt *time.Time
+		if err := rows.Scan(
+			&car.CarID,
+			&car.Name,
+			&car.Quote,
+			&car.RentedAt,
+			&car.Position,
+			&coverID,
+			&coverPath,
+			&coverVariants,
+			&coverPosition,
+			&coverCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if coverID != nil {
+			car.Cover = &entities.CarImage{
+				ID:        *coverID,
+				CarID:     car.CarID,
+				ImagePath: derefString(coverPath),
+				Variants:  coverVariants,
+				IsCover:   true,
+				CreatedAt: derefTime(coverCreatedAt),
+			}
+			if coverPosition != nil {
+				car.Cover.Position = *coverPosition
+			}
+		}
+		cars = append(cars, car)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return cars, nil
+}
+
+// listCarRenters loads the celebrities who rented a car in the order of the
+// car page. It is shared with the car repository, which includes them in
+// GetCarByID.
+func listCarRenters(ctx context.Context, q querier, carID int64) ([]*entities.CarRenter, error) {
+	query := `
+		SELECT
+			ce.id,
+			ce.name,
+			COALESCE(ce.image, ''),
+			ce.image_variants,
+			cc.quote,
+			cc.rented_at,
+			cc.car_position
+		FROM celebrity_cars cc
+		JOIN celebrities ce ON ce.id = cc.celebrity_id
+		WHERE cc.car_id = $1
+		ORDER BY cc.car_position ASC, ce.id ASC
+	`
+	rows, err := q.Query(ctx, query, carID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	renters := make([]*entities.CarRenter, 0)
+	for rows.Next() {
+		renter := &entities.CarRenter{}
+		if err := rows.Scan(
+			&renter.CelebrityID,
+			&renter.Name,
+			&renter.Image,
+			&renter.ImageVariants,
+			&renter.Quote,
+			&renter.RentedAt,
+			&renter.Position,
+		); err != nil {
+			return nil, err
+		}
+		renters = append(renters, renter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return renters, nil
+}
+
+// lockCelebrity serializes changes to the cars and the gallery of one
+// celebrity
+func lockCelebrity(ctx context.Context, tx pgx.Tx, celebrityID int64) error {
+	var id int64
+	err := tx.QueryRow(ctx, `SELECT id FROM celebrities WHERE id = $1 FOR UPDATE`, celebrityID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.New(apperrors.ErrCodeNotFound, "celebrity not found")
+	}
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+	}
+	return nil
+}
+
+func (r *celebrityCarRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrCelebrityCarNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return apperrors.ErrCelebrityCarExists
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "Связанная запись не найдена")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+const celebrityImageColumns = `id, celebrity_id, image_path, variants, position, created_at`
+
+type celebrityImageRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCelebrityImageRepository(db *pgxpool.Pool) ports.CelebrityImageRepository {
+	return &celebrityImageRepository{db: db}
+}
+
+func (r *celebrityImageRepository) Save(ctx context.Context, celebrityID int64, imagePath string, variants []entities.ImageVariant) (*entities.CelebrityImage, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCelebrity(ctx, tx, celebrityID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO celebrity_images (celebrity_id, image_path, variants, position)
+		SELECT $1, $2, $3,
+			COALESCE((SELECT MAX(position) + 1 FROM celebrity_images WHERE celebrity_id = $1), 0)
+		RETURNING ` + celebrityImageColumns
+
+	image, err := scanCelebrityImage(tx.QueryRow(ctx, query, celebrityID, imagePath, nonNilVariants(variants)))
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, r.handleError(err)
+	}
+	return image, nil
+}
+
+func (r *celebrityImageRepository) GetByID(ctx context.Context, imageID int64) (*entities.CelebrityImage, error) {
+	query := `SELECT ` + celebrityImageColumns + ` FROM celebrity_images WHERE id = $1`
+
+	image, err := scanCelebrityImage(r.db.QueryRow(ctx, query, imageID))
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	return image, nil
+}
+
+func (r *celebrityImageRepository) Delete(ctx context.Context, imageID int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM celebrity_images WHERE id = $1`, imageID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrCelebrityImageNotFound
+	}
+	return nil
+}
+
+func (r *celebrityImageRepository) Reorder(ctx context.Context, celebrityID int64, imageIDs []int64) ([]*entities.CelebrityImage, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockCelebrity(ctx, tx, celebrityID); err != nil {
+		return nil, err
+	}
+
+	var total, matched int64
+	countQuery := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE id = ANY($2))
+		FROM celebrity_images
+		WHERE celebrity_id = $1
+	`
+	if err := tx.QueryRow(ctx, countQuery, celebrityID, imageIDs).Scan(&total, &matched); err != nil {
+		return nil, r.handleError(err)
+	}
+	if matched != int64(len(imageIDs)) || total != matched {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "image_ids must list every image of the celebrity exactly once")
+	}
+
+	updateQuery := `
+		UPDATE celebrity_images ci
+		SET position = ordered.ord - 1
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS ordered(id, ord)
+		WHERE ci.id = ordered.id AND ci.celebrity_id = $1
+	`
+	if _, err := tx.Exec(ctx, updateQuery, celebrityID, imageIDs); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	images, err := listCelebrityImages(ctx, tx, celebrityID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, r.handleError(err)
+	}
+	return images, nil
+}
+
+// listCelebrityImages loads the gallery of a celebrity in display order. It
+// is shared with the celebrity repository, which includes the gallery in
+// GetCelebrityByID.
+func listCelebrityImages(ctx context.Context, q querier, celebrityID int64) ([]*entities.CelebrityImage, error) {
+	query := `
+		SELECT ` + celebrityImageColumns + `
+		FROM celebrity_images
+		WHERE celebrity_id = $1
+		ORDER BY position ASC, id ASC
+	`
+	rows, err := q.Query(ctx, query, celebrityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := make([]*entities.CelebrityImage, 0)
+	for rows.Next() {
+		image, err := scanCelebrityImage(rows)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func scanCelebrityImage(row pgx.Row) (*entities.CelebrityImage, error) {
+	var image entities.CelebrityImage
+	err := row.Scan(&image.ID, &image.CelebrityID, &image.ImagePath, &image.Variants, &image.Position, &image.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func (r *celebrityImageRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrCelebrityImageNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+		return apperrors.New(apperrors.ErrCodeNotFound, "celebrity not found")
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
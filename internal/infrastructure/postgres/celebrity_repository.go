@@ -2,12 +2,24 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
+const celebrityColumns = `
+	id, name, profession, bio, social_links, is_featured, display_order,
+	image, image_variants, created_at, updated_at
+`
+
+// nextFeaturedOrder places a newly featured celebrity at the end of the
+// carousel
+const nextFeaturedOrder = `(SELECT COALESCE(MAX(display_order) + 1, 0) FROM celebrities WHERE is_featured)`
+
 type CelebrityRepositoryImpl struct {
 	db *pgxpool.Pool
 }
@@ -16,75 +28,116 @@ func NewCelebrityRepositoryImpl(db *pgxpool.Pool) ports.CelebrityRepository {
 	return &CelebrityRepositoryImpl{db: db}
 }
 
-func (r *CelebrityRepositoryImpl) CreateCelebrity(ctx context.Context, celebrity *entities.Celebrity) error {
-	query := `
-		INSERT INTO celebrities (name, image)
-		VALUES ($1, $2)
-		RETURNING id, name, image, created_at, updated_at
-	`
-	return r.db.QueryRow(ctx, query, celebrity.Name, celebrity.Image).Scan(
+func scanCelebrity(row pgx.Row, celebrity *entities.Celebrity) error {
+	if err := row.Scan(
 		&celebrity.ID,
 		&celebrity.Name,
+		&celebrity.Profession,
+		&celebrity.Bio,
+		&celebrity.SocialLinks,
+		&celebrity.IsFeatured,
+		&celebrity.DisplayOrder,
 		&celebrity.Image,
+		&celebrity.ImageVariants,
 		&celebrity.CreatedAt,
 		&celebrity.UpdatedAt,
-	)
+	); err != nil {
+		return err
+	}
+	if celebrity.SocialLinks == nil {
+		celebrity.SocialLinks = make([]entities.SocialLink, 0)
+	}
+	return nil
+}
+
+func nonNilSocialLinks(links []entities.SocialLink) []entities.SocialLink {
+	if links == nil {
+		return make([]entities.SocialLink, 0)
+	}
+	return links
 }
 
-func (r *CelebrityRepositoryImpl) UploadImage(ctx context.Context, id int64, imagePath string) (*entities.Celebrity, error) {
+func (r *CelebrityRepositoryImpl) CreateCelebrity(ctx context.Context, celebrity *entities.Celebrity) error {
+	query := `
+		INSERT INTO celebrities (name, profession, bio, social_links, is_featured, display_order, image)
+		VALUES ($1, $2, $3, $4, $5, CASE WHEN $5 THEN ` + nextFeaturedOrder + ` ELSE 0 END, $6)
+		RETURNING ` + celebrityColumns
+	return scanCelebrity(r.db.QueryRow(ctx, query,
+		celebrity.Name,
+		celebrity.Profession,
+		celebrity.Bio,
+		nonNilSocialLinks(celebrity.SocialLinks),
+		celebrity.IsFeatured,
+		celebrity.Image,
+	), celebrity)
+}
+
+func (r *CelebrityRepositoryImpl) UploadImage(ctx context.Context, id int64, imagePath string, variants []entities.ImageVariant) (*entities.Celebrity, error) {
 	query := `
 		UPDATE celebrities
-		SET image = $1, updated_at = NOW()
-		WHERE id = $2
-		RETURNING id, name, image, created_at, updated_at
-	`
+		SET image = $1, image_variants = $2, updated_at = NOW()
+		WHERE id = $3
+		RETURNING ` + celebrityColumns
 	var celebrity entities.Celebrity
-	err := r.db.QueryRow(ctx, query, imagePath, id).Scan(
-		&celebrity.ID,
-		&celebrity.Name,
-		&celebrity.Image,
-		&celebrity.CreatedAt,
-		&celebrity.UpdatedAt,
-	)
+	err := scanCelebrity(r.db.QueryRow(ctx, query, imagePath, nonNilVariants(variants), id), &celebrity)
 	if err != nil {
 		return nil, err
 	}
 	return &celebrity, nil
 }
 
+// UpdateCelebrity saves the profile. A celebrity that becomes featured is
+// placed last in the carousel; one that stops being featured loses its place.
 func (r *CelebrityRepositoryImpl) UpdateCelebrity(ctx context.Context, celebrity *entities.Celebrity) error {
 	query := `
 		UPDATE celebrities
-		SET name = $1, updated_at = NOW()
-		WHERE id = $2
-		RETURNING id, name, image, created_at, updated_at
-	`
-	return r.db.QueryRow(ctx, query, celebrity.Name, celebrity.ID).Scan(
-		&celebrity.ID,
-		&celebrity.Name,
-		&celebrity.Image,
-		&celebrity.CreatedAt,
-		&celebrity.UpdatedAt,
-	)
+		SET name = $1,
+			profession = $2,
+			bio = $3,
+			social_links = $4,
+			display_order = CASE
+				WHEN NOT $5 THEN 0
+				WHEN is_featured THEN display_order
+				ELSE ` + nextFeaturedOrder + `
+			END,
+			is_featured = $5,
+			updated_at = NOW()
+		WHERE id = $6
+		RETURNING ` + celebrityColumns
+	return scanCelebrity(r.db.QueryRow(ctx, query,
+		celebrity.Name,
+		celebrity.Profession,
+		celebrity.Bio,
+		nonNilSocialLinks(celebrity.SocialLinks),
+		celebrity.IsFeatured,
+		celebrity.ID,
+	), celebrity)
 }
 
 func (r *CelebrityRepositoryImpl) GetCelebrityByID(ctx context.Context, id int64) (*entities.Celebrity, error) {
-	query := `
-		SELECT id, name, image, created_at, updated_at
-		FROM celebrities
-		WHERE id = $1
-	`
+	query := `SELECT ` + celebrityColumns + ` FROM celebrities WHERE id = $1`
+
 	var celebrity entities.Celebrity
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&celebrity.ID,
-		&celebrity.Name,
-		&celebrity.Image,
-		&celebrity.CreatedAt,
-		&celebrity.UpdatedAt,
-	)
+	err := scanCelebrity(r.db.QueryRow(ctx, query, id), &celebrity)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrCelebrityNotFound
+	}
 	if err != nil {
-		return nil, err
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+	}
+
+	images, err := listCelebrityImages(ctx, r.db, id)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
 	}
+	celebrity.Images = images
+
+	cars, err := listRentedCars(ctx, r.db, id)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+	}
+	celebrity.Cars = cars
+
 	return &celebrity, nil
 }
 
@@ -109,7 +162,7 @@ func (r *CelebrityRepositoryImpl) ListCelebrities(ctx context.Context, offset in
 	}
 
 	query := `
-		SELECT id, name, image, created_at, updated_at
+		SELECT ` + celebrityColumns + `
 		FROM celebrities
 		ORDER BY created_at DESC
 		OFFSET $1 LIMIT $2
@@ -119,27 +172,95 @@ func (r *CelebrityRepositoryImpl) ListCelebrities(ctx context.Context, offset in
 	if err != nil {
 		return 0, nil, err
 	}
+
+	celebrities, err := collectCelebrities(rows)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return total, celebrities, nil
+}
+
+func (r *CelebrityRepositoryImpl) ListFeaturedCelebrities(ctx context.Context) ([]*entities.Celebrity, error) {
+	return listFeaturedCelebrities(ctx, r.db)
+}
+
+func (r *CelebrityRepositoryImpl) ReorderFeaturedCelebrities(ctx context.Context, celebrityIDs []int64) ([]*entities.Celebrity, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the featured rows keeps the set from changing before the
+	// update, so with duplicates rejected by the caller equal counts mean
+	// celebrityIDs lists every featured celebrity exactly once
+	if _, err := tx.Exec(ctx, `SELECT id FROM celebrities WHERE is_featured FOR UPDATE`); err != nil {
+		return nil, err
+	}
+
+	var total, matched int64
+	countQuery := `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE id = ANY($1))
+		FROM celebrities
+		WHERE is_featured
+	`
+	if err := tx.QueryRow(ctx, countQuery, celebrityIDs).Scan(&total, &matched); err != nil {
+		return nil, err
+	}
+	if matched != int64(len(celebrityIDs)) || total != matched {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "celebrity_ids must list every featured celebrity exactly once")
+	}
+
+	updateQuery := `
+		UPDATE celebrities ce
+		SET display_order = ordered.ord - 1, updated_at = NOW()
+		FROM unnest($1::bigint[]) WITH ORDINALITY AS ordered(id, ord)
+		WHERE ce.id = ordered.id
+	`
+	if _, err := tx.Exec(ctx, updateQuery, celebrityIDs); err != nil {
+		return nil, err
+	}
+
+	celebrities, err := listFeaturedCelebrities(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return celebrities, nil
+}
+
+func listFeaturedCelebrities(ctx context.Context, q querier) ([]*entities.Celebrity, error) {
+	query := `
+		SELECT ` + celebrityColumns + `
+		FROM celebrities
+		WHERE is_featured
+		ORDER BY display_order ASC, id ASC
+	`
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return collectCelebrities(rows)
+}
+
+func collectCelebrities(rows pgx.Rows) ([]*entities.Celebrity, error) {
 	defer rows.Close()
 
-	var celebrities []*entities.Celebrity
+	celebrities := make([]*entities.Celebrity, 0)
 	for rows.Next() {
 		var celebrity entities.Celebrity
-		err := rows.Scan(
-			&celebrity.ID,
-			&celebrity.Name,
-			&celebrity.Image,
-			&celebrity.CreatedAt,
-			&celebrity.UpdatedAt,
-		)
-		if err != nil {
-			return 0, nil, err
+		if err := scanCelebrity(rows, &celebrity); err != nil {
+			return nil, err
 		}
 		celebrities = append(celebrities, &celebrity)
 	}
 
-	if err = rows.Err(); err != nil {
-		return 0, nil, err
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-
-	return total, celebrities, nil
+	return celebrities, nil
 }
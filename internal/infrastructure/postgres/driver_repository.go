@@ -2,11 +2,17 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type driverRepository struct {
@@ -17,58 +23,166 @@ func NewDriverRepository(db *pgxpool.Pool) ports.DriverRepository {
 	return &driverRepository{db: db}
 }
 
+const driverColumns = `
+	d.id, d.full_name, d.about, d.photo_url, d.photo_variants, d.experience_years,
+	d.driving_experience, d.languages,
+	ARRAY(SELECT l.category FROM driver_licenses l WHERE l.driver_id = d.id ORDER BY l.category),
+	ARRAY(SELECT l.expires_at FROM driver_licenses l WHERE l.driver_id = d.id ORDER BY l.category),
+	ARRAY(SELECT dc.car_id FROM driver_cars dc WHERE dc.driver_id = d.id ORDER BY dc.car_id),
+	ARRAY(SELECT dcc.category_id FROM driver_car_categories dcc WHERE dcc.driver_id = d.id ORDER BY dcc.category_id),
+	d.rating_average::float8, d.review_count,
+	d.created_at, d.updated_at
+`
+
+func scanDriver(row pgx.Row, driver *entities.Driver) error {
+	var categories []string
+	var expiries []time.Time
+	if err := row.Scan(
+		&driver.ID,
+		&driver.FullName,
+		&driver.About,
+		&driver.PhotoURL,
+		&driver.PhotoVariants,
+		&driver.ExperienceYears,
+		&driver.Qualifications.DrivingExperience,
+		&driver.Qualifications.Languages,
+		&categories,
+		&expiries,
+		&driver.Qualifications.CarIDs,
+		&driver.Qualifications.CategoryIDs,
+		&driver.Rating.Average,
+		&driver.Rating.Count,
+		&driver.CreatedAt,
+		&driver.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	driver.Qualifications.Licenses = make([]entities.DriverLicense, 0, len(categories))
+	for i, category := range categories {
+		driver.Qualifications.Licenses = append(driver.Qualifications.Licenses, entities.DriverLicense{
+			Category:  entities.DriverLicenseCategory(category),
+			ExpiresAt: expiries[i],
+		})
+	}
+	return nil
+}
+
 func (r *driverRepository) CreateDriver(ctx context.Context, driver *entities.Driver) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
-		INSERT INTO drivers (full_name, about, photo_url, experience_years, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO drivers (full_name, about, photo_url, photo_variants, experience_years, driving_experience, languages, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id
 	`
-	return r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		driver.FullName,
 		driver.About,
 		driver.PhotoURL,
+		nonNilVariants(driver.PhotoVariants),
 		driver.ExperienceYears,
+		driver.Qualifications.DrivingExperience,
+		driver.Qualifications.Languages,
 		driver.CreatedAt,
 		driver.UpdatedAt,
 	).Scan(&driver.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+
+	if err := r.insertQualifications(ctx, tx, driver); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
 }
 
 func (r *driverRepository) GetDriverByID(ctx context.Context, id int64) (*entities.Driver, error) {
-	query := `
-		SELECT id, full_name, about, photo_url, experience_years, created_at, updated_at
-		FROM drivers
-		WHERE id = $1
-	`
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM drivers d
+		WHERE d.id = $1
+	`, driverColumns)
 	driver := &entities.Driver{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&driver.ID,
-		&driver.FullName,
-		&driver.About,
-		&driver.PhotoURL,
-		&driver.ExperienceYears,
-		&driver.CreatedAt,
-		&driver.UpdatedAt,
-	)
+	err := scanDriver(r.db.QueryRow(ctx, query, id), driver)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrDriverNotFound
+	}
 	if err != nil {
-		return nil, err
+		return nil, r.handleError(err)
 	}
 	return driver, nil
 }
 
-func (r *driverRepository) ListDrivers(ctx context.Context, offset, limit int64) (int64, []*entities.Driver, error) {
-	countQuery := `SELECT COUNT(*) FROM drivers`
+func (r *driverRepository) ListDrivers(ctx context.Context, offset, limit int64, filter ports.DriverFilter) (int64, []*entities.Driver, error) {
+	conditions := []string{"1=1"}
+	args := []any{}
+	argPos := 1
+
+	if filter.MinExperience > 0 {
+		conditions = append(conditions, fmt.Sprintf("d.driving_experience >= $%d", argPos))
+		args = append(args, filter.MinExperience)
+		argPos++
+	}
+	if len(filter.Languages) > 0 {
+		conditions = append(conditions, fmt.Sprintf("d.languages @> $%d::text[]", argPos))
+		args = append(args, filter.Languages)
+		argPos++
+	}
+	if filter.LicenseCategory != "" {
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM driver_licenses l
+			WHERE l.driver_id = d.id AND l.category = $%d AND l.expires_at >= CURRENT_DATE
+		)`, argPos))
+		args = append(args, filter.LicenseCategory)
+		argPos++
+	}
+	if filter.CarID > 0 {
+		// Certified for the car itself or for its category
+		conditions = append(conditions, fmt.Sprintf(`(
+			EXISTS (SELECT 1 FROM driver_cars dc WHERE dc.driver_id = d.id AND dc.car_id = $%d)
+			OR EXISTS (
+				SELECT 1 FROM driver_car_categories dcc
+				JOIN cars c ON c.car_category_id = dcc.category_id
+				WHERE dcc.driver_id = d.id AND c.id = $%d
+			)
+		)`, argPos, argPos))
+		args = append(args, filter.CarID)
+		argPos++
+	}
+	if filter.CategoryID > 0 {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM driver_car_categories dcc WHERE dcc.driver_id = d.id AND dcc.category_id = $%d)", argPos))
+		args = append(args, filter.CategoryID)
+		argPos++
+	}
+
+	whereSQL := strings.Join(conditions, " AND ")
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM drivers d WHERE %s`, whereSQL)
 	var total int64
-	if err := r.db.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
 		return 0, nil, err
 	}
 
-	query := `
-		SELECT id, full_name, about, photo_url, experience_years, created_at, updated_at
-		FROM drivers
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM drivers d
+		WHERE %s
+		ORDER BY d.created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, driverColumns, whereSQL, argPos, argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -77,15 +191,7 @@ func (r *driverRepository) ListDrivers(ctx context.Context, offset, limit int64)
 	drivers := make([]*entities.Driver, 0)
 	for rows.Next() {
 		driver := &entities.Driver{}
-		if err := rows.Scan(
-			&driver.ID,
-			&driver.FullName,
-			&driver.About,
-			&driver.PhotoURL,
-			&driver.ExperienceYears,
-			&driver.CreatedAt,
-			&driver.UpdatedAt,
-		); err != nil {
+		if err := scanDriver(rows, driver); err != nil {
 			return 0, nil, err
 		}
 		drivers = append(drivers, driver)
@@ -99,27 +205,72 @@ func (r *driverRepository) ListDrivers(ctx context.Context, offset, limit int64)
 }
 
 func (r *driverRepository) UpdateDriver(ctx context.Context, driver *entities.Driver) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE drivers
-		SET full_name = $1, about = $2, photo_url = $3, experience_years = $4, updated_at = $5
-		WHERE id = $6
+		SET full_name = $1, about = $2, photo_url = $3, photo_variants = $4, experience_years = $5,
+			driving_experience = $6, languages = $7, updated_at = $8
+		WHERE id = $9
 	`
-	result, err := r.db.Exec(ctx, query,
+	result, err := tx.Exec(ctx, query,
 		driver.FullName,
 		driver.About,
 		driver.PhotoURL,
+		nonNilVariants(driver.PhotoVariants),
 		driver.ExperienceYears,
+		driver.Qualifications.DrivingExperience,
+		driver.Qualifications.Languages,
 		driver.UpdatedAt,
 		driver.ID,
 	)
 	if err != nil {
-		return err
+		return r.handleError(err)
 	}
 
 	if result.RowsAffected() == 0 {
 		return fmt.Errorf("driver not found")
 	}
 
+	// Licenses and certifications are owned by the driver and replaced as a whole
+	for _, table := range []string{"driver_licenses", "driver_cars", "driver_car_categories"} {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE driver_id = $1`, table), driver.ID); err != nil {
+			return r.handleError(err)
+		}
+	}
+	if err := r.insertQualifications(ctx, tx, driver); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *driverRepository) insertQualifications(ctx context.Context, tx pgx.Tx, driver *entities.Driver) error {
+	for _, license := range driver.Qualifications.Licenses {
+		query := `INSERT INTO driver_licenses (driver_id, category, expires_at) VALUES ($1, $2, $3)`
+		if _, err := tx.Exec(ctx, query, driver.ID, license.Category, license.ExpiresAt); err != nil {
+			return r.handleError(err)
+		}
+	}
+	if len(driver.Qualifications.CarIDs) > 0 {
+		query := `INSERT INTO driver_cars (driver_id, car_id) SELECT $1, unnest($2::int[])`
+		if _, err := tx.Exec(ctx, query, driver.ID, driver.Qualifications.CarIDs); err != nil {
+			return r.handleError(err)
+		}
+	}
+	if len(driver.Qualifications.CategoryIDs) > 0 {
+		query := `INSERT INTO driver_car_categories (driver_id, category_id) SELECT $1, unnest($2::int[])`
+		if _, err := tx.Exec(ctx, query, driver.ID, driver.Qualifications.CategoryIDs); err != nil {
+			return r.handleError(err)
+		}
+	}
 	return nil
 }
 
@@ -136,3 +287,201 @@ func (r *driverRepository) DeleteDriver(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (r *driverRepository) GetSchedule(ctx context.Context, driverID int64) (*entities.DriverSchedule, error) {
+	schedules, err := r.ListSchedules(ctx, []int64{driverID})
+	if err != nil {
+		return nil, err
+	}
+	return schedules[driverID], nil
+}
+
+func (r *driverRepository) ReplaceSchedule(ctx context.Context, schedule *entities.DriverSchedule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM driver_working_hours WHERE driver_id = $1`, schedule.DriverID); err != nil {
+		return r.handleError(err)
+	}
+
+	for _, shift := range schedule.Shifts {
+		query := `
+			INSERT INTO driver_working_hours (driver_id, weekday, start_time, end_time)
+			VALUES ($1, $2, $3::time, $4::time)
+		`
+		if _, err := tx.Exec(ctx, query, schedule.DriverID, int(shift.Weekday), shift.StartTime, shift.EndTime); err != nil {
+			return r.handleError(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *driverRepository) ListSchedules(ctx context.Context, driverIDs []int64) (map[int64]*entities.DriverSchedule, error) {
+	schedules := make(map[int64]*entities.DriverSchedule, len(driverIDs))
+	for _, id := range driverIDs {
+		schedules[id] = &entities.DriverSchedule{DriverID: id, Shifts: make([]entities.DriverShift, 0)}
+	}
+	if len(driverIDs) == 0 {
+		return schedules, nil
+	}
+
+	query := `
+		SELECT driver_id, weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+		FROM driver_working_hours
+		WHERE driver_id = ANY($1)
+		ORDER BY driver_id, weekday
+	`
+	rows, err := r.db.Query(ctx, query, driverIDs)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var driverID int64
+		var weekday int
+		var shift entities.DriverShift
+		if err := rows.Scan(&driverID, &weekday, &shift.StartTime, &shift.EndTime); err != nil {
+			return nil, r.handleError(err)
+		}
+		shift.Weekday = time.Weekday(weekday)
+		if schedule, ok := schedules[driverID]; ok {
+			schedule.Shifts = append(schedule.Shifts, shift)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return schedules, nil
+}
+
+func (r *driverRepository) CreateDayOff(ctx context.Context, dayOff *entities.DriverDayOff) error {
+	query := `
+		INSERT INTO driver_days_off (driver_id, period, reason, created_at)
+		VALUES ($1, tstzrange($2, $3, '[)'), $4, $5)
+		RETURNING id
+	`
+	err := r.db.QueryRow(ctx, query,
+		dayOff.DriverID,
+		dayOff.StartDate,
+		dayOff.EndDate,
+		dayOff.Reason,
+		dayOff.CreatedAt,
+	).Scan(&dayOff.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *driverRepository) DeleteDayOff(ctx context.Context, driverID, dayOffID int64) error {
+	query := `DELETE FROM driver_days_off WHERE id = $1 AND driver_id = $2`
+	result, err := r.db.Exec(ctx, query, dayOffID, driverID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrDayOffNotFound
+	}
+	return nil
+}
+
+func (r *driverRepository) ListBusyIntervals(ctx context.Context, driverID int64, from, to time.Time) ([]*entities.BusyInterval, error) {
+	query := `
+		SELECT lower(period), upper(period), 'booking' AS kind
+		FROM bookings
+		WHERE driver_id = $1
+			AND status <> 'cancelled'
+			AND period && tstzrange($2, $3, '[)')
+		UNION ALL
+		SELECT lower(period), upper(period), 'day_off' AS kind
+		FROM driver_days_off
+		WHERE driver_id = $1
+			AND period && tstzrange($2, $3, '[)')
+		ORDER BY 1
+	`
+	rows, err := r.db.Query(ctx, query, driverID, from, to)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	intervals := make([]*entities.BusyInterval, 0)
+	for rows.Next() {
+		interval := &entities.BusyInterval{}
+		if err := rows.Scan(&interval.Start, &interval.End, &interval.Kind); err != nil {
+			return nil, r.handleError(err)
+		}
+		intervals = append(intervals, interval)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return intervals, nil
+}
+
+func (r *driverRepository) ListAvailableDrivers(ctx context.Context, from, to time.Time) ([]*entities.Driver, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM drivers d
+		WHERE NOT EXISTS (
+				SELECT 1 FROM bookings b
+				WHERE b.driver_id = d.id
+					AND b.status <> 'cancelled'
+					AND b.period && tstzrange($1, $2, '[)')
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM driver_days_off o
+				WHERE o.driver_id = d.id
+					AND o.period && tstzrange($1, $2, '[)')
+			)
+		ORDER BY d.full_name
+	`, driverColumns)
+	rows, err := r.db.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	drivers := make([]*entities.Driver, 0)
+	for rows.Next() {
+		driver := &entities.Driver{}
+		if err := scanDriver(rows, driver); err != nil {
+			return nil, r.handleError(err)
+		}
+		drivers = append(drivers, driver)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return drivers, nil
+}
+
+func (r *driverRepository) handleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23P01": // exclusion_violation
+			return apperrors.New(apperrors.ErrCodeConflict, "Выходной пересекается с существующим")
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "Связанная запись не найдена")
+		case "23514": // check_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeValidation, "Неверные данные водителя")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
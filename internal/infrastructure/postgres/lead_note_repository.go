@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type leadNoteRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLeadNoteRepository(db *pgxpool.Pool) ports.LeadNoteRepository {
+	return &leadNoteRepository{db: db}
+}
+
+func (r *leadNoteRepository) CreateNote(ctx context.Context, note *entities.LeadNote) error {
+	query := `
+		INSERT INTO lead_notes (lead_id, author_id, body, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query, note.LeadID, note.AuthorID, note.Body, note.CreatedAt).Scan(&note.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *leadNoteRepository) ListNotes(ctx context.Context, leadID int64) ([]*entities.LeadNote, error) {
+	query := `
+		SELECT id, lead_id, author_id, body, created_at
+		FROM lead_notes
+		WHERE lead_id = $1 OR lead_id IN (SELECT id FROM leads WHERE merged_into_id = $1)
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := r.db.Query(ctx, query, leadID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	notes := make([]*entities.LeadNote, 0)
+	for rows.Next() {
+		note := &entities.LeadNote{}
+		if err := rows.Scan(&note.ID, &note.LeadID, &note.AuthorID, &note.Body, &note.CreatedAt); err != nil {
+			return nil, r.handleError(err)
+		}
+		notes = append(notes, note)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return notes, nil
+}
+
+func (r *leadNoteRepository) handleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+		return apperrors.ErrLeadNotFound
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
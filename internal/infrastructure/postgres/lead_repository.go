@@ -2,11 +2,17 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
 type leadRepository struct {
@@ -17,73 +23,139 @@ func NewLeadRepository(db *pgxpool.Pool) ports.LeadRepository {
 	return &leadRepository{db: db}
 }
 
-func (r *leadRepository) CreateLead(ctx context.Context, lead *entities.Lead) error {
-	query := `
-		INSERT INTO leads (full_name, phone, period, created_at)
-		VALUES ($1, $2, tstzrange($3, $4, '[]'), $5)
-		RETURNING id
-	`
-	return r.db.QueryRow(ctx, query, lead.FullName, lead.Phone, lead.StartDate, lead.EndDate, lead.CreatedAt).Scan(&lead.ID)
-}
+const leadColumns = `
+	id, full_name, phone, phone_raw, lower(period), upper(period), car_id, with_driver, price_quote,
+	status, lost_reason, assignee_id, duplicate_of_id, merged_into_id, created_at, updated_at
+`
 
-func (r *leadRepository) GetLeadByID(ctx context.Context, id int64) (*entities.Lead, error) {
-	query := `
-		SELECT id, full_name, phone, lower(period), upper(period), created_at
-		FROM leads
-		WHERE id = $1
-	`
-	lead := &entities.Lead{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
+func scanLead(row pgx.Row, lead *entities.Lead) error {
+	return row.Scan(
 		&lead.ID,
 		&lead.FullName,
 		&lead.Phone,
+		&lead.PhoneRaw,
 		&lead.StartDate,
 		&lead.EndDate,
+		&lead.CarID,
+		&lead.WithDriver,
+		&lead.PriceQuote,
+		&lead.Status,
+		&lead.LostReason,
+		&lead.AssigneeID,
+		&lead.DuplicateOfID,
+		&lead.MergedIntoID,
 		&lead.CreatedAt,
+		&lead.UpdatedAt,
 	)
-	if err != nil {
-		return nil, err
+}
+
+func (r *leadRepository) CreateLead(ctx context.Context, lead *entities.Lead) error {
+	query := `
+		INSERT INTO leads (full_name, phone, phone_raw, period, car_id, with_driver, price_quote, status, duplicate_of_id, created_at, updated_at)
+		VALUES ($1, $2, $3, tstzrange($4, $5, '[]'), $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+	return conn(ctx, r.db).QueryRow(ctx, query,
+		lead.FullName,
+		lead.Phone,
+		lead.PhoneRaw,
+		lead.StartDate,
+		lead.EndDate,
+		lead.CarID,
+		lead.WithDriver,
+		lead.PriceQuote,
+		lead.Status,
+		lead.DuplicateOfID,
+		lead.CreatedAt,
+		lead.UpdatedAt,
+	).Scan(&lead.ID)
+}
+
+func (r *leadRepository) GetLeadByID(ctx context.Context, id int64) (*entities.Lead, error) {
+	query := `SELECT ` + leadColumns + ` FROM leads WHERE id = $1`
+
+	lead := &entities.Lead{}
+	if err := scanLead(conn(ctx, r.db).QueryRow(ctx, query, id), lead); err != nil {
+		return nil, r.handleError(err)
 	}
 	return lead, nil
 }
 
-func (r *leadRepository) ListLeads(ctx context.Context, offset, limit int64) (int64, []*entities.Lead, error) {
-	countQuery := `SELECT COUNT(*) FROM leads`
+func (r *leadRepository) ListLeads(ctx context.Context, offset, limit int64, filter ports.LeadFilter) (int64, []*entities.Lead, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	conditions := []string{"merged_into_id IS NULL"}
+	args := []any{}
+	argPos := 1
+
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argPos))
+		args = append(args, filter.Status)
+		argPos++
+	}
+	if filter.Unassigned {
+		conditions = append(conditions, "assignee_id IS NULL")
+	} else if filter.AssigneeID != 0 {
+		conditions = append(conditions, fmt.Sprintf("assignee_id = $%d", argPos))
+		args = append(args, filter.AssigneeID)
+		argPos++
+	}
+	if filter.DuplicatesOnly {
+		conditions = append(conditions, "duplicate_of_id IS NOT NULL")
+	}
+	if !filter.CreatedFrom.IsZero() {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argPos))
+		args = append(args, filter.CreatedFrom)
+		argPos++
+	}
+	if !filter.CreatedTo.IsZero() {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", argPos))
+		args = append(args, filter.CreatedTo)
+		argPos++
+	}
+
+	whereSQL := strings.Join(conditions, " AND ")
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM leads WHERE %s`, whereSQL)
 	var total int64
-	if err := r.db.QueryRow(ctx, countQuery).Scan(&total); err != nil {
-		return 0, nil, err
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	if total == 0 {
+		return 0, []*entities.Lead{}, nil
 	}
 
-	query := `
-		SELECT id, full_name, phone, lower(period), upper(period), created_at
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM leads
+		WHERE %s
 		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-	rows, err := r.db.Query(ctx, query, limit, offset)
+		LIMIT $%d OFFSET $%d
+	`, leadColumns, whereSQL, argPos, argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, r.handleError(err)
 	}
 	defer rows.Close()
 
 	leads := make([]*entities.Lead, 0)
 	for rows.Next() {
 		lead := &entities.Lead{}
-		if err := rows.Scan(
-			&lead.ID,
-			&lead.FullName,
-			&lead.Phone,
-			&lead.StartDate,
-			&lead.EndDate,
-			&lead.CreatedAt,
-		); err != nil {
-			return 0, nil, err
+		if err := scanLead(rows, lead); err != nil {
+			return 0, nil, r.handleError(err)
 		}
 		leads = append(leads, lead)
 	}
 
 	if err := rows.Err(); err != nil {
-		return 0, nil, err
+		return 0, nil, r.handleError(err)
 	}
 
 	return total, leads, nil
@@ -93,12 +165,205 @@ func (r *leadRepository) DeleteLead(ctx context.Context, id int64) error {
 	query := `DELETE FROM leads WHERE id = $1`
 	result, err := r.db.Exec(ctx, query, id)
 	if err != nil {
-		return err
+		return r.handleError(err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("lead not found")
+		return apperrors.ErrLeadNotFound
+	}
+
+	return nil
+}
+
+func (r *leadRepository) UpdateLeadStatus(ctx context.Context, lead *entities.Lead, change *entities.LeadStatusChange) error {
+	// A single statement keeps the status and its history entry together
+	// without a transaction of its own, so it also joins the one in ctx.
+	// Matching on the previous status makes a concurrent change lose instead
+	// of silently overwriting the other one
+	query := `
+		WITH updated AS (
+			UPDATE leads
+			SET status = $1, lost_reason = $2, updated_at = $3
+			WHERE id = $4 AND status = $5
+			RETURNING id
+		)
+		INSERT INTO lead_status_history (lead_id, from_status, to_status, reason, changed_by, created_at)
+		SELECT id, $5, $6, $7, $8, $9
+		FROM updated
+		RETURNING id
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		lead.Status,
+		lead.LostReason,
+		lead.UpdatedAt,
+		lead.ID,
+		change.FromStatus,
+		change.ToStatus,
+		change.Reason,
+		change.ChangedBy,
+		change.CreatedAt,
+	).Scan(&change.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.New(apperrors.ErrCodeConflict, "Статус заявки уже изменен")
+	}
+	if err != nil {
+		return r.handleError(err)
 	}
+	return nil
+}
 
+func (r *leadRepository) UpdateLeadAssignee(ctx context.Context, lead *entities.Lead) error {
+	query := `UPDATE leads SET assignee_id = $1, updated_at = $2 WHERE id = $3`
+	result, err := conn(ctx, r.db).Exec(ctx, query, lead.AssigneeID, lead.UpdatedAt, lead.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrLeadNotFound
+	}
 	return nil
 }
+
+func (r *leadRepository) ListLeadStatusHistory(ctx context.Context, leadID int64) ([]*entities.LeadStatusChange, error) {
+	query := `
+		SELECT id, lead_id, from_status, to_status, reason, changed_by, created_at
+		FROM lead_status_history
+		WHERE lead_id = $1 OR lead_id IN (SELECT id FROM leads WHERE merged_into_id = $1)
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := r.db.Query(ctx, query, leadID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	history := make([]*entities.LeadStatusChange, 0)
+	for rows.Next() {
+		change := &entities.LeadStatusChange{}
+		if err := rows.Scan(
+			&change.ID,
+			&change.LeadID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.Reason,
+			&change.ChangedBy,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, r.handleError(err)
+		}
+		history = append(history, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	return history, nil
+}
+
+func (r *leadRepository) FindDuplicateCandidates(ctx context.Context, lead *entities.Lead, since time.Time) ([]*entities.Lead, error) {
+	// Same-phone leads are the strongest matches, so a busy period must not
+	// push them past the limit
+	query := `
+		SELECT ` + leadColumns + `
+		FROM leads
+		WHERE merged_into_id IS NULL
+			AND created_at >= $1
+			AND (phone = $2 OR period && tstzrange($3, $4, '[]'))
+		ORDER BY phone = $2 DESC, created_at ASC, id ASC
+		LIMIT 100
+	`
+	return r.queryLeads(ctx, query, since, lead.Phone, lead.StartDate, lead.EndDate)
+}
+
+func (r *leadRepository) ListDuplicateGroup(ctx context.Context, rootID int64) ([]*entities.Lead, error) {
+	query := `
+		SELECT ` + leadColumns + `
+		FROM leads
+		WHERE (id = $1 OR duplicate_of_id = $1) AND merged_into_id IS NULL
+		ORDER BY created_at ASC, id ASC
+	`
+	return r.queryLeads(ctx, query, rootID)
+}
+
+func (r *leadRepository) MergeLeads(ctx context.Context, target *entities.Lead, sources []*entities.Lead) error {
+	db := conn(ctx, r.db)
+
+	sourceIDs := make([]int64, len(sources))
+	for i, src := range sources {
+		sourceIDs[i] = src.ID
+	}
+
+	// The merged_into_id guards make a concurrent merge of the same leads fail
+	// instead of merging them twice
+	result, err := db.Exec(ctx, `
+		UPDATE leads
+		SET merged_into_id = $1, duplicate_of_id = NULL, updated_at = $2
+		WHERE id = ANY($3) AND id <> $1 AND merged_into_id IS NULL
+	`, target.ID, target.UpdatedAt, sourceIDs)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() != int64(len(sourceIDs)) {
+		return apperrors.New(apperrors.ErrCodeConflict, "Заявка уже объединена с другой")
+	}
+
+	result, err = db.Exec(ctx, `
+		UPDATE leads
+		SET car_id = $1, with_driver = $2, price_quote = $3, assignee_id = $4, duplicate_of_id = $5, updated_at = $6
+		WHERE id = $7 AND merged_into_id IS NULL
+	`, target.CarID, target.WithDriver, target.PriceQuote, target.AssigneeID, target.DuplicateOfID, target.UpdatedAt, target.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.New(apperrors.ErrCodeConflict, "Заявка уже объединена с другой")
+	}
+
+	// Keep merge chains one level deep so the history of target is found
+	// with a single lookup
+	if _, err := db.Exec(ctx, `UPDATE leads SET merged_into_id = $1 WHERE merged_into_id = ANY($2)`, target.ID, sourceIDs); err != nil {
+		return r.handleError(err)
+	}
+	if _, err := db.Exec(ctx, `
+		UPDATE leads SET duplicate_of_id = $1
+		WHERE duplicate_of_id = ANY($2) AND id <> $1 AND merged_into_id IS NULL
+	`, target.ID, sourceIDs); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *leadRepository) queryLeads(ctx context.Context, query string, args ...any) ([]*entities.Lead, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	leads := make([]*entities.Lead, 0)
+	for rows.Next() {
+		lead := &entities.Lead{}
+		if err := scanLead(rows, lead); err != nil {
+			return nil, r.handleError(err)
+		}
+		leads = append(leads, lead)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+	return leads, nil
+}
+
+func (r *leadRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrLeadNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "Связанная запись не найдена")
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
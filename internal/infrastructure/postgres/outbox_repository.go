@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+const outboxColumns = `id, topic, payload, status, attempts, next_attempt_at, last_error, created_at, processed_at`
+
+type outboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) ports.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func scanOutboxMessage(row pgx.Row, msg *entities.OutboxMessage) error {
+	return row.Scan(
+		&msg.ID,
+		&msg.Topic,
+		&msg.Payload,
+		&msg.Status,
+		&msg.Attempts,
+		&msg.NextAttemptAt,
+		&msg.LastError,
+		&msg.CreatedAt,
+		&msg.ProcessedAt,
+	)
+}
+
+func (r *outboxRepository) Enqueue(ctx context.Context, msg *entities.OutboxMessage) error {
+	query := `
+		INSERT INTO outbox_messages (topic, payload, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		msg.Topic,
+		msg.Payload,
+		msg.Status,
+		msg.NextAttemptAt,
+		msg.CreatedAt,
+	).Scan(&msg.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*entities.OutboxMessage, error) {
+	// SKIP LOCKED lets several API instances poll the same table without
+	// delivering a message twice
+	query := `
+		UPDATE outbox_messages
+		SET attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM outbox_messages
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC, id ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + outboxColumns
+
+	rows, err := r.db.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	messages := make([]*entities.OutboxMessage, 0)
+	for rows.Next() {
+		msg := &entities.OutboxMessage{}
+		if err := scanOutboxMessage(rows, msg); err != nil {
+			return nil, r.handleError(err)
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+	return messages, nil
+}
+
+func (r *outboxRepository) MarkSent(ctx context.Context, id int64) error {
+	query := `
+		UPDATE outbox_messages
+		SET status = 'sent', last_error = NULL, processed_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := r.db.Exec(ctx, query, id); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) MarkRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE outbox_messages
+		SET next_attempt_at = $2, last_error = $3
+		WHERE id = $1
+	`
+	if _, err := r.db.Exec(ctx, query, id, nextAttemptAt, lastError); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	query := `
+		UPDATE outbox_messages
+		SET status = 'dead', last_error = $2, processed_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := r.db.Exec(ctx, query, id, lastError); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM outbox_messages WHERE status = 'sent' AND processed_at < $1`
+
+	tag, err := r.db.Exec(ctx, query, before)
+	if err != nil {
+		return 0, r.handleError(err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *outboxRepository) handleError(err error) error {
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с очередью сообщений")
+}
@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type ratePlanRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRatePlanRepository(db *pgxpool.Pool) ports.RatePlanRepository {
+	return &ratePlanRepository{db: db}
+}
+
+const ratePlanColumns = `
+	id, name, car_id, car_category_id, short_daily_rate, medium_daily_rate, long_daily_rate,
+	weekend_surcharge_percent, chauffeur_daily_rate, created_at, updated_at
+`
+
+func scanRatePlan(row pgx.Row, plan *entities.RatePlan) error {
+	return row.Scan(
+		&plan.ID,
+		&plan.Name,
+		&plan.CarID,
+		&plan.CategoryID,
+		&plan.ShortDailyRate,
+		&plan.MediumDailyRate,
+		&plan.LongDailyRate,
+		&plan.WeekendSurchargePercent,
+		&plan.ChauffeurDailyRate,
+		&plan.CreatedAt,
+		&plan.UpdatedAt,
+	)
+}
+
+func (r *ratePlanRepository) CreateRatePlan(ctx context.Context, plan *entities.RatePlan) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO rate_plans (
+			name, car_id, car_category_id, short_daily_rate, medium_daily_rate, long_daily_rate,
+			weekend_surcharge_percent, chauffeur_daily_rate, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+	err = tx.QueryRow(ctx, query,
+		plan.Name,
+		plan.CarID,
+		plan.CategoryID,
+		plan.ShortDailyRate,
+		plan.MediumDailyRate,
+		plan.LongDailyRate,
+		plan.WeekendSurchargePercent,
+		plan.ChauffeurDailyRate,
+		plan.CreatedAt,
+		plan.UpdatedAt,
+	).Scan(&plan.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+
+	if err := r.insertSeasons(ctx, tx, plan); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *ratePlanRepository) GetRatePlanByID(ctx context.Context, id int64) (*entities.RatePlan, error) {
+	query := `SELECT ` + ratePlanColumns + ` FROM rate_plans WHERE id = $1`
+
+	plan := &entities.RatePlan{}
+	if err := scanRatePlan(r.db.QueryRow(ctx, query, id), plan); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	seasons, err := r.getSeasons(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan.Seasons = seasons
+
+	return plan, nil
+}
+
+func (r *ratePlanRepository) ListRatePlans(ctx context.Context, offset, limit int64) (int64, []*entities.RatePlan, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM rate_plans`).Scan(&total); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	query := `SELECT ` + ratePlanColumns + ` FROM rate_plans ORDER BY id LIMIT $1 OFFSET $2`
+	rows, err := r.db.Query(ctx, query, limit, offset)
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	plans := make([]*entities.RatePlan, 0)
+	for rows.Next() {
+		plan := &entities.RatePlan{}
+		if err := scanRatePlan(rows, plan); err != nil {
+			return 0, nil, r.handleError(err)
+		}
+		plans = append(plans, plan)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	for _, plan := range plans {
+		seasons, err := r.getSeasons(ctx, plan.ID)
+		if err != nil {
+			return 0, nil, err
+		}
+		plan.Seasons = seasons
+	}
+
+	return total, plans, nil
+}
+
+func (r *ratePlanRepository) UpdateRatePlan(ctx context.Context, plan *entities.RatePlan) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE rate_plans
+		SET name = $1,
+			short_daily_rate = $2,
+			medium_daily_rate = $3,
+			long_daily_rate = $4,
+			weekend_surcharge_percent = $5,
+			chauffeur_daily_rate = $6,
+			updated_at = $7
+		WHERE id = $8
+	`
+	result, err := tx.Exec(ctx, query,
+		plan.Name,
+		plan.ShortDailyRate,
+		plan.MediumDailyRate,
+		plan.LongDailyRate,
+		plan.WeekendSurchargePercent,
+		plan.ChauffeurDailyRate,
+		plan.UpdatedAt,
+		plan.ID,
+	)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrRatePlanNotFound
+	}
+
+	// Seasons are owned by the plan and replaced as a whole
+	if _, err := tx.Exec(ctx, `DELETE FROM seasonal_rates WHERE rate_plan_id = $1`, plan.ID); err != nil {
+		return r.handleError(err)
+	}
+	if err := r.insertSeasons(ctx, tx, plan); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *ratePlanRepository) DeleteRatePlan(ctx context.Context, id int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM rate_plans WHERE id = $1`, id)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrRatePlanNotFound
+	}
+	return nil
+}
+
+func (r *ratePlanRepository) FindRatePlanForCar(ctx context.Context, carID, categoryID int64) (*entities.RatePlan, error) {
+	query := `
+		SELECT ` + ratePlanColumns + `
+		FROM rate_plans
+		WHERE car_id = $1 OR car_category_id = $2
+		ORDER BY car_id IS NULL
+		LIMIT 1
+	`
+	plan := &entities.RatePlan{}
+	if err := scanRatePlan(r.db.QueryRow(ctx, query, carID, categoryID), plan); err != nil {
+		return nil, r.handleError(err)
+	}
+
+	seasons, err := r.getSeasons(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan.Seasons = seasons
+
+	return plan, nil
+}
+
+func (r *ratePlanRepository) insertSeasons(ctx context.Context, tx pgx.Tx, plan *entities.RatePlan) error {
+	query := `
+		INSERT INTO seasonal_rates (rate_plan_id, name, start_date, end_date, surcharge_percent)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	for _, season := range plan.Seasons {
+		err := tx.QueryRow(ctx, query,
+			plan.ID,
+			season.Name,
+			season.StartDate,
+			season.EndDate,
+			season.SurchargePercent,
+		).Scan(&season.ID)
+		if err != nil {
+			return r.handleError(err)
+		}
+	}
+	return nil
+}
+
+func (r *ratePlanRepository) getSeasons(ctx context.Context, planID int64) ([]*entities.SeasonalRate, error) {
+	query := `
+		SELECT id, name, start_date, end_date, surcharge_percent
+		FROM seasonal_rates
+		WHERE rate_plan_id = $1
+		ORDER BY start_date
+	`
+	rows, err := r.db.Query(ctx, query, planID)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	seasons := make([]*entities.SeasonalRate, 0)
+	for rows.Next() {
+		season := &entities.SeasonalRate{}
+		if err := rows.Scan(
+			&season.ID,
+			&season.Name,
+			&season.StartDate,
+			&season.EndDate,
+			&season.SurchargePercent,
+		); err != nil {
+			return nil, r.handleError(err)
+		}
+		seasons = append(seasons, season)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.handleError(err)
+	}
+	return seasons, nil
+}
+
+func (r *ratePlanRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrRatePlanNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeConflict, "Тариф для этого автомобиля или категории уже существует")
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeNotFound, "Автомобиль или категория не найдены")
+		case "23514": // check_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeValidation, "Неверные параметры тарифа")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
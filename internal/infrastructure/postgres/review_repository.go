@@ -0,0 +1,308 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type reviewRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewReviewRepository(db *pgxpool.Pool) ports.ReviewRepository {
+	return &reviewRepository{db: db}
+}
+
+const reviewColumns = `
+	id, booking_id, car_id, driver_id, author_name, rating, driver_rating, text, photos,
+	status, rejection_reason, moderated_by, moderated_at, created_at, updated_at
+`
+
+func scanReview(row pgx.Row, review *entities.Review) error {
+	if err := row.Scan(
+		&review.ID,
+		&review.BookingID,
+		&review.CarID,
+		&review.DriverID,
+		&review.AuthorName,
+		&review.Rating,
+		&review.DriverRating,
+		&review.Text,
+		&review.Photos,
+		&review.Status,
+		&review.RejectionReason,
+		&review.ModeratedBy,
+		&review.ModeratedAt,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if review.Photos == nil {
+		review.Photos = make([]entities.ReviewPhoto, 0)
+	}
+	return nil
+}
+
+func (r *reviewRepository) CreateReview(ctx context.Context, review *entities.Review) error {
+	photos := review.Photos
+	if photos == nil {
+		photos = make([]entities.ReviewPhoto, 0)
+	}
+	for i := range photos {
+		photos[i].Variants = nonNilVariants(photos[i].Variants)
+	}
+
+	query := `
+		INSERT INTO reviews (booking_id, car_id, driver_id, author_name, rating, driver_rating, text, photos, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id
+	`
+	err := r.db.QueryRow(ctx, query,
+		review.BookingID,
+		review.CarID,
+		review.DriverID,
+		review.AuthorName,
+		review.Rating,
+		review.DriverRating,
+		review.Text,
+		photos,
+		review.Status,
+		review.CreatedAt,
+		review.UpdatedAt,
+	).Scan(&review.ID)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *reviewRepository) GetReviewByID(ctx context.Context, id int64) (*entities.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM reviews WHERE id = $1`
+
+	review := &entities.Review{}
+	if err := scanReview(r.db.QueryRow(ctx, query, id), review); err != nil {
+		return nil, r.handleError(err)
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) GetReviewByBookingID(ctx context.Context, bookingID int64) (*entities.Review, error) {
+	query := `SELECT ` + reviewColumns + ` FROM reviews WHERE booking_id = $1`
+
+	review := &entities.Review{}
+	if err := scanReview(r.db.QueryRow(ctx, query, bookingID), review); err != nil {
+		return nil, r.handleError(err)
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) ListReviews(ctx context.Context, offset, limit int64, filter ports.ReviewFilter) (int64, []*entities.Review, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	conditions := []string{"1=1"}
+	args := []any{}
+	argPos := 1
+
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argPos))
+		args = append(args, filter.Status)
+		argPos++
+	}
+	if filter.CarID != 0 {
+		conditions = append(conditions, fmt.Sprintf("car_id = $%d", argPos))
+		args = append(args, filter.CarID)
+		argPos++
+	}
+	if filter.DriverID != 0 {
+		conditions = append(conditions, fmt.Sprintf("driver_id = $%d", argPos))
+		args = append(args, filter.DriverID)
+		argPos++
+	}
+
+	whereSQL := strings.Join(conditions, " AND ")
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM reviews WHERE %s`, whereSQL)
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	if total == 0 {
+		return 0, []*entities.Review{}, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM reviews
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, reviewColumns, whereSQL, argPos, argPos+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return 0, nil, r.handleError(err)
+	}
+	defer rows.Close()
+
+	reviews := make([]*entities.Review, 0)
+	for rows.Next() {
+		review := &entities.Review{}
+		if err := scanReview(rows, review); err != nil {
+			return 0, nil, r.handleError(err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, nil, r.handleError(err)
+	}
+
+	return total, reviews, nil
+}
+
+func (r *reviewRepository) UpdateReviewModeration(ctx context.Context, review *entities.Review, fromStatus entities.ReviewStatus) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Matching on the previous status makes a concurrent moderation lose
+	// instead of silently overwriting the other one
+	query := `
+		UPDATE reviews
+		SET status = $1, rejection_reason = $2, moderated_by = $3, moderated_at = $4, updated_at = $5
+		WHERE id = $6 AND status = $7
+	`
+	result, err := tx.Exec(ctx, query,
+		review.Status,
+		review.RejectionReason,
+		review.ModeratedBy,
+		review.ModeratedAt,
+		review.UpdatedAt,
+		review.ID,
+		fromStatus,
+	)
+	if err != nil {
+		return r.handleError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.New(apperrors.ErrCodeConflict, "Отзыв уже промодерирован")
+	}
+
+	if err := refreshRatings(ctx, tx, review.CarID, review.DriverID); err != nil {
+		return r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *reviewRepository) DeleteReview(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		carID    int64
+		driverID *int64
+	)
+	query := `DELETE FROM reviews WHERE id = $1 RETURNING car_id, driver_id`
+	if err := tx.QueryRow(ctx, query, id).Scan(&carID, &driverID); err != nil {
+		return r.handleError(err)
+	}
+
+	if err := refreshRatings(ctx, tx, carID, driverID); err != nil {
+		return r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+// refreshRatings recomputes the stored rating of the car and, when set, the
+// driver from their approved reviews. Recomputing instead of adjusting the
+// counters keeps them correct whichever way a review moves.
+//
+// The rows are locked first: under READ COMMITTED an UPDATE waiting on the
+// row lock would still aggregate reviews from its own, older snapshot, while
+// the statements after the lock see every committed moderation.
+func refreshRatings(ctx context.Context, tx pgx.Tx, carID int64, driverID *int64) error {
+	if _, err := tx.Exec(ctx, `SELECT id FROM cars WHERE id = $1 FOR UPDATE`, carID); err != nil {
+		return err
+	}
+	if driverID != nil {
+		if _, err := tx.Exec(ctx, `SELECT id FROM drivers WHERE id = $1 FOR UPDATE`, *driverID); err != nil {
+			return err
+		}
+	}
+
+	carQuery := `
+		UPDATE cars SET (rating_average, review_count) = (
+			SELECT COALESCE(ROUND(AVG(rating), 2), 0), COUNT(*)
+			FROM reviews
+			WHERE car_id = $1 AND status = 'approved'
+		)
+		WHERE id = $1
+	`
+	if _, err := tx.Exec(ctx, carQuery, carID); err != nil {
+		return err
+	}
+
+	if driverID == nil {
+		return nil
+	}
+
+	driverQuery := `
+		UPDATE drivers SET (rating_average, review_count) = (
+			SELECT COALESCE(ROUND(AVG(driver_rating), 2), 0), COUNT(*)
+			FROM reviews
+			WHERE driver_id = $1 AND status = 'approved' AND driver_rating IS NOT NULL
+		)
+		WHERE id = $1
+	`
+	_, err := tx.Exec(ctx, driverQuery, *driverID)
+	return err
+}
+
+func (r *reviewRepository) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrReviewNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return apperrors.ErrReviewAlreadyExists
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "Связанная запись не найдена")
+		case "23514": // check_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeValidation, "Неверные данные отзыва")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type SessionRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepositoryImpl(db *pgxpool.Pool) ports.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+const insertSessionQuery = `
+	INSERT INTO user_sessions (jti, family_id, user_id, user_agent, ip_address, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, created_at, updated_at
+`
+
+func (r *SessionRepositoryImpl) CreateSession(ctx context.Context, session *entities.Session) error {
+	err := r.db.QueryRow(ctx, insertSessionQuery,
+		session.JTI,
+		session.FamilyID,
+		session.UserID,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+	).Scan(&session.ID, &session.CreatedAt, &session.UpdatedAt)
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *SessionRepositoryImpl) GetSessionByJTI(ctx context.Context, jti string) (*entities.Session, error) {
+	query := `
+		SELECT id, jti, family_id, user_id, user_agent, ip_address, replaced_by, revoked_at, expires_at, created_at, updated_at
+		FROM user_sessions
+		WHERE jti = $1
+	`
+	var session entities.Session
+	err := r.db.QueryRow(ctx, query, jti).Scan(
+		&session.ID,
+		&session.JTI,
+		&session.FamilyID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.ReplacedBy,
+		&session.RevokedAt,
+		&session.ExpiresAt,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		return nil, r.handleError(err)
+	}
+	return &session, nil
+}
+
+func (r *SessionRepositoryImpl) RotateSession(ctx context.Context, currentJTI string, next *entities.Session) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, r.handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	revokeQuery := `
+		UPDATE user_sessions
+		SET revoked_at = NOW(), replaced_by = $2, updated_at = NOW()
+		WHERE jti = $1 AND revoked_at IS NULL
+	`
+	tag, err := tx.Exec(ctx, revokeQuery, currentJTI, next.JTI)
+	if err != nil {
+		return false, r.handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	err = tx.QueryRow(ctx, insertSessionQuery,
+		next.JTI,
+		next.FamilyID,
+		next.UserID,
+		next.UserAgent,
+		next.IPAddress,
+		next.ExpiresAt,
+	).Scan(&next.ID, &next.CreatedAt, &next.UpdatedAt)
+	if err != nil {
+		return false, r.handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, r.handleError(err)
+	}
+	return true, nil
+}
+
+func (r *SessionRepositoryImpl) RevokeSession(ctx context.Context, jti string) error {
+	query := `
+		UPDATE user_sessions
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE jti = $1 AND revoked_at IS NULL
+	`
+	if _, err := r.db.Exec(ctx, query, jti); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *SessionRepositoryImpl) RevokeSessionFamily(ctx context.Context, familyID string) error {
+	query := `
+		UPDATE user_sessions
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+	if _, err := r.db.Exec(ctx, query, familyID); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *SessionRepositoryImpl) RevokeUserSessions(ctx context.Context, userID int64) error {
+	query := `
+		UPDATE user_sessions
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	if _, err := conn(ctx, r.db).Exec(ctx, query, userID); err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
+func (r *SessionRepositoryImpl) handleError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrSessionNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeConflict, "Сессия уже существует")
+		case "23503": // foreign_key_violation
+			return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Нарушение внешнего ключа")
+		}
+	}
+
+	return apperrors.Wrap(err, apperrors.ErrCodeDatabase, "Ошибка при работе с базой данных")
+}
@@ -25,7 +25,7 @@ func (r *UserRepositoryImpl) ConfirmEmailVerification(ctx context.Context, email
 		UPDATE users
 		SET is_verified = TRUE, updated_at = NOW()
 		WHERE email = $1
-		RETURNING id, email, password_hash, is_verified, created_at, updated_at
+		RETURNING id, email, password_hash, is_verified, role, password_changed_at, created_at, updated_at
 	`
 	var user entities.User
 	err := r.db.QueryRow(ctx, query, email).Scan(
@@ -33,6 +33,8 @@ func (r *UserRepositoryImpl) ConfirmEmailVerification(ctx context.Context, email
 		&user.Email,
 		&user.PasswordHash,
 		&user.IsVerified,
+		&user.Role,
+		&user.PasswordChangedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -46,10 +48,10 @@ func (r *UserRepositoryImpl) CreateUser(ctx context.Context, email, passwordHash
 	query := `
 		INSERT INTO users (email, password_hash, is_verified)
 		VALUES ($1, $2, $3)
-		RETURNING id, email, password_hash, is_verified, created_at, updated_at
+		RETURNING id, email, password_hash, is_verified, role, password_changed_at, created_at, updated_at
 	`
 	var user entities.User
-	err := r.db.QueryRow(ctx, query, email, passwordHash, false).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.IsVerified, &user.CreatedAt, &user.UpdatedAt)
+	err := r.db.QueryRow(ctx, query, email, passwordHash, false).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.IsVerified, &user.Role, &user.PasswordChangedAt, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		return nil, r.handleError(err)
 	}
@@ -58,7 +60,7 @@ func (r *UserRepositoryImpl) CreateUser(ctx context.Context, email, passwordHash
 
 func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (*entities.User, error) {
 	query := `
-		SELECT id, email, password_hash, is_verified, created_at, updated_at
+		SELECT id, email, password_hash, is_verified, role, password_changed_at, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -68,6 +70,8 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 		&user.Email,
 		&user.PasswordHash,
 		&user.IsVerified,
+		&user.Role,
+		&user.PasswordChangedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -79,14 +83,14 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 
 func (r *UserRepositoryImpl) GetUserById(ctx context.Context, id int64) (*entities.User, error) {
 	query := `
-		SELECT id, email, password_hash, is_verified, created_at, updated_at
+		SELECT id, email, password_hash, is_verified, role, password_changed_at, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
 	var user entities.User
-	err := r.db.QueryRow(ctx, query, id).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.IsVerified, &user.CreatedAt, &user.UpdatedAt)
+	err := r.db.QueryRow(ctx, query, id).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.IsVerified, &user.Role, &user.PasswordChangedAt, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
-		return nil, err
+		return nil, r.handleError(err)
 	}
 	return &user, nil
 }
@@ -94,14 +98,14 @@ func (r *UserRepositoryImpl) GetUserById(ctx context.Context, id int64) (*entiti
 func (r *UserRepositoryImpl) UpdateUser(ctx context.Context, user *entities.User) (*entities.User, error) {
 	query := `
 		UPDATE users
-		SET email = $1, password_hash = $2, updated_at = NOW()
-		WHERE id = $3
-		RETURNING id, email, password_hash, is_verified, created_at, updated_at
+		SET email = $1, password_hash = $2, role = $3, password_changed_at = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING id, email, password_hash, is_verified, role, password_changed_at, created_at, updated_at
 	`
 	var updatedUser entities.User
-	err := r.db.QueryRow(ctx, query, user.Email, user.PasswordHash, user.ID).Scan(&updatedUser.ID, &updatedUser.Email, &updatedUser.PasswordHash, &updatedUser.IsVerified, &updatedUser.CreatedAt, &updatedUser.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, user.Email, user.PasswordHash, user.Role, user.PasswordChangedAt, user.ID).Scan(&updatedUser.ID, &updatedUser.Email, &updatedUser.PasswordHash, &updatedUser.IsVerified, &updatedUser.Role, &updatedUser.PasswordChangedAt, &updatedUser.CreatedAt, &updatedUser.UpdatedAt)
 	if err != nil {
-		return nil, err
+		return nil, r.handleError(err)
 	}
 	return &updatedUser, nil
 }
@@ -27,9 +27,11 @@ func (r *VerifyCodeRepositoryImpl) GetVerifyCodeByEmailAndCodeAndType(ctx contex
 		FROM verify_codes vc
 		INNER JOIN users u ON vc.user_id = u.id
 		WHERE u.email = $1 AND vc.code = $2 AND vc.type = $3
+		ORDER BY vc.created_at DESC
+		LIMIT 1
 	`
 	var verifyCode entities.VerifyCode
-	err := r.db.QueryRow(ctx, query, email, code, verifyCodeType).Scan(
+	err := conn(ctx, r.db).QueryRow(ctx, query, email, code, verifyCodeType).Scan(
 		&verifyCode.ID,
 		&verifyCode.Code,
 		&verifyCode.UserID,
@@ -58,7 +60,7 @@ func (r *VerifyCodeRepositoryImpl) CreateVerifyCode(ctx context.Context, code st
 	RETURNING id, code, user_id, type, is_used, expires_at, created_at, updated_at;
 	`
 	var verifyCode entities.VerifyCode
-	err := r.db.QueryRow(ctx, query, code, userID, verifyCodeType, expiresAt).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, code, userID, verifyCodeType, expiresAt).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
 	if err != nil {
 		return nil, r.handleError(err)
 	}
@@ -72,7 +74,7 @@ func (r *VerifyCodeRepositoryImpl) GetVerifyCodeByUserIDAndType(ctx context.Cont
 		WHERE user_id = $1 AND type = $2
 	`
 	var verifyCode entities.VerifyCode
-	err := r.db.QueryRow(ctx, query, userID, verifyCodeType).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, userID, verifyCodeType).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
 	if err != nil {
 		return nil, r.handleError(err)
 	}
@@ -88,7 +90,7 @@ func (r *VerifyCodeRepositoryImpl) GetVerifyCodeByCodeAndType(ctx context.Contex
 		LIMIT 1
 	`
 	var verifyCode entities.VerifyCode
-	err := r.db.QueryRow(ctx, query, code, verifyCodeType).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, code, verifyCodeType).Scan(&verifyCode.ID, &verifyCode.Code, &verifyCode.UserID, &verifyCode.Type, &verifyCode.IsUsed, &verifyCode.ExpiresAt, &verifyCode.CreatedAt, &verifyCode.UpdatedAt)
 	if err != nil {
 		return nil, r.handleError(err)
 	}
@@ -102,7 +104,7 @@ func (r *VerifyCodeRepositoryImpl) UpdateVerifyCode(ctx context.Context, verifyC
 		WHERE id = $4
 		RETURNING id, code, user_id, type, is_used, expires_at, created_at, updated_at
 	`
-	err := r.db.QueryRow(ctx, query, verifyCode.IsUsed, verifyCode.ExpiresAt, verifyCode.Code, verifyCode.ID).Scan(
+	err := conn(ctx, r.db).QueryRow(ctx, query, verifyCode.IsUsed, verifyCode.ExpiresAt, verifyCode.Code, verifyCode.ID).Scan(
 		&verifyCode.ID,
 		&verifyCode.Code,
 		&verifyCode.UserID,
@@ -118,6 +120,25 @@ func (r *VerifyCodeRepositoryImpl) UpdateVerifyCode(ctx context.Context, verifyC
 	return verifyCode, nil
 }
 
+func (r *VerifyCodeRepositoryImpl) UseVerifyCode(ctx context.Context, verifyCode *entities.VerifyCode) error {
+	// Matching on is_used and the code lets only one of concurrent requests
+	// spend it, and none once a new code replaced it
+	query := `
+		UPDATE verify_codes
+		SET is_used = TRUE, updated_at = NOW()
+		WHERE id = $1 AND code = $2 AND is_used = FALSE
+		RETURNING is_used, updated_at
+	`
+	err := conn(ctx, r.db).QueryRow(ctx, query, verifyCode.ID, verifyCode.Code).Scan(&verifyCode.IsUsed, &verifyCode.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.ErrVerifyCodeAlreadyUsed
+	}
+	if err != nil {
+		return r.handleError(err)
+	}
+	return nil
+}
+
 func (r *VerifyCodeRepositoryImpl) handleError(err error) error {
 	if errors.Is(err, pgx.ErrNoRows) {
 		return apperrors.ErrVerifyCodeNotFound
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+)
+
+// MemoryRateLimiter is a sliding-window limiter kept in process memory.
+// Counters are per instance, so with several replicas the effective limit is
+// multiplied by the replica count.
+type MemoryRateLimiter struct {
+	mu        sync.Mutex
+	hits      map[string][]time.Time
+	lastSweep time.Time
+}
+
+func NewMemoryRateLimiter() ports.RateLimiter {
+	return &MemoryRateLimiter{
+		hits:      make(map[string][]time.Time),
+		lastSweep: time.Now(),
+	}
+}
+
+func (l *MemoryRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	if limit <= 0 {
+		return true, nil
+	}
+
+	now := time.Now()
+	cutoff := now.Add(-window)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	hits := prune(l.hits[key], cutoff)
+	if len(hits) >= limit {
+		l.hits[key] = hits
+		return false, nil
+	}
+	l.hits[key] = append(hits, now)
+
+	// Drop idle keys from time to time so the map does not grow unbounded
+	if now.Sub(l.lastSweep) > window {
+		for k, v := range l.hits {
+			if rest := prune(v, cutoff); len(rest) == 0 {
+				delete(l.hits, k)
+			} else {
+				l.hits[k] = rest
+			}
+		}
+		l.lastSweep = now
+	}
+
+	return true, nil
+}
+
+func prune(hits []time.Time, cutoff time.Time) []time.Time {
+	i := 0
+	for i < len(hits) && !hits[i].After(cutoff) {
+		i++
+	}
+	return hits[i:]
+}
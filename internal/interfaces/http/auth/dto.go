@@ -15,6 +15,7 @@ type SignUpResponse struct {
 	ID         int64     `json:"id" example:"123"`
 	Email      string    `json:"email" example:"user@example.com"`
 	IsVerified bool      `json:"is_verified" example:"false"`
+	Role       string    `json:"role" example:"customer"`
 	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
 	UpdatedAt  time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
 }
@@ -24,6 +25,7 @@ func ToSignUpResponse(user *entities.User) SignUpResponse {
 		ID:         user.ID,
 		Email:      user.Email,
 		IsVerified: user.IsVerified,
+		Role:       string(user.Role),
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
 	}
@@ -70,3 +72,53 @@ type RefreshResponse struct {
 	AccessToken  string `json:"access_token" example:"<access_token>"`
 	RefreshToken string `json:"refresh_token,omitempty" example:"<refresh_token>"`
 }
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"<refresh_token>"`
+}
+
+type LogoutResponse struct {
+	Message string `json:"message" example:"Сессия завершена"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email" example:"user@example.com"`
+}
+
+type ForgotPasswordResponse struct {
+	Message string `json:"message" example:"Если email зарегистрирован, код сброса пароля отправлен"`
+}
+
+type ResetPasswordRequest struct {
+	Email       string `json:"email" binding:"required,email" example:"user@example.com"`
+	Code        string `json:"code" binding:"required" example:"123456"`
+	NewPassword string `json:"new_password" binding:"required,min=8" example:"newpassword123"`
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message" example:"Пароль успешно изменен"`
+}
+
+type ChangeUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin manager customer" example:"manager"`
+}
+
+type UserResponse struct {
+	ID         int64     `json:"id" example:"123"`
+	Email      string    `json:"email" example:"user@example.com"`
+	IsVerified bool      `json:"is_verified" example:"true"`
+	Role       string    `json:"role" example:"manager"`
+	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt  time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+func ToUserResponse(user *entities.User) UserResponse {
+	return UserResponse{
+		ID:         user.ID,
+		Email:      user.Email,
+		IsVerified: user.IsVerified,
+		Role:       string(user.Role),
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
+	}
+}
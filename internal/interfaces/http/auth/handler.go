@@ -2,9 +2,12 @@ package auth
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/auth"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 	"github.com/nomad-pixel/imperial/pkg/errors"
 )
 
@@ -14,6 +17,11 @@ type AuthHandler struct {
 	confirmEmailVerificationUsecase usecasePorts.ConfirmEmailVerificationUsecase
 	signInUsecase                   usecasePorts.SignInUsecase
 	refreshTokenUsecase             usecasePorts.RefreshTokenUsecase
+	forgotPasswordUsecase           usecasePorts.ForgotPasswordUsecase
+	resetPasswordUsecase            usecasePorts.ResetPasswordUsecase
+	logoutUsecase                   usecasePorts.LogoutUsecase
+	logoutAllUsecase                usecasePorts.LogoutAllUsecase
+	changeUserRoleUsecase           usecasePorts.ChangeUserRoleUsecase
 }
 
 func NewAuthHandler(
@@ -22,6 +30,11 @@ func NewAuthHandler(
 	confirmEmailVerificationUsecase usecasePorts.ConfirmEmailVerificationUsecase,
 	signInUsecase usecasePorts.SignInUsecase,
 	refreshTokenUsecase usecasePorts.RefreshTokenUsecase,
+	forgotPasswordUsecase usecasePorts.ForgotPasswordUsecase,
+	resetPasswordUsecase usecasePorts.ResetPasswordUsecase,
+	logoutUsecase usecasePorts.LogoutUsecase,
+	logoutAllUsecase usecasePorts.LogoutAllUsecase,
+	changeUserRoleUsecase usecasePorts.ChangeUserRoleUsecase,
 ) *AuthHandler {
 	return &AuthHandler{
 		signUpUsecase:                   signUpUsecase,
@@ -29,6 +42,11 @@ func NewAuthHandler(
 		confirmEmailVerificationUsecase: confirmEmailVerificationUsecase,
 		signInUsecase:                   signInUsecase,
 		refreshTokenUsecase:             refreshTokenUsecase,
+		forgotPasswordUsecase:           forgotPasswordUsecase,
+		resetPasswordUsecase:            resetPasswordUsecase,
+		logoutUsecase:                   logoutUsecase,
+		logoutAllUsecase:                logoutAllUsecase,
+		changeUserRoleUsecase:           changeUserRoleUsecase,
 	}
 }
 
@@ -134,7 +152,7 @@ func (h *AuthHandler) SignIn(c *gin.Context) {
 		return
 	}
 
-	user, tokens, err := h.signInUsecase.Execute(c.Request.Context(), req.Email, req.Password)
+	user, tokens, err := h.signInUsecase.Execute(c.Request.Context(), req.Email, req.Password, c.Request.UserAgent(), c.ClientIP())
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -150,7 +168,7 @@ func (h *AuthHandler) SignIn(c *gin.Context) {
 
 // RefreshToken godoc
 // @Summary      Обновление access токена
-// @Description  Обновляет access токен с помощью refresh токена
+// @Description  Обновляет пару токенов с помощью refresh токена. Старый refresh токен становится недействительным, повторное его использование завершает все сессии этого входа
 // @Tags         Auth
 // @Accept       json
 // @Produce      json
@@ -164,12 +182,159 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	newAccess, err := h.refreshTokenUsecase.Execute(c.Request.Context(), req.RefreshToken)
+	tokens, err := h.refreshTokenUsecase.Execute(c.Request.Context(), req.RefreshToken, c.Request.UserAgent(), c.ClientIP())
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	resp := RefreshResponse{AccessToken: newAccess}
+	resp := RefreshResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// ForgotPassword godoc
+// @Summary      Запрос сброса пароля
+// @Description  Отправляет код сброса пароля на указанный email
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body ForgotPasswordRequest true "Email для отправки кода сброса пароля"
+// @Success      200 {object} ForgotPasswordResponse "Код сброса пароля отправлен"
+// @Failure      429 {object} map[string]string "Слишком много запросов"
+// @Router       /v1/auth/forgot-password [post]
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	if err := h.forgotPasswordUsecase.Execute(c.Request.Context(), req.Email, c.ClientIP()); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ForgotPasswordResponse{
+		Message: "Если email зарегистрирован, код сброса пароля отправлен",
+	})
+}
+
+// ResetPassword godoc
+// @Summary      Сброс пароля
+// @Description  Устанавливает новый пароль по коду из email и завершает все активные сессии
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body ResetPasswordRequest true "Код сброса и новый пароль"
+// @Success      200 {object} ResetPasswordResponse "Пароль успешно изменен"
+// @Router       /v1/auth/reset-password [post]
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	err := h.resetPasswordUsecase.Execute(c.Request.Context(), req.Email, req.Code, req.NewPassword)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ResetPasswordResponse{
+		Message: "Пароль успешно изменен",
+	})
+}
+
+// Logout godoc
+// @Summary      Выход из текущей сессии
+// @Description  Отзывает переданный refresh токен. Access токен остается действительным до истечения срока
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body LogoutRequest true "Refresh токен текущей сессии"
+// @Success      200 {object} LogoutResponse "Сессия завершена"
+// @Router       /v1/auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req LogoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	if err := h.logoutUsecase.Execute(c.Request.Context(), req.RefreshToken); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, LogoutResponse{
+		Message: "Сессия завершена",
+	})
+}
+
+// LogoutAll godoc
+// @Summary      Выход со всех устройств
+// @Description  Отзывает все refresh токены текущего пользователя
+// @Tags         Auth
+// @Produce      json
+// @Success      200 {object} LogoutResponse "Все сессии завершены"
+// @Router       /v1/auth/logout-all [post]
+// @Security     BearerAuth
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	userID := c.GetInt64(middleware.ContextUserIDKey)
+	if userID == 0 {
+		_ = c.Error(errors.ErrUnauthorized)
+		return
+	}
+
+	if err := h.logoutAllUsecase.Execute(c.Request.Context(), userID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, LogoutResponse{
+		Message: "Все сессии завершены",
+	})
+}
+
+// ChangeUserRole godoc
+// @Summary      Изменение роли пользователя
+// @Description  Доступно только администратору. Свою роль изменить нельзя. Сессии пользователя отзываются, новая роль действует после повторного входа
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ID пользователя"
+// @Param        request body ChangeUserRoleRequest true "Новая роль"
+// @Success      200 {object} UserResponse "Роль изменена"
+// @Router       /v1/users/{id}/role [patch]
+// @Security     BearerAuth
+func (h *AuthHandler) ChangeUserRole(c *gin.Context) {
+	actorID := c.GetInt64(middleware.ContextUserIDKey)
+	if actorID == 0 {
+		_ = c.Error(errors.ErrUnauthorized)
+		return
+	}
+
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный ID пользователя"))
+		return
+	}
+
+	var req ChangeUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	user, err := h.changeUserRoleUsecase.Execute(c.Request.Context(), actorID, userID, entities.UserRole(req.Role))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToUserResponse(user))
+}
@@ -1,8 +1,13 @@
 package auth
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+)
 
-func RegisterRoutes(router gin.IRouter, handler *AuthHandler) {
+func RegisterRoutes(router gin.IRouter, handler *AuthHandler, tokenSvc ports.TokenService) {
 	api := router.Group("/v1/auth")
 	{
 		api.POST("/sign-up", handler.SignUp)
@@ -10,5 +15,19 @@ func RegisterRoutes(router gin.IRouter, handler *AuthHandler) {
 		api.POST("/confirm-email", handler.ConfirmEmail)
 		api.POST("/sign-in", handler.SignIn)
 		api.POST("/refresh-token", handler.RefreshToken)
+		api.POST("/forgot-password", handler.ForgotPassword)
+		api.POST("/reset-password", handler.ResetPassword)
+		api.POST("/logout", handler.Logout)
+		api.POST("/logout-all", middleware.AuthMiddleware(tokenSvc), handler.LogoutAll)
+	}
+
+	// The first admin is created with cmd/set-role
+	users := router.Group("/v1/users")
+	users.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin),
+	)
+	{
+		users.PATCH("/:id/role", handler.ChangeUserRole)
 	}
 }
@@ -0,0 +1,27 @@
+package booking
+
+import "time"
+
+type CreateBookingRequest struct {
+	CarID         int64     `json:"car_id" binding:"required"`
+	DriverID      *int64    `json:"driver_id"`
+	LeadID        *int64    `json:"lead_id"`
+	CustomerName  string    `json:"customer_name"`
+	CustomerPhone string    `json:"customer_phone"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	Comment       string    `json:"comment"`
+}
+
+type UpdateBookingStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending confirmed active completed cancelled" example:"confirmed"`
+}
+
+type AssignBookingDriverRequest struct {
+	DriverID *int64 `json:"driver_id" example:"3"`
+}
+
+type ListBookingsResponse struct {
+	Total int64       `json:"total"`
+	Data  interface{} `json:"data"`
+}
@@ -0,0 +1,213 @@
+package booking
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/booking"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type BookingHandler struct {
+	createBookingUsecase       usecasePorts.CreateBookingUsecase
+	getBookingByIdUsecase      usecasePorts.GetBookingByIdUsecase
+	listBookingsUsecase        usecasePorts.ListBookingsUsecase
+	updateBookingStatusUsecase usecasePorts.UpdateBookingStatusUsecase
+	assignBookingDriverUsecase usecasePorts.AssignBookingDriverUsecase
+}
+
+func NewBookingHandler(
+	createBookingUsecase usecasePorts.CreateBookingUsecase,
+	getBookingByIdUsecase usecasePorts.GetBookingByIdUsecase,
+	listBookingsUsecase usecasePorts.ListBookingsUsecase,
+	updateBookingStatusUsecase usecasePorts.UpdateBookingStatusUsecase,
+	assignBookingDriverUsecase usecasePorts.AssignBookingDriverUsecase,
+) *BookingHandler {
+	return &BookingHandler{
+		createBookingUsecase:       createBookingUsecase,
+		getBookingByIdUsecase:      getBookingByIdUsecase,
+		listBookingsUsecase:        listBookingsUsecase,
+		updateBookingStatusUsecase: updateBookingStatusUsecase,
+		assignBookingDriverUsecase: assignBookingDriverUsecase,
+	}
+}
+
+// CreateBooking godoc
+// @Summary Create a new booking
+// @Description Reserve a car for a period. Customer fields and dates default to the referenced lead. Returns 409 if the car is already booked for an overlapping period
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param booking body CreateBookingRequest true "Booking data"
+// @Success 201 {object} entities.Booking
+// @Router /v1/bookings [post]
+// @Security     BearerAuth
+func (h *BookingHandler) CreateBooking(c *gin.Context) {
+	var req CreateBookingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	booking, err := h.createBookingUsecase.Execute(c.Request.Context(), usecasePorts.CreateBookingInput{
+		CarID:         req.CarID,
+		DriverID:      req.DriverID,
+		LeadID:        req.LeadID,
+		CustomerName:  req.CustomerName,
+		CustomerPhone: req.CustomerPhone,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		Comment:       req.Comment,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, booking)
+}
+
+// GetBookingByID godoc
+// @Summary Get booking by ID
+// @Description Get detailed information about a booking by ID
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} entities.Booking
+// @Router /v1/bookings/{id} [get]
+// @Security     BearerAuth
+func (h *BookingHandler) GetBookingByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid booking ID"))
+		return
+	}
+
+	booking, err := h.getBookingByIdUsecase.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, booking)
+}
+
+// ListBookings godoc
+// @Summary List bookings
+// @Description Get a paginated list of bookings, optionally filtered by status, car, driver or lead
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param offset query int false "Offset for pagination" default(0)
+// @Param limit query int false "Limit for pagination" default(20)
+// @Param status query string false "Booking status" Enums(pending, confirmed, active, completed, cancelled)
+// @Param car_id query int false "Filter by car ID"
+// @Param driver_id query int false "Filter by driver ID"
+// @Param lead_id query int false "Filter by lead ID"
+// @Success 200 {object} ListBookingsResponse
+// @Router /v1/bookings [get]
+// @Security     BearerAuth
+func (h *BookingHandler) ListBookings(c *gin.Context) {
+	offset := int64(0)
+	limit := int64(20)
+
+	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil {
+		offset = o
+	}
+	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil {
+		limit = l
+	}
+
+	filter := ports.BookingFilter{
+		Status: entities.BookingStatus(c.Query("status")),
+	}
+	if v, err := strconv.ParseInt(c.Query("car_id"), 10, 64); err == nil {
+		filter.CarID = v
+	}
+	if v, err := strconv.ParseInt(c.Query("driver_id"), 10, 64); err == nil {
+		filter.DriverID = v
+	}
+	if v, err := strconv.ParseInt(c.Query("lead_id"), 10, 64); err == nil {
+		filter.LeadID = v
+	}
+
+	total, bookings, err := h.listBookingsUsecase.Execute(c.Request.Context(), offset, limit, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, ListBookingsResponse{
+		Total: total,
+		Data:  bookings,
+	})
+}
+
+// UpdateBookingStatus godoc
+// @Summary Change booking status
+// @Description Move a booking through its lifecycle: pending → confirmed → active → completed. Pending and confirmed bookings can be cancelled
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param status body UpdateBookingStatusRequest true "New status"
+// @Success 200 {object} entities.Booking
+// @Router /v1/bookings/{id}/status [patch]
+// @Security     BearerAuth
+func (h *BookingHandler) UpdateBookingStatus(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid booking ID"))
+		return
+	}
+
+	var req UpdateBookingStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	booking, err := h.updateBookingStatusUsecase.Execute(c.Request.Context(), id, entities.BookingStatus(req.Status))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, booking)
+}
+
+// AssignBookingDriver godoc
+// @Summary Assign a driver to a booking
+// @Description Set the chauffeur of a booking, or remove it with a null driver_id. Returns 409 if the driver is booked for an overlapping period, off duty or outside working hours
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param driver body AssignBookingDriverRequest true "Driver"
+// @Success 200 {object} entities.Booking
+// @Router /v1/bookings/{id}/driver [put]
+// @Security     BearerAuth
+func (h *BookingHandler) AssignBookingDriver(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid booking ID"))
+		return
+	}
+
+	var req AssignBookingDriverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	booking, err := h.assignBookingDriverUsecase.Execute(c.Request.Context(), id, req.DriverID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, booking)
+}
@@ -0,0 +1,23 @@
+package booking
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *BookingHandler, tokenSvc ports.TokenService) {
+	bookings := router.Group("/v1/bookings")
+	bookings.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin, entities.UserRoleManager),
+	)
+	{
+		bookings.POST("", handler.CreateBooking)
+		bookings.GET("", handler.ListBookings)
+		bookings.GET("/:id", handler.GetBookingByID)
+		bookings.PATCH("/:id/status", handler.UpdateBookingStatus)
+		bookings.PUT("/:id/driver", handler.AssignBookingDriver)
+	}
+}
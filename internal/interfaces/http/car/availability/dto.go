@@ -0,0 +1,9 @@
+package car
+
+import "time"
+
+type CreateMaintenanceBlockRequest struct {
+	StartDate time.Time `json:"start_date" binding:"required" example:"2026-10-10T00:00:00Z"`
+	EndDate   time.Time `json:"end_date" binding:"required" example:"2026-10-14T00:00:00Z"`
+	Reason    string    `json:"reason" example:"Плановое ТО"`
+}
@@ -0,0 +1,137 @@
+package car
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/car"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type CarAvailabilityHandler struct {
+	getCarCalendar         usecasePorts.GetCarCalendarUsecase
+	createMaintenanceBlock usecasePorts.CreateCarMaintenanceBlockUsecase
+	deleteMaintenanceBlock usecasePorts.DeleteCarMaintenanceBlockUsecase
+}
+
+func NewCarAvailabilityHandler(
+	getCarCalendar usecasePorts.GetCarCalendarUsecase,
+	createMaintenanceBlock usecasePorts.CreateCarMaintenanceBlockUsecase,
+	deleteMaintenanceBlock usecasePorts.DeleteCarMaintenanceBlockUsecase,
+) *CarAvailabilityHandler {
+	return &CarAvailabilityHandler{
+		getCarCalendar:         getCarCalendar,
+		createMaintenanceBlock: createMaintenanceBlock,
+		deleteMaintenanceBlock: deleteMaintenanceBlock,
+	}
+}
+
+// GetCarCalendar godoc
+// @Summary      Календарь занятости автомобиля
+// @Description  Возвращает занятые интервалы (бронирования и обслуживание) автомобиля за месяц
+// @Tags         Car availability
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        month query string false "Месяц в формате YYYY-MM, по умолчанию текущий"
+// @Success      200 {object}  entities.CarCalendar  "Календарь занятости"
+// @Security     BearerAuth
+// @Router       /v1/cars/{id}/calendar [get]
+func (h *CarAvailabilityHandler) GetCarCalendar(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+
+	month, err := ParseCalendarMonth(c.Query("month"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	calendar, err := h.getCarCalendar.Execute(c.Request.Context(), carID, month.Year(), month.Month())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
+
+// CreateMaintenanceBlock godoc
+// @Summary      Блокировка автомобиля на обслуживание
+// @Description  Делает автомобиль недоступным для бронирования на период [start_date, end_date)
+// @Tags         Car availability
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        request body CreateMaintenanceBlockRequest true "Период обслуживания"
+// @Success      201 {object}  entities.CarMaintenanceBlock  "Период обслуживания создан"
+// @Security     BearerAuth
+// @Router       /v1/cars/{id}/maintenance-blocks [post]
+func (h *CarAvailabilityHandler) CreateMaintenanceBlock(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+
+	var req CreateMaintenanceBlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	block, err := h.createMaintenanceBlock.Execute(c.Request.Context(), carID, req.StartDate, req.EndDate, req.Reason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, block)
+}
+
+// DeleteMaintenanceBlock godoc
+// @Summary      Снятие блокировки обслуживания
+// @Tags         Car availability
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        block_id path int true "ID периода обслуживания"
+// @Success      200 {object}  map[string]string  "Период обслуживания удален"
+// @Security     BearerAuth
+// @Router       /v1/cars/{id}/maintenance-blocks/{block_id} [delete]
+func (h *CarAvailabilityHandler) DeleteMaintenanceBlock(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+	blockID, err := strconv.ParseInt(c.Param("block_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID периода обслуживания"))
+		return
+	}
+
+	if err := h.deleteMaintenanceBlock.Execute(c.Request.Context(), carID, blockID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Период обслуживания успешно удален"})
+}
+
+// ParseCalendarMonth parses a YYYY-MM month, defaulting to the current one
+func ParseCalendarMonth(value string) (time.Time, error) {
+	if value == "" {
+		now := time.Now().UTC()
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, errors.ErrCodeValidation, "Месяц должен быть в формате YYYY-MM")
+	}
+	return month, nil
+}
@@ -0,0 +1,23 @@
+package car
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+)
+
+func RegisterRoutes(router gin.IRouter, handler *CarAvailabilityHandler, tokenSvc ports.TokenService) {
+	api := router.Group("/v1/cars/:id")
+	api.Use(middleware.AuthMiddleware(tokenSvc))
+	{
+		api.GET("/calendar", handler.GetCarCalendar)
+	}
+
+	// Admin-only endpoints
+	admin := api.Group("/maintenance-blocks", middleware.RequireRoles(entities.UserRoleAdmin))
+	{
+		admin.POST("", handler.CreateMaintenanceBlock)
+		admin.DELETE("/:block_id", handler.DeleteMaintenanceBlock)
+	}
+}
@@ -2,6 +2,7 @@ package car
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -13,8 +14,8 @@ func RegisterRoutes(router gin.IRouter, handler *CarCategoryHandler, tokenSvc po
 	api.GET("", handler.GetCarCategories)
 	api.GET("/:id", handler.GetCarCategory)
 
-	// Protected endpoints
-	api.Use(middleware.AuthMiddleware(tokenSvc))
+	// Admin-only endpoints
+	api.Use(middleware.AuthMiddleware(tokenSvc), middleware.RequireRoles(entities.UserRoleAdmin))
 	{
 		api.POST("", handler.CreateCarCategory)
 		api.PUT("/:id", handler.UpdateCarCategory)
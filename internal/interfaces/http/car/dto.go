@@ -9,6 +9,7 @@ type CreateCarRequest struct {
 	MarkId         int64   `json:"mark_id" binding:"required,min=1" example:"1"`
 	CategoryId     int64   `json:"category_id" binding:"required,min=1" example:"2"`
 	TagsIds        []int64 `json:"tags_ids" binding:"required"`
+	CarSpecsRequest
 }
 
 type UpdateCarRequest struct {
@@ -18,6 +19,45 @@ type UpdateCarRequest struct {
 	MarkId         int64   `json:"mark_id" binding:"required,min=1" example:"1"`
 	CategoryId     int64   `json:"category_id" binding:"required,min=1" example:"2"`
 	TagsIds        []int64 `json:"tags_ids" binding:"required"`
+	CarSpecsRequest
+}
+
+// CarSpecsRequest carries the optional technical specification of a car.
+// Omitted fields are stored as unknown.
+type CarSpecsRequest struct {
+	Year         *int64   `json:"year" example:"2023"`
+	Seats        *int64   `json:"seats" example:"5"`
+	Transmission *string  `json:"transmission" enums:"automatic,manual,robot,cvt" example:"automatic"`
+	FuelType     *string  `json:"fuel_type" enums:"petrol,diesel,hybrid,electric" example:"petrol"`
+	EngineVolume *float64 `json:"engine_volume" example:"4.0"`
+	Horsepower   *int64   `json:"horsepower" example:"585"`
+	Acceleration *float64 `json:"acceleration" example:"3.8"`
+	Color        *string  `json:"color" example:"black"`
+	DriveType    *string  `json:"drive_type" enums:"fwd,rwd,awd" example:"awd"`
+}
+
+func (r CarSpecsRequest) toSpecs() entities.CarSpecs {
+	specs := entities.CarSpecs{
+		Year:         r.Year,
+		Seats:        r.Seats,
+		EngineVolume: r.EngineVolume,
+		Horsepower:   r.Horsepower,
+		Acceleration: r.Acceleration,
+		Color:        r.Color,
+	}
+	if r.Transmission != nil {
+		v := entities.CarTransmission(*r.Transmission)
+		specs.Transmission = &v
+	}
+	if r.FuelType != nil {
+		v := entities.CarFuelType(*r.FuelType)
+		specs.FuelType = &v
+	}
+	if r.DriveType != nil {
+		v := entities.CarDriveType(*r.DriveType)
+		specs.DriveType = &v
+	}
+	return specs
 }
 
 type CarResponse = entities.Car
@@ -26,7 +66,13 @@ type MessageResponse struct {
 	Message string `json:"message"`
 }
 
+type SearchCarsResponse struct {
+	Total int64                       `json:"total"`
+	Data  []*entities.CarSearchResult `json:"data"`
+}
+
 type ListCarsResponse struct {
-	Total int64           `json:"total"`
-	Data  []*entities.Car `json:"data"`
+	Total  int64               `json:"total"`
+	Data   []*entities.Car     `json:"data"`
+	Facets *entities.CarFacets `json:"facets"`
 }
@@ -3,11 +3,14 @@ package car
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/car"
 	"github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
 )
 
 type CarHandler struct {
@@ -16,6 +19,8 @@ type CarHandler struct {
 	updateCar  usecasePorts.UpdateCarUsecase
 	getCarById usecasePorts.GetCarByIdUsecase
 	getCars    usecasePorts.GetListCarsUsecase
+	searchCars usecasePorts.SearchCarsUsecase
+	getFacets  usecasePorts.GetCarFacetsUsecase
 }
 
 func NewCarHandler(
@@ -24,6 +29,8 @@ func NewCarHandler(
 	updateCar usecasePorts.UpdateCarUsecase,
 	getCarById usecasePorts.GetCarByIdUsecase,
 	getCars usecasePorts.GetListCarsUsecase,
+	searchCars usecasePorts.SearchCarsUsecase,
+	getFacets usecasePorts.GetCarFacetsUsecase,
 ) *CarHandler {
 	return &CarHandler{
 		createCar:  createCar,
@@ -31,6 +38,8 @@ func NewCarHandler(
 		updateCar:  updateCar,
 		getCarById: getCarById,
 		getCars:    getCars,
+		searchCars: searchCars,
+		getFacets:  getFacets,
 	}
 }
 
@@ -51,18 +60,10 @@ func (h *CarHandler) CreateCar(c *gin.Context) {
 		return
 	}
 
-	car := &entities.Car{
-		Name:           req.Name,
-		OnlyWithDriver: req.OnlyWithDriver,
-		PricePerDay:    req.PricePerDay,
-		Mark: &entities.CarMark{
-			ID: req.MarkId,
-		},
-		Category: &entities.CarCategory{
-			ID: req.CategoryId,
-		},
-
-		Tags: make([]*entities.CarTag, 0, len(req.TagsIds)),
+	car, err := entities.NewCar(req.Name, req.PricePerDay, req.MarkId, req.CategoryId, req.OnlyWithDriver, req.toSpecs())
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeBadRequest, err.Error()))
+		return
 	}
 
 	for _, tagID := range req.TagsIds {
@@ -80,7 +81,7 @@ func (h *CarHandler) CreateCar(c *gin.Context) {
 
 // DeleteCar godoc
 // @Summary      Удаление автомобиля
-// @Description  Удаляет автомобиль по указанному ID
+// @Description  Удаляет автомобиль по указанному ID. Автомобиль с бронированиями удалить нельзя (409)
 // @Tags         Cars
 // @Accept       json
 // @Produce      json
@@ -143,13 +144,19 @@ func (h *CarHandler) UpdateCar(c *gin.Context) {
 		Category: &entities.CarCategory{
 			ID: req.CategoryId,
 		},
-		Tags: make([]*entities.CarTag, 0, len(req.TagsIds)),
+		Specs: req.toSpecs(),
+		Tags:  make([]*entities.CarTag, 0, len(req.TagsIds)),
 	}
 
 	for _, tagID := range req.TagsIds {
 		car.Tags = append(car.Tags, &entities.CarTag{ID: tagID})
 	}
 
+	if err := car.Validate(); err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeBadRequest, err.Error()))
+		return
+	}
+
 	updatedCar, err := h.updateCar.Execute(c.Request.Context(), car)
 	if err != nil {
 		_ = c.Error(err)
@@ -187,7 +194,7 @@ func (h *CarHandler) GetCarByID(c *gin.Context) {
 
 // GetCars godoc
 // @Summary      Получение списка автомобилей
-// @Description  Возвращает список автомобилей с возможностью фильтрации и пагинации
+// @Description  Возвращает список автомобилей с фильтрацией, сортировкой, пагинацией и счетчиками фасетов по текущим фильтрам
 // @Tags         Cars
 // @Accept       json
 // @Produce      json
@@ -196,15 +203,33 @@ func (h *CarHandler) GetCarByID(c *gin.Context) {
 // @Param        name query string false "Фильтр по названию автомобиля"
 // @Param        mark_id query int false "Фильтр по ID марки автомобиля"
 // @Param        category_id query int false "Фильтр по ID категории автомобиля"
+// @Param        available_from query string false "Свободен с (YYYY-MM-DD или RFC 3339)"
+// @Param        available_to query string false "Свободен до, не включительно (YYYY-MM-DD или RFC 3339)"
+// @Param        year_from query int false "Год выпуска от"
+// @Param        year_to query int false "Год выпуска до"
+// @Param        seats_from query int false "Количество мест от"
+// @Param        seats_to query int false "Количество мест до"
+// @Param        horsepower_from query int false "Мощность (л.с.) от"
+// @Param        horsepower_to query int false "Мощность (л.с.) до"
+// @Param        engine_volume_from query number false "Объем двигателя (л) от"
+// @Param        engine_volume_to query number false "Объем двигателя (л) до"
+// @Param        acceleration_to query number false "Разгон 0–100 км/ч (с) не более"
+// @Param        transmission query string false "Коробка передач через запятую (automatic, manual, robot, cvt)"
+// @Param        fuel_type query string false "Тип топлива через запятую (petrol, diesel, hybrid, electric)"
+// @Param        drive_type query string false "Привод через запятую (fwd, rwd, awd)"
+// @Param        color query string false "Цвет"
+// @Param        price_from query int false "Цена за день от"
+// @Param        price_to query int false "Цена за день до"
+// @Param        only_with_driver query bool false "Только с водителем (true) или без водителя (false)"
+// @Param        tag_ids query string false "ID тегов через запятую"
+// @Param        tag_match query string false "Сочетание тегов: any (любой) или all (все)" Enums(any, all) default(any)
+// @Param        sort query string false "Сортировка" Enums(newest, price_asc, price_desc, popular) default(newest)
 // @Success      200 {object}  ListCarsResponse  "Список автомобилей"
 // @Security     BearerAuth
 // @Router       /v1/cars [get]
 func (h *CarHandler) ListCars(c *gin.Context) {
 	offset := int64(0)
 	limit := int64(20)
-	name := ""
-	var markID int64 = 0
-	var categoryID int64 = 0
 
 	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil {
 		offset = o
@@ -212,22 +237,201 @@ func (h *CarHandler) ListCars(c *gin.Context) {
 	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil {
 		limit = l
 	}
-	name = c.DefaultQuery("name", "")
-	if m, err := strconv.ParseInt(c.DefaultQuery("mark_id", "0"), 10, 64); err == nil {
-		markID = m
+
+	filter, err := ParseCarListFilter(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
-	if cat, err := strconv.ParseInt(c.DefaultQuery("category_id", "0"), 10, 64); err == nil {
-		categoryID = cat
+
+	total, cars, err := h.getCars.Execute(c.Request.Context(), offset, limit, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
 
-	total, cars, err := h.getCars.Execute(c.Request.Context(), offset, limit, name, markID, categoryID)
+	facets, err := h.getFacets.Execute(c.Request.Context(), filter)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, ListCarsResponse{
+		Total:  total,
+		Data:   cars,
+		Facets: facets,
+	})
+}
+
+// SearchCars godoc
+// @Summary      Поиск автомобилей
+// @Description  Полнотекстовый поиск по названию, марке, категории, тегам и характеристикам на русском и английском с учетом опечаток. Результаты отсортированы по релевантности, кириллица также ищется в латинском написании. Highlight экранирован как HTML, совпадения выделены тегом <mark>
+// @Tags         Cars
+// @Produce      json
+// @Param        q query string true "Поисковый запрос"
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  SearchCarsResponse  "Результаты поиска"
+// @Security     BearerAuth
+// @Router       /v1/cars/search [get]
+func (h *CarHandler) SearchCars(c *gin.Context) {
+	offset := int64(0)
+	limit := int64(20)
+
+	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil {
+		offset = o
+	}
+	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil {
+		limit = l
+	}
+
+	total, results, err := h.searchCars.Execute(c.Request.Context(), c.Query("q"), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, SearchCarsResponse{
 		Total: total,
-		Data:  cars,
+		Data:  results,
 	})
 }
+
+// ParseCarListFilter reads the catalog filter query parameters shared by the
+// admin and public car lists.
+func ParseCarListFilter(c *gin.Context) (ports.CarListFilter, error) {
+	filter := ports.CarListFilter{
+		Name: c.DefaultQuery("name", ""),
+	}
+	if m, err := strconv.ParseInt(c.DefaultQuery("mark_id", "0"), 10, 64); err == nil {
+		filter.MarkID = m
+	}
+	if cat, err := strconv.ParseInt(c.DefaultQuery("category_id", "0"), 10, 64); err == nil {
+		filter.CategoryID = cat
+	}
+
+	if v := c.Query("available_from"); v != "" {
+		t, err := utils.ParseDateOrTime(v)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат available_from")
+		}
+		filter.AvailableFrom = t
+	}
+	if v := c.Query("available_to"); v != "" {
+		t, err := utils.ParseDateOrTime(v)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат available_to")
+		}
+		filter.AvailableTo = t
+	}
+
+	intRanges := []struct {
+		param string
+		dst   *int64
+	}{
+		{"year_from", &filter.YearFrom},
+		{"year_to", &filter.YearTo},
+		{"seats_from", &filter.SeatsFrom},
+		{"seats_to", &filter.SeatsTo},
+		{"horsepower_from", &filter.HorsepowerFrom},
+		{"horsepower_to", &filter.HorsepowerTo},
+	}
+	for _, r := range intRanges {
+		if v := c.Query(r.param); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n < 0 {
+				return filter, errors.New(errors.ErrCodeValidation, "Неверное значение "+r.param)
+			}
+			*r.dst = n
+		}
+	}
+
+	floatRanges := []struct {
+		param string
+		dst   *float64
+	}{
+		{"engine_volume_from", &filter.EngineVolumeFrom},
+		{"engine_volume_to", &filter.EngineVolumeTo},
+		{"acceleration_to", &filter.AccelerationTo},
+	}
+	for _, r := range floatRanges {
+		if v := c.Query(r.param); v != "" {
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil || n < 0 {
+				return filter, errors.New(errors.ErrCodeValidation, "Неверное значение "+r.param)
+			}
+			*r.dst = n
+		}
+	}
+
+	for _, v := range splitQueryList(c.Query("transmission")) {
+		t := entities.CarTransmission(v)
+		if !t.IsValid() {
+			return filter, errors.New(errors.ErrCodeValidation, "Неверное значение transmission")
+		}
+		filter.Transmissions = append(filter.Transmissions, t)
+	}
+	for _, v := range splitQueryList(c.Query("fuel_type")) {
+		f := entities.CarFuelType(v)
+		if !f.IsValid() {
+			return filter, errors.New(errors.ErrCodeValidation, "Неверное значение fuel_type")
+		}
+		filter.FuelTypes = append(filter.FuelTypes, f)
+	}
+	for _, v := range splitQueryList(c.Query("drive_type")) {
+		d := entities.CarDriveType(v)
+		if !d.IsValid() {
+			return filter, errors.New(errors.ErrCodeValidation, "Неверное значение drive_type")
+		}
+		filter.DriveTypes = append(filter.DriveTypes, d)
+	}
+
+	filter.Color = strings.TrimSpace(c.Query("color"))
+
+	for _, param := range []struct {
+		name string
+		dst  *int64
+	}{
+		{"price_from", &filter.PriceFrom},
+		{"price_to", &filter.PriceTo},
+	} {
+		if v := c.Query(param.name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n < 0 {
+				return filter, errors.New(errors.ErrCodeValidation, "Неверное значение "+param.name)
+			}
+			*param.dst = n
+		}
+	}
+
+	if v := c.Query("only_with_driver"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.New(errors.ErrCodeValidation, "Неверное значение only_with_driver")
+		}
+		filter.OnlyWithDriver = &b
+	}
+
+	for _, v := range splitQueryList(c.Query("tag_ids")) {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			return filter, errors.New(errors.ErrCodeValidation, "Неверное значение tag_ids")
+		}
+		filter.TagIDs = append(filter.TagIDs, id)
+	}
+	filter.TagMatch = ports.CarTagMatch(c.DefaultQuery("tag_match", string(ports.CarTagMatchAny)))
+	filter.Sort = ports.CarListSort(c.DefaultQuery("sort", string(ports.CarListSortNewest)))
+
+	return filter, nil
+}
+
+// splitQueryList splits a comma separated query value, dropping empty items.
+func splitQueryList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -4,6 +4,10 @@ import "github.com/nomad-pixel/imperial/internal/domain/entities"
 
 type CarImageResponse = entities.CarImage
 
+type ReorderCarImagesRequest struct {
+	ImageIDs []int64 `json:"image_ids" binding:"required,min=1" example:"3,1,2"`
+}
+
 type MessageResponse struct {
 	Message string `json:"message"`
 }
@@ -1,7 +1,6 @@
 package car
 
 import (
-	"io"
 	"net/http"
 	"strconv"
 
@@ -14,17 +13,23 @@ type CarImageHandler struct {
 	createCarImage   usecasePorts.CreateCarImageUsecase
 	deleteCarImage   usecasePorts.DeleteCarImageUsecase
 	getCarImagesList usecasePorts.GetCarImagesListUsecase
+	reorderImages    usecasePorts.ReorderCarImagesUsecase
+	setCover         usecasePorts.SetCarImageCoverUsecase
 }
 
 func NewCarImageHandler(
 	createCarImage usecasePorts.CreateCarImageUsecase,
 	deleteCarImage usecasePorts.DeleteCarImageUsecase,
 	getCarImagesList usecasePorts.GetCarImagesListUsecase,
+	reorderImages usecasePorts.ReorderCarImagesUsecase,
+	setCover usecasePorts.SetCarImageCoverUsecase,
 ) *CarImageHandler {
 	return &CarImageHandler{
 		createCarImage:   createCarImage,
 		deleteCarImage:   deleteCarImage,
 		getCarImagesList: getCarImagesList,
+		reorderImages:    reorderImages,
+		setCover:         setCover,
 	}
 }
 
@@ -54,18 +59,12 @@ func (h *CarImageHandler) CreateCarImage(c *gin.Context) {
 
 	src, err := fileHeader.Open()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open uploaded file"})
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeInternal, "Не удалось открыть загруженный файл"))
 		return
 	}
 	defer src.Close()
 
-	fileData, err := io.ReadAll(src)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read uploaded file"})
-		return
-	}
-
-	image, err := h.createCarImage.Execute(c.Request.Context(), carID, fileData, fileHeader.Filename)
+	image, err := h.createCarImage.Execute(c.Request.Context(), carID, src, fileHeader.Filename)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -147,3 +146,72 @@ func (h *CarImageHandler) GetCarImagesList(c *gin.Context) {
 	})
 
 }
+
+// ReorderCarImages godoc
+// @Summary      Изменение порядка изображений автомобиля
+// @Description  Сохраняет порядок галереи автомобиля. Список должен содержать все изображения автомобиля ровно по одному разу
+// @Tags         Car images
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        request body ReorderCarImagesRequest true "ID изображений в новом порядке"
+// @Security     BearerAuth
+// @Router       /v1/cars/{id}/images/order [put]
+// @Success      200 {object}  ListCarImagesResponse  "Порядок изображений сохранен"
+func (h *CarImageHandler) ReorderCarImages(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+
+	var req ReorderCarImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	images, err := h.reorderImages.Execute(c.Request.Context(), carID, req.ImageIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ListCarImagesResponse{
+		Total: int64(len(images)),
+		Data:  images,
+	})
+}
+
+// SetCarImageCover godoc
+// @Summary      Выбор обложки автомобиля
+// @Description  Делает изображение главным в карточке автомобиля
+// @Tags         Car images
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        image_id path int true "ID изображения"
+// @Security     BearerAuth
+// @Router       /v1/cars/{id}/images/{image_id}/cover [put]
+// @Success      200 {object}  CarImageResponse  "Обложка успешно изменена"
+func (h *CarImageHandler) SetCarImageCover(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+
+	imageID, err := strconv.ParseInt(c.Param("image_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID изображения"))
+		return
+	}
+
+	image, err := h.setCover.Execute(c.Request.Context(), carID, imageID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, image)
+}
@@ -2,6 +2,7 @@ package car
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -10,8 +11,15 @@ func RegisterRoutes(router gin.IRouter, handler *CarImageHandler, tokenSvc ports
 	api := router.Group("/v1/cars/:id/images")
 	api.Use(middleware.AuthMiddleware(tokenSvc))
 	{
-		api.POST("", handler.CreateCarImage)
 		api.GET("", handler.GetCarImagesList)
-		api.DELETE("/:image_id", handler.DeleteCarImage)
+	}
+
+	// Admin-only endpoints
+	admin := api.Group("", middleware.RequireRoles(entities.UserRoleAdmin))
+	{
+		admin.POST("", handler.CreateCarImage)
+		admin.PUT("/order", handler.ReorderCarImages)
+		admin.DELETE("/:image_id", handler.DeleteCarImage)
+		admin.PUT("/:image_id/cover", handler.SetCarImageCover)
 	}
 }
@@ -2,6 +2,7 @@ package car
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -11,10 +12,16 @@ func RegisterRoutes(router gin.IRouter, handler *CarHandler, tokenSvc ports.Toke
 	api.Use(middleware.AuthMiddleware(tokenSvc))
 
 	{
-		api.POST("", handler.CreateCar)
 		api.GET("", handler.ListCars)
+		api.GET("/search", handler.SearchCars)
 		api.GET("/:id", handler.GetCarByID)
-		api.PUT("/:id", handler.UpdateCar)
-		api.DELETE("/:id", handler.DeleteCar)
+	}
+
+	// Admin-only endpoints
+	admin := api.Group("", middleware.RequireRoles(entities.UserRoleAdmin))
+	{
+		admin.POST("", handler.CreateCar)
+		admin.PUT("/:id", handler.UpdateCar)
+		admin.DELETE("/:id", handler.DeleteCar)
 	}
 }
@@ -2,6 +2,7 @@ package car
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -12,7 +13,7 @@ func RegisterRoutes(router gin.IRouter, handler *CarTagHandler, tokenSvc ports.T
 	api.GET("", handler.GetCarTags)
 	api.GET("/:id", handler.GetCarTag)
 
-	api.Use(middleware.AuthMiddleware(tokenSvc))
+	api.Use(middleware.AuthMiddleware(tokenSvc), middleware.RequireRoles(entities.UserRoleAdmin))
 	{
 		api.POST("", handler.CreateCarTag)
 		api.PUT("/:id", handler.UpdateCarTag)
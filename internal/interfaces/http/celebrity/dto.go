@@ -1,6 +1,10 @@
 package celebrity
 
-import "github.com/nomad-pixel/imperial/internal/domain/entities"
+import (
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
 
 type MessageResponse struct {
 	Message string `json:"message"`
@@ -12,11 +16,49 @@ type ListCelebritiesResponse struct {
 }
 
 type CreateCelebrityRequest struct {
-	Name  string `json:"name" binding:"required,min=1,max=255" example:"John Doe"`
-	Image string `json:"image" example:"https://example.com/celebrity.jpg"`
+	Name        string                `json:"name" binding:"required,min=1,max=255" example:"John Doe"`
+	Image       string                `json:"image" example:"https://example.com/celebrity.jpg"`
+	Profession  string                `json:"profession" binding:"max=200" example:"Актёр"`
+	Bio         string                `json:"bio" binding:"max=2000" example:"Народный артист, постоянный клиент с 2019 года"`
+	SocialLinks []entities.SocialLink `json:"social_links" binding:"max=10"`
+	IsFeatured  bool                  `json:"is_featured" example:"true"`
 }
 
+// UpdateCelebrityRequest replaces the whole profile. A celebrity that becomes
+// featured is placed last in the homepage carousel.
 type UpdateCelebrityRequest struct {
-	Name  string `json:"name" binding:"required,min=1,max=255" example:"John Doe"`
-	Image string `json:"image" example:"https://example.com/celebrity.jpg"`
+	Name        string                `json:"name" binding:"required,min=1,max=255" example:"John Doe"`
+	Image       string                `json:"image" example:"https://example.com/celebrity.jpg"`
+	Profession  string                `json:"profession" binding:"max=200" example:"Актёр"`
+	Bio         string                `json:"bio" binding:"max=2000" example:"Народный артист, постоянный клиент с 2019 года"`
+	SocialLinks []entities.SocialLink `json:"social_links" binding:"max=10"`
+	IsFeatured  bool                  `json:"is_featured" example:"true"`
+}
+
+type ReorderFeaturedCelebritiesRequest struct {
+	CelebrityIDs []int64 `json:"celebrity_ids" binding:"required,min=1" example:"3,1,2"`
+}
+
+type ReorderCelebrityImagesRequest struct {
+	ImageIDs []int64 `json:"image_ids" binding:"required,min=1" example:"3,1,2"`
+}
+
+type CelebrityCarRequest struct {
+	CarID int64  `json:"car_id" binding:"required" example:"1"`
+	Quote string `json:"quote" binding:"max=500" example:"Лучший автомобиль для деловых встреч"`
+	// RentedAt is the rental date; only the date part is kept
+	RentedAt *time.Time `json:"rented_at" example:"2024-05-01T00:00:00Z"`
+}
+
+type UpdateCelebrityCarRequest struct {
+	Quote    string     `json:"quote" binding:"max=500" example:"Лучший автомобиль для деловых встреч"`
+	RentedAt *time.Time `json:"rented_at" example:"2024-05-01T00:00:00Z"`
+}
+
+type ReorderCelebrityCarsRequest struct {
+	CarIDs []int64 `json:"car_ids" binding:"required,min=1" example:"3,1,2"`
+}
+
+type ReorderCarCelebritiesRequest struct {
+	CelebrityIDs []int64 `json:"celebrity_ids" binding:"required,min=1" example:"3,1,2"`
 }
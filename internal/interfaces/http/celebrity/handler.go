@@ -1,7 +1,6 @@
 package celebrity
 
 import (
-	"io"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -16,6 +15,15 @@ type CelebrityHandler struct {
 	listCelebritiesUsecase      usecasePorts.ListCelebritiesUsecase
 	updateCelebrityUsecase      usecasePorts.UpdateCelebrityUsecase
 	deleteCelebrityUsecase      usecasePorts.DeleteCelebrityUsecase
+	addCelebrityCar             usecasePorts.AddCelebrityCarUsecase
+	updateCelebrityCar          usecasePorts.UpdateCelebrityCarUsecase
+	deleteCelebrityCar          usecasePorts.DeleteCelebrityCarUsecase
+	reorderCelebrityCars        usecasePorts.ReorderCelebrityCarsUsecase
+	reorderCarCelebrities       usecasePorts.ReorderCarCelebritiesUsecase
+	reorderFeatured             usecasePorts.ReorderFeaturedCelebritiesUsecase
+	addCelebrityImage           usecasePorts.AddCelebrityImageUsecase
+	deleteCelebrityImage        usecasePorts.DeleteCelebrityImageUsecase
+	reorderCelebrityImages      usecasePorts.ReorderCelebrityImagesUsecase
 }
 
 func NewCelebrityHandler(
@@ -25,6 +33,15 @@ func NewCelebrityHandler(
 	listCelebritiesUsecase usecasePorts.ListCelebritiesUsecase,
 	updateCelebrityUsecase usecasePorts.UpdateCelebrityUsecase,
 	deleteCelebrityUsecase usecasePorts.DeleteCelebrityUsecase,
+	addCelebrityCar usecasePorts.AddCelebrityCarUsecase,
+	updateCelebrityCar usecasePorts.UpdateCelebrityCarUsecase,
+	deleteCelebrityCar usecasePorts.DeleteCelebrityCarUsecase,
+	reorderCelebrityCars usecasePorts.ReorderCelebrityCarsUsecase,
+	reorderCarCelebrities usecasePorts.ReorderCarCelebritiesUsecase,
+	reorderFeatured usecasePorts.ReorderFeaturedCelebritiesUsecase,
+	addCelebrityImage usecasePorts.AddCelebrityImageUsecase,
+	deleteCelebrityImage usecasePorts.DeleteCelebrityImageUsecase,
+	reorderCelebrityImages usecasePorts.ReorderCelebrityImagesUsecase,
 ) *CelebrityHandler {
 	return &CelebrityHandler{
 		createCelebrityUsecase:      createCelebrityUsecase,
@@ -33,12 +50,21 @@ func NewCelebrityHandler(
 		listCelebritiesUsecase:      listCelebritiesUsecase,
 		updateCelebrityUsecase:      updateCelebrityUsecase,
 		deleteCelebrityUsecase:      deleteCelebrityUsecase,
+		addCelebrityCar:             addCelebrityCar,
+		updateCelebrityCar:          updateCelebrityCar,
+		deleteCelebrityCar:          deleteCelebrityCar,
+		reorderCelebrityCars:        reorderCelebrityCars,
+		reorderCarCelebrities:       reorderCarCelebrities,
+		reorderFeatured:             reorderFeatured,
+		addCelebrityImage:           addCelebrityImage,
+		deleteCelebrityImage:        deleteCelebrityImage,
+		reorderCelebrityImages:      reorderCelebrityImages,
 	}
 }
 
 // CreateCelebrity godoc
 // @Summary Create a new celebrity
-// @Description Create a new celebrity with the provided details
+// @Description Create a new celebrity with the provided details. A featured celebrity is placed last in the homepage carousel
 // @Tags Celebrities
 // @Accept json
 // @Produce json
@@ -53,7 +79,13 @@ func (h *CelebrityHandler) CreateCelebrity(c *gin.Context) {
 		return
 	}
 
-	celebrity, err := h.createCelebrityUsecase.Execute(c.Request.Context(), req.Name)
+	celebrity, err := h.createCelebrityUsecase.Execute(c.Request.Context(), usecasePorts.CelebrityInput{
+		Name:        req.Name,
+		Profession:  req.Profession,
+		Bio:         req.Bio,
+		SocialLinks: req.SocialLinks,
+		IsFeatured:  req.IsFeatured,
+	})
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -64,7 +96,7 @@ func (h *CelebrityHandler) CreateCelebrity(c *gin.Context) {
 
 // UploadCelebrityImage godoc
 // @Summary Upload an image for a celebrity
-// @Description Upload an image file for the specified celebrity
+// @Description Replace the main photo of the celebrity. The previous photo is deleted only after the new one is stored
 // @Tags Celebrities
 // @Accept multipart/form-data
 // @Produce json
@@ -93,13 +125,7 @@ func (h *CelebrityHandler) UploadCelebrityImage(c *gin.Context) {
 	}
 	defer fileData.Close()
 
-	imageBytes, err := io.ReadAll(fileData)
-	if err != nil {
-		_ = c.Error(errors.Wrap(err, errors.ErrCodeInternal, "Failed to read image file"))
-		return
-	}
-
-	celebrity, err := h.celebrityUploadImageUsecase.Execute(c.Request.Context(), id, imageBytes, file.Filename)
+	celebrity, err := h.celebrityUploadImageUsecase.Execute(c.Request.Context(), id, fileData, file.Filename)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -192,7 +218,13 @@ func (h *CelebrityHandler) UpdateCelebrity(c *gin.Context) {
 		return
 	}
 
-	updatedCelebrity, err := h.updateCelebrityUsecase.Execute(c.Request.Context(), id, req.Name)
+	updatedCelebrity, err := h.updateCelebrityUsecase.Execute(c.Request.Context(), id, usecasePorts.CelebrityInput{
+		Name:        req.Name,
+		Profession:  req.Profession,
+		Bio:         req.Bio,
+		SocialLinks: req.SocialLinks,
+		IsFeatured:  req.IsFeatured,
+	})
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -228,3 +260,306 @@ func (h *CelebrityHandler) DeleteCelebrity(c *gin.Context) {
 		Message: "Celebrity successfully deleted",
 	})
 }
+
+// AddCelebrityCar godoc
+// @Summary Add a rented car to a celebrity
+// @Description Link a car the celebrity rented, with an optional quote and rental date. The car is listed last on the celebrity page and the celebrity last on the car page
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param car body CelebrityCarRequest true "Rented car"
+// @Success 201 {object} entities.CelebrityCar
+// @Router /v1/celebrities/{id}/cars [post]
+// @Security     BearerAuth
+func (h *CelebrityHandler) AddCelebrityCar(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+
+	var req CelebrityCarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	link, err := h.addCelebrityCar.Execute(c.Request.Context(), id, req.CarID, req.Quote, req.RentedAt)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, link)
+}
+
+// UpdateCelebrityCar godoc
+// @Summary Update a rented car of a celebrity
+// @Description Replace the quote and rental date of a car the celebrity rented
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param car_id path int true "Car ID"
+// @Param car body UpdateCelebrityCarRequest true "Quote and rental date"
+// @Success 200 {object} entities.CelebrityCar
+// @Router /v1/celebrities/{id}/cars/{car_id} [put]
+// @Security     BearerAuth
+func (h *CelebrityHandler) UpdateCelebrityCar(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+	carID, err := strconv.ParseInt(c.Param("car_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid car ID"))
+		return
+	}
+
+	var req UpdateCelebrityCarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	link, err := h.updateCelebrityCar.Execute(c.Request.Context(), id, carID, req.Quote, req.RentedAt)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, link)
+}
+
+// DeleteCelebrityCar godoc
+// @Summary Remove a rented car from a celebrity
+// @Description Unlink a car from the celebrity
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param car_id path int true "Car ID"
+// @Success 200 {object} MessageResponse
+// @Router /v1/celebrities/{id}/cars/{car_id} [delete]
+// @Security     BearerAuth
+func (h *CelebrityHandler) DeleteCelebrityCar(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+	carID, err := strconv.ParseInt(c.Param("car_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid car ID"))
+		return
+	}
+
+	if err := h.deleteCelebrityCar.Execute(c.Request.Context(), id, carID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, MessageResponse{
+		Message: "Car successfully removed from celebrity",
+	})
+}
+
+// ReorderCelebrityCars godoc
+// @Summary Reorder the cars of a celebrity
+// @Description Set the order of the "Their choice" list. car_ids must contain every car of the celebrity exactly once
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param order body ReorderCelebrityCarsRequest true "Car IDs in display order"
+// @Success 200 {array} entities.RentedCar
+// @Router /v1/celebrities/{id}/cars/order [put]
+// @Security     BearerAuth
+func (h *CelebrityHandler) ReorderCelebrityCars(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+
+	var req ReorderCelebrityCarsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	cars, err := h.reorderCelebrityCars.Execute(c.Request.Context(), id, req.CarIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, cars)
+}
+
+// ReorderCarCelebrities godoc
+// @Summary Reorder the celebrities of a car
+// @Description Set the order of the "Rented by" list of a car. celebrity_ids must contain every celebrity linked to the car exactly once
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Car ID"
+// @Param order body ReorderCarCelebritiesRequest true "Celebrity IDs in display order"
+// @Success 200 {array} entities.CarRenter
+// @Router /v1/cars/{id}/celebrities/order [put]
+// @Security     BearerAuth
+func (h *CelebrityHandler) ReorderCarCelebrities(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid car ID"))
+		return
+	}
+
+	var req ReorderCarCelebritiesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	renters, err := h.reorderCarCelebrities.Execute(c.Request.Context(), carID, req.CelebrityIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, renters)
+}
+
+// ReorderFeaturedCelebrities godoc
+// @Summary Reorder the featured celebrities
+// @Description Set the order of the homepage carousel. celebrity_ids must contain every featured celebrity exactly once
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param order body ReorderFeaturedCelebritiesRequest true "Celebrity IDs in display order"
+// @Success 200 {array} entities.Celebrity
+// @Router /v1/celebrities/featured/order [put]
+// @Security     BearerAuth
+func (h *CelebrityHandler) ReorderFeaturedCelebrities(c *gin.Context) {
+	var req ReorderFeaturedCelebritiesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	celebrities, err := h.reorderFeatured.Execute(c.Request.Context(), req.CelebrityIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, celebrities)
+}
+
+// AddCelebrityImage godoc
+// @Summary Add a photo to the celebrity gallery
+// @Description Upload a photo; it is placed last in the gallery
+// @Tags Celebrities
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param image formData file true "Image file"
+// @Success 201 {object} entities.CelebrityImage
+// @Router /v1/celebrities/{id}/images [post]
+// @Security     BearerAuth
+func (h *CelebrityHandler) AddCelebrityImage(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Image file is required"))
+		return
+	}
+
+	fileData, err := file.Open()
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeInternal, "Failed to open image file"))
+		return
+	}
+	defer fileData.Close()
+
+	image, err := h.addCelebrityImage.Execute(c.Request.Context(), id, fileData, file.Filename)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, image)
+}
+
+// DeleteCelebrityImage godoc
+// @Summary Delete a photo from the celebrity gallery
+// @Description Remove a gallery photo and its files
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param image_id path int true "Image ID"
+// @Success 200 {object} MessageResponse
+// @Router /v1/celebrities/{id}/images/{image_id} [delete]
+// @Security     BearerAuth
+func (h *CelebrityHandler) DeleteCelebrityImage(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+	imageID, err := strconv.ParseInt(c.Param("image_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid image ID"))
+		return
+	}
+
+	if err := h.deleteCelebrityImage.Execute(c.Request.Context(), id, imageID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, MessageResponse{
+		Message: "Celebrity image successfully deleted",
+	})
+}
+
+// ReorderCelebrityImages godoc
+// @Summary Reorder the celebrity gallery
+// @Description Set the order of the gallery. image_ids must contain every photo of the celebrity exactly once
+// @Tags Celebrities
+// @Accept json
+// @Produce json
+// @Param id path int true "Celebrity ID"
+// @Param order body ReorderCelebrityImagesRequest true "Image IDs in display order"
+// @Success 200 {array} entities.CelebrityImage
+// @Router /v1/celebrities/{id}/images/order [put]
+// @Security     BearerAuth
+func (h *CelebrityHandler) ReorderCelebrityImages(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid celebrity ID"))
+		return
+	}
+
+	var req ReorderCelebrityImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	images, err := h.reorderCelebrityImages.Execute(c.Request.Context(), id, req.ImageIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, images)
+}
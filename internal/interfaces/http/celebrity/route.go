@@ -2,6 +2,7 @@ package celebrity
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -12,11 +13,34 @@ func RegisterRoutes(router gin.IRouter, handler *CelebrityHandler, tokenSvc port
 	api.Use(middleware.AuthMiddleware(tokenSvc))
 
 	{
-		api.POST("", handler.CreateCelebrity)
 		api.GET("", handler.ListCelebrities)
 		api.GET("/:id", handler.GetCelebrityByID)
-		api.PUT("/:id", handler.UpdateCelebrity)
-		api.PUT("/:id/image", handler.UploadCelebrityImage)
-		api.DELETE("/:id", handler.DeleteCelebrity)
+	}
+
+	// Admin-only endpoints
+	admin := api.Group("", middleware.RequireRoles(entities.UserRoleAdmin))
+	{
+		admin.POST("", handler.CreateCelebrity)
+		admin.PUT("/featured/order", handler.ReorderFeaturedCelebrities)
+		admin.PUT("/:id", handler.UpdateCelebrity)
+		admin.PUT("/:id/image", handler.UploadCelebrityImage)
+		admin.DELETE("/:id", handler.DeleteCelebrity)
+		admin.POST("/:id/cars", handler.AddCelebrityCar)
+		admin.PUT("/:id/cars/order", handler.ReorderCelebrityCars)
+		admin.PUT("/:id/cars/:car_id", handler.UpdateCelebrityCar)
+		admin.DELETE("/:id/cars/:car_id", handler.DeleteCelebrityCar)
+		admin.POST("/:id/images", handler.AddCelebrityImage)
+		admin.PUT("/:id/images/order", handler.ReorderCelebrityImages)
+		admin.DELETE("/:id/images/:image_id", handler.DeleteCelebrityImage)
+	}
+
+	// The "Rented by" order is edited from the car page
+	carCelebrities := router.Group("/v1/cars/:id/celebrities")
+	carCelebrities.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin),
+	)
+	{
+		carCelebrities.PUT("/order", handler.ReorderCarCelebrities)
 	}
 }
@@ -1,18 +1,60 @@
 package driver
 
+import (
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
 type CreateDriverRequest struct {
 	FullName        string `json:"full_name" binding:"required"`
 	About           string `json:"about" binding:"required"`
 	ExperienceYears string `json:"experience_years" binding:"required"`
+	DriverQualificationsRequest
 }
 
 type UpdateDriverRequest struct {
 	FullName        string `json:"full_name" binding:"required"`
 	About           string `json:"about" binding:"required"`
 	ExperienceYears string `json:"experience_years" binding:"required"`
+	DriverQualificationsRequest
+}
+
+// DriverQualificationsRequest carries the structured driver profile. Omitted
+// lists are stored as empty, so an update replaces them as a whole.
+type DriverQualificationsRequest struct {
+	DrivingExperience int64                    `json:"driving_experience" example:"12"`
+	Languages         []string                 `json:"languages" example:"ru,kk,en"`
+	Licenses          []entities.DriverLicense `json:"licenses"`
+	CarIDs            []int64                  `json:"car_ids"`
+	CategoryIDs       []int64                  `json:"category_ids"`
+}
+
+func (r DriverQualificationsRequest) toQualifications() entities.DriverQualifications {
+	return entities.DriverQualifications{
+		DrivingExperience: r.DrivingExperience,
+		Languages:         r.Languages,
+		Licenses:          r.Licenses,
+		CarIDs:            r.CarIDs,
+		CategoryIDs:       r.CategoryIDs,
+	}
 }
 
 type ListDriversResponse struct {
 	Total int64       `json:"total"`
 	Data  interface{} `json:"data"`
 }
+
+type ListAvailableDriversResponse struct {
+	Data []*entities.Driver `json:"data"`
+}
+
+type SetDriverScheduleRequest struct {
+	Shifts []entities.DriverShift `json:"shifts"`
+}
+
+type CreateDriverDayOffRequest struct {
+	StartDate time.Time `json:"start_date" binding:"required" example:"2026-10-10T00:00:00Z"`
+	EndDate   time.Time `json:"end_date" binding:"required" example:"2026-10-14T00:00:00Z"`
+	Reason    string    `json:"reason" example:"Отпуск"`
+}
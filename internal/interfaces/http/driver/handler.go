@@ -1,21 +1,31 @@
 package driver
 
 import (
-	"io"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/driver"
+	carAvailability "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
 	"github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
 )
 
 type DriverHandler struct {
-	createDriverUsecase      usecasePorts.CreateDriverUsecase
-	getDriverByIdUsecase     usecasePorts.GetDriverByIdUsecase
-	listDriversUsecase       usecasePorts.ListDriversUsecase
-	updateDriverUsecase      usecasePorts.UpdateDriverUsecase
-	deleteDriverUsecase      usecasePorts.DeleteDriverUsecase
-	uploadDriverPhotoUsecase usecasePorts.UploadDriverPhotoUsecase
+	createDriverUsecase         usecasePorts.CreateDriverUsecase
+	getDriverByIdUsecase        usecasePorts.GetDriverByIdUsecase
+	listDriversUsecase          usecasePorts.ListDriversUsecase
+	updateDriverUsecase         usecasePorts.UpdateDriverUsecase
+	deleteDriverUsecase         usecasePorts.DeleteDriverUsecase
+	uploadDriverPhotoUsecase    usecasePorts.UploadDriverPhotoUsecase
+	getDriverScheduleUsecase    usecasePorts.GetDriverScheduleUsecase
+	setDriverScheduleUsecase    usecasePorts.SetDriverScheduleUsecase
+	getDriverCalendarUsecase    usecasePorts.GetDriverCalendarUsecase
+	createDriverDayOffUsecase   usecasePorts.CreateDriverDayOffUsecase
+	deleteDriverDayOffUsecase   usecasePorts.DeleteDriverDayOffUsecase
+	listAvailableDriversUsecase usecasePorts.ListAvailableDriversUsecase
 }
 
 func NewDriverHandler(
@@ -25,14 +35,26 @@ func NewDriverHandler(
 	updateDriverUsecase usecasePorts.UpdateDriverUsecase,
 	deleteDriverUsecase usecasePorts.DeleteDriverUsecase,
 	uploadDriverPhotoUsecase usecasePorts.UploadDriverPhotoUsecase,
+	getDriverScheduleUsecase usecasePorts.GetDriverScheduleUsecase,
+	setDriverScheduleUsecase usecasePorts.SetDriverScheduleUsecase,
+	getDriverCalendarUsecase usecasePorts.GetDriverCalendarUsecase,
+	createDriverDayOffUsecase usecasePorts.CreateDriverDayOffUsecase,
+	deleteDriverDayOffUsecase usecasePorts.DeleteDriverDayOffUsecase,
+	listAvailableDriversUsecase usecasePorts.ListAvailableDriversUsecase,
 ) *DriverHandler {
 	return &DriverHandler{
-		createDriverUsecase:      createDriverUsecase,
-		getDriverByIdUsecase:     getDriverByIdUsecase,
-		listDriversUsecase:       listDriversUsecase,
-		updateDriverUsecase:      updateDriverUsecase,
-		deleteDriverUsecase:      deleteDriverUsecase,
-		uploadDriverPhotoUsecase: uploadDriverPhotoUsecase,
+		createDriverUsecase:         createDriverUsecase,
+		getDriverByIdUsecase:        getDriverByIdUsecase,
+		listDriversUsecase:          listDriversUsecase,
+		updateDriverUsecase:         updateDriverUsecase,
+		deleteDriverUsecase:         deleteDriverUsecase,
+		uploadDriverPhotoUsecase:    uploadDriverPhotoUsecase,
+		getDriverScheduleUsecase:    getDriverScheduleUsecase,
+		setDriverScheduleUsecase:    setDriverScheduleUsecase,
+		getDriverCalendarUsecase:    getDriverCalendarUsecase,
+		createDriverDayOffUsecase:   createDriverDayOffUsecase,
+		deleteDriverDayOffUsecase:   deleteDriverDayOffUsecase,
+		listAvailableDriversUsecase: listAvailableDriversUsecase,
 	}
 }
 
@@ -53,7 +75,7 @@ func (h *DriverHandler) CreateDriver(c *gin.Context) {
 		return
 	}
 
-	driver, err := h.createDriverUsecase.Execute(c.Request.Context(), req.FullName, req.About, req.ExperienceYears)
+	driver, err := h.createDriverUsecase.Execute(c.Request.Context(), req.FullName, req.About, req.ExperienceYears, req.toQualifications())
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -90,12 +112,17 @@ func (h *DriverHandler) GetDriverByID(c *gin.Context) {
 
 // ListDrivers godoc
 // @Summary List drivers
-// @Description Get a paginated list of drivers
+// @Description Get a paginated list of drivers, optionally filtered by qualifications
 // @Tags Drivers
 // @Accept json
 // @Produce json
 // @Param offset query int false "Offset for pagination" default(0)
 // @Param limit query int false "Limit for pagination" default(20)
+// @Param min_experience query int false "Minimum driving experience in years"
+// @Param languages query string false "Comma-separated ISO 639-1 codes the driver must all speak, e.g. en,ru"
+// @Param license query string false "License category the driver holds and that has not expired" Enums(A, B, BE, C, CE, D, DE, A1, B1, C1, C1E, D1, D1E)
+// @Param car_id query int false "Certified for this car directly or through its category"
+// @Param category_id query int false "Certified for this car category"
 // @Success 200 {object} ListDriversResponse
 // @Router /v1/drivers [get]
 // @Security     BearerAuth
@@ -110,7 +137,7 @@ func (h *DriverHandler) ListDrivers(c *gin.Context) {
 		limit = l
 	}
 
-	total, drivers, err := h.listDriversUsecase.Execute(c.Request.Context(), offset, limit)
+	total, drivers, err := h.listDriversUsecase.Execute(c.Request.Context(), offset, limit, ParseDriverFilter(c))
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -146,7 +173,7 @@ func (h *DriverHandler) UpdateDriver(c *gin.Context) {
 		return
 	}
 
-	updatedDriver, err := h.updateDriverUsecase.Execute(c.Request.Context(), id, req.FullName, req.About, req.ExperienceYears)
+	updatedDriver, err := h.updateDriverUsecase.Execute(c.Request.Context(), id, req.FullName, req.About, req.ExperienceYears, req.toQualifications())
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -212,17 +239,219 @@ func (h *DriverHandler) UploadDriverPhoto(c *gin.Context) {
 	}
 	defer fileData.Close()
 
-	imageBytes, err := io.ReadAll(fileData)
+	driver, err := h.uploadDriverPhotoUsecase.Execute(c.Request.Context(), id, fileData, file.Filename)
 	if err != nil {
-		_ = c.Error(errors.Wrap(err, errors.ErrCodeInternal, "Failed to read photo file"))
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, driver)
+}
+
+// ListAvailableDrivers godoc
+// @Summary List available drivers
+// @Description Drivers who work during [start_date, end_date) and have no booking or day off overlapping it
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param start_date query string true "Period start (YYYY-MM-DD or RFC 3339)"
+// @Param end_date query string true "Period end (YYYY-MM-DD or RFC 3339)"
+// @Success 200 {object} ListAvailableDriversResponse
+// @Router /v1/drivers/available [get]
+// @Security     BearerAuth
+func (h *DriverHandler) ListAvailableDrivers(c *gin.Context) {
+	startDate, err := utils.ParseDateOrTime(c.Query("start_date"))
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат start_date"))
+		return
+	}
+	endDate, err := utils.ParseDateOrTime(c.Query("end_date"))
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат end_date"))
 		return
 	}
 
-	driver, err := h.uploadDriverPhotoUsecase.Execute(c.Request.Context(), id, imageBytes, file.Filename)
+	drivers, err := h.listAvailableDriversUsecase.Execute(c.Request.Context(), startDate, endDate)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(200, driver)
+	c.JSON(200, ListAvailableDriversResponse{Data: drivers})
+}
+
+// GetDriverSchedule godoc
+// @Summary Get driver schedule
+// @Description Weekly working hours of the driver. An empty list means no schedule is set and the driver counts as working every day
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Success 200 {object} entities.DriverSchedule
+// @Router /v1/drivers/{id}/schedule [get]
+// @Security     BearerAuth
+func (h *DriverHandler) GetDriverSchedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid driver ID"))
+		return
+	}
+
+	schedule, err := h.getDriverScheduleUsecase.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, schedule)
+}
+
+// SetDriverSchedule godoc
+// @Summary Set driver schedule
+// @Description Replace the weekly working hours of the driver, one shift per weekday (0 = Sunday). Times are HH:MM in the business timezone (APP_TIMEZONE)
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Param schedule body SetDriverScheduleRequest true "Shifts"
+// @Success 200 {object} entities.DriverSchedule
+// @Router /v1/drivers/{id}/schedule [put]
+// @Security     BearerAuth
+func (h *DriverHandler) SetDriverSchedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid driver ID"))
+		return
+	}
+
+	var req SetDriverScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	schedule, err := h.setDriverScheduleUsecase.Execute(c.Request.Context(), id, req.Shifts)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, schedule)
+}
+
+// GetDriverCalendar godoc
+// @Summary Get driver calendar
+// @Description Shifts, bookings and days off of the driver within a month
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Param month query string false "Month as YYYY-MM, defaults to the current one"
+// @Success 200 {object} entities.DriverCalendar
+// @Router /v1/drivers/{id}/calendar [get]
+// @Security     BearerAuth
+func (h *DriverHandler) GetDriverCalendar(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid driver ID"))
+		return
+	}
+
+	month, err := carAvailability.ParseCalendarMonth(c.Query("month"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	calendar, err := h.getDriverCalendarUsecase.Execute(c.Request.Context(), id, month.Year(), month.Month())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, calendar)
+}
+
+// CreateDriverDayOff godoc
+// @Summary Add driver day off
+// @Description Take the driver off duty for [start_date, end_date). Returns 409 if the driver is booked during that period
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Param day_off body CreateDriverDayOffRequest true "Day off period"
+// @Success 201 {object} entities.DriverDayOff
+// @Router /v1/drivers/{id}/days-off [post]
+// @Security     BearerAuth
+func (h *DriverHandler) CreateDriverDayOff(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid driver ID"))
+		return
+	}
+
+	var req CreateDriverDayOffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	dayOff, err := h.createDriverDayOffUsecase.Execute(c.Request.Context(), id, req.StartDate, req.EndDate, req.Reason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, dayOff)
+}
+
+// DeleteDriverDayOff godoc
+// @Summary Delete driver day off
+// @Tags Driver availability
+// @Accept json
+// @Produce json
+// @Param id path int true "Driver ID"
+// @Param day_off_id path int true "Day off ID"
+// @Success 200 {object} map[string]string
+// @Router /v1/drivers/{id}/days-off/{day_off_id} [delete]
+// @Security     BearerAuth
+func (h *DriverHandler) DeleteDriverDayOff(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid driver ID"))
+		return
+	}
+	dayOffID, err := strconv.ParseInt(c.Param("day_off_id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid day off ID"))
+		return
+	}
+
+	if err := h.deleteDriverDayOffUsecase.Execute(c.Request.Context(), id, dayOffID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, gin.H{"message": "Day off deleted successfully"})
+}
+
+// ParseDriverFilter reads the qualification filters shared by the admin and
+// public driver lists. Malformed numbers are ignored.
+func ParseDriverFilter(c *gin.Context) ports.DriverFilter {
+	filter := ports.DriverFilter{
+		LicenseCategory: entities.DriverLicenseCategory(c.Query("license")),
+	}
+	if v, err := strconv.ParseInt(c.Query("min_experience"), 10, 64); err == nil {
+		filter.MinExperience = v
+	}
+	if v := c.Query("languages"); v != "" {
+		filter.Languages = strings.Split(v, ",")
+	}
+	if v, err := strconv.ParseInt(c.Query("car_id"), 10, 64); err == nil {
+		filter.CarID = v
+	}
+	if v, err := strconv.ParseInt(c.Query("category_id"), 10, 64); err == nil {
+		filter.CategoryID = v
+	}
+	return filter
 }
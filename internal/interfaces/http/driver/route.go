@@ -2,6 +2,7 @@ package driver
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
@@ -10,11 +11,27 @@ func RegisterRoutes(router *gin.RouterGroup, handler *DriverHandler, tokenSvc po
 	drivers := router.Group("/v1/drivers")
 	drivers.Use(middleware.AuthMiddleware(tokenSvc))
 	{
-		drivers.POST("", handler.CreateDriver)
 		drivers.GET("/:id", handler.GetDriverByID)
 		drivers.GET("", handler.ListDrivers)
-		drivers.PUT("/:id", handler.UpdateDriver)
-		drivers.DELETE("/:id", handler.DeleteDriver)
-		drivers.PUT("/:id/photo", handler.UploadDriverPhoto)
+	}
+
+	// Availability is planned by managers as well as admins
+	dispatch := drivers.Group("", middleware.RequireRoles(entities.UserRoleAdmin, entities.UserRoleManager))
+	{
+		dispatch.GET("/available", handler.ListAvailableDrivers)
+		dispatch.GET("/:id/schedule", handler.GetDriverSchedule)
+		dispatch.PUT("/:id/schedule", handler.SetDriverSchedule)
+		dispatch.GET("/:id/calendar", handler.GetDriverCalendar)
+		dispatch.POST("/:id/days-off", handler.CreateDriverDayOff)
+		dispatch.DELETE("/:id/days-off/:day_off_id", handler.DeleteDriverDayOff)
+	}
+
+	// Admin-only endpoints
+	admin := drivers.Group("", middleware.RequireRoles(entities.UserRoleAdmin))
+	{
+		admin.POST("", handler.CreateDriver)
+		admin.PUT("/:id", handler.UpdateDriver)
+		admin.DELETE("/:id", handler.DeleteDriver)
+		admin.PUT("/:id/photo", handler.UploadDriverPhoto)
 	}
 }
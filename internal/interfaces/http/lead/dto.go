@@ -1,15 +1,68 @@
 package lead
 
-import "time"
+import (
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
 
 type CreateLeadRequest struct {
-	FullName  string    `json:"full_name" binding:"required"`
-	Phone     string    `json:"phone" binding:"required"`
-	StartDate time.Time `json:"start_date" binding:"required"`
-	EndDate   time.Time `json:"end_date" binding:"required"`
+	FullName   string    `json:"full_name" binding:"required"`
+	Phone      string    `json:"phone" binding:"required"`
+	StartDate  time.Time `json:"start_date" binding:"required"`
+	EndDate    time.Time `json:"end_date" binding:"required"`
+	CarID      *int64    `json:"car_id"`
+	WithDriver bool      `json:"with_driver"`
+}
+
+type SubmitLeadRequest struct {
+	FullName     string    `json:"full_name" binding:"required"`
+	Phone        string    `json:"phone" binding:"required"`
+	StartDate    time.Time `json:"start_date" binding:"required"`
+	EndDate      time.Time `json:"end_date" binding:"required"`
+	CarID        *int64    `json:"car_id"`
+	WithDriver   bool      `json:"with_driver"`
+	CaptchaToken string    `json:"captcha_token"`
+	// Website is a honeypot: the form hides it, so only bots fill it in
+	Website string `json:"website"`
+}
+
+type SubmitLeadResponse struct {
+	Message string `json:"message"`
 }
 
 type ListLeadsResponse struct {
-	Total int64 `json:"total"`
+	Total int64       `json:"total"`
 	Data  interface{} `json:"data"`
 }
+
+type UpdateLeadStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=new contacted quoted won lost" example:"contacted"`
+	// Reason is required when the lead is lost
+	Reason string `json:"reason" example:"Выбрал другую компанию"`
+}
+
+type AssignLeadRequest struct {
+	// AssigneeID is the admin or manager to assign; null unassigns the lead
+	AssigneeID *int64 `json:"assignee_id" example:"2"`
+}
+
+type CreateLeadNoteRequest struct {
+	Body string `json:"body" binding:"required" example:"Перезвонить завтра после 12:00"`
+}
+
+type ListLeadHistoryResponse struct {
+	Data []*entities.LeadStatusChange `json:"data"`
+}
+
+type ListLeadNotesResponse struct {
+	Data []*entities.LeadNote `json:"data"`
+}
+
+type MergeLeadsRequest struct {
+	SourceIDs []int64 `json:"source_ids" binding:"required,min=1,max=10" example:"12,15"`
+}
+
+type ListLeadDuplicatesResponse struct {
+	Data []*entities.Lead `json:"data"`
+}
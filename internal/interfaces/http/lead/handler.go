@@ -2,10 +2,15 @@ package lead
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/lead"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 	"github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
 )
 
 type LeadHandler struct {
@@ -13,6 +18,14 @@ type LeadHandler struct {
 	getLeadByIdUsecase usecasePorts.GetLeadByIdUsecase
 	listLeadsUsecase   usecasePorts.ListLeadsUsecase
 	deleteLeadUsecase  usecasePorts.DeleteLeadUsecase
+	submitLeadUsecase  usecasePorts.SubmitLeadUsecase
+	updateStatus       usecasePorts.UpdateLeadStatusUsecase
+	assignLead         usecasePorts.AssignLeadUsecase
+	getHistory         usecasePorts.GetLeadHistoryUsecase
+	addNote            usecasePorts.AddLeadNoteUsecase
+	listNotes          usecasePorts.ListLeadNotesUsecase
+	listDuplicates     usecasePorts.ListLeadDuplicatesUsecase
+	mergeLeads         usecasePorts.MergeLeadsUsecase
 }
 
 func NewLeadHandler(
@@ -20,12 +33,28 @@ func NewLeadHandler(
 	getLeadByIdUsecase usecasePorts.GetLeadByIdUsecase,
 	listLeadsUsecase usecasePorts.ListLeadsUsecase,
 	deleteLeadUsecase usecasePorts.DeleteLeadUsecase,
+	submitLeadUsecase usecasePorts.SubmitLeadUsecase,
+	updateStatus usecasePorts.UpdateLeadStatusUsecase,
+	assignLead usecasePorts.AssignLeadUsecase,
+	getHistory usecasePorts.GetLeadHistoryUsecase,
+	addNote usecasePorts.AddLeadNoteUsecase,
+	listNotes usecasePorts.ListLeadNotesUsecase,
+	listDuplicates usecasePorts.ListLeadDuplicatesUsecase,
+	mergeLeads usecasePorts.MergeLeadsUsecase,
 ) *LeadHandler {
 	return &LeadHandler{
 		createLeadUsecase:  createLeadUsecase,
 		getLeadByIdUsecase: getLeadByIdUsecase,
 		listLeadsUsecase:   listLeadsUsecase,
 		deleteLeadUsecase:  deleteLeadUsecase,
+		submitLeadUsecase:  submitLeadUsecase,
+		updateStatus:       updateStatus,
+		assignLead:         assignLead,
+		getHistory:         getHistory,
+		addNote:            addNote,
+		listNotes:          listNotes,
+		listDuplicates:     listDuplicates,
+		mergeLeads:         mergeLeads,
 	}
 }
 
@@ -46,7 +75,14 @@ func (h *LeadHandler) CreateLead(c *gin.Context) {
 		return
 	}
 
-	lead, err := h.createLeadUsecase.Execute(c.Request.Context(), req.FullName, req.Phone, req.StartDate, req.EndDate)
+	lead, err := h.createLeadUsecase.Execute(c.Request.Context(), usecasePorts.CreateLeadInput{
+		FullName:   req.FullName,
+		Phone:      req.Phone,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		CarID:      req.CarID,
+		WithDriver: req.WithDriver,
+	})
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -83,12 +119,17 @@ func (h *LeadHandler) GetLeadByID(c *gin.Context) {
 
 // ListLeads godoc
 // @Summary List leads
-// @Description Get a paginated list of leads
+// @Description Get a paginated list of leads, newest first, optionally filtered by status, assignee and creation date. Leads merged into another lead are not listed; likely duplicates carry duplicate_of_id.
 // @Tags Leads
 // @Accept json
 // @Produce json
 // @Param offset query int false "Offset for pagination" default(0)
 // @Param limit query int false "Limit for pagination" default(20)
+// @Param status query string false "Lead status" Enums(new, contacted, quoted, won, lost)
+// @Param assignee_id query int false "Filter by assigned user ID; 0 lists unassigned leads"
+// @Param created_from query string false "Created at or after (YYYY-MM-DD or RFC 3339)"
+// @Param created_to query string false "Created before (RFC 3339) or on (YYYY-MM-DD)"
+// @Param duplicates query bool false "Only leads flagged as likely duplicates"
 // @Success 200 {object} ListLeadsResponse
 // @Router /v1/leads [get]
 // @Security     BearerAuth
@@ -103,7 +144,13 @@ func (h *LeadHandler) ListLeads(c *gin.Context) {
 		limit = l
 	}
 
-	total, leads, err := h.listLeadsUsecase.Execute(c.Request.Context(), offset, limit)
+	filter, err := parseLeadFilter(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	total, leads, err := h.listLeadsUsecase.Execute(c.Request.Context(), offset, limit, filter)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -140,3 +187,298 @@ func (h *LeadHandler) DeleteLead(c *gin.Context) {
 
 	c.JSON(200, gin.H{"message": "Lead deleted successfully"})
 }
+
+// SubmitLead godoc
+// @Summary Submit a rental inquiry
+// @Description Anonymous lead submission for the website form. Protected by captcha, a honeypot field and per-IP/per-phone rate limits
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param lead body SubmitLeadRequest true "Inquiry data"
+// @Success 201 {object} SubmitLeadResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /v1/public/leads [post]
+func (h *LeadHandler) SubmitLead(c *gin.Context) {
+	var req SubmitLeadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	_, err := h.submitLeadUsecase.Execute(c.Request.Context(), usecasePorts.SubmitLeadInput{
+		FullName:     req.FullName,
+		Phone:        req.Phone,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		CarID:        req.CarID,
+		WithDriver:   req.WithDriver,
+		CaptchaToken: req.CaptchaToken,
+		Honeypot:     req.Website,
+		RemoteIP:     c.ClientIP(),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, SubmitLeadResponse{Message: "Заявка принята, мы свяжемся с вами в ближайшее время"})
+}
+
+// UpdateLeadStatus godoc
+// @Summary Change lead status
+// @Description Move a lead through the pipeline: new → contacted → quoted → won/lost. A lead can be lost from any open status, which requires a reason. Every change is recorded in the lead history
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param status body UpdateLeadStatusRequest true "New status"
+// @Success 200 {object} entities.Lead
+// @Router /v1/leads/{id}/status [patch]
+// @Security     BearerAuth
+func (h *LeadHandler) UpdateLeadStatus(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	var req UpdateLeadStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	userID := c.GetInt64(middleware.ContextUserIDKey)
+	lead, err := h.updateStatus.Execute(c.Request.Context(), id, entities.LeadStatus(req.Status), req.Reason, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, lead)
+}
+
+// AssignLead godoc
+// @Summary Assign lead
+// @Description Assign a lead to an admin or manager, or unassign it with a null assignee_id
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param assignee body AssignLeadRequest true "Assignee"
+// @Success 200 {object} entities.Lead
+// @Router /v1/leads/{id}/assignee [patch]
+// @Security     BearerAuth
+func (h *LeadHandler) AssignLead(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	var req AssignLeadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	lead, err := h.assignLead.Execute(c.Request.Context(), id, req.AssigneeID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, lead)
+}
+
+// GetLeadHistory godoc
+// @Summary Get lead status history
+// @Description List every status change of a lead and of the leads merged into it, oldest first
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} ListLeadHistoryResponse
+// @Router /v1/leads/{id}/history [get]
+// @Security     BearerAuth
+func (h *LeadHandler) GetLeadHistory(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	history, err := h.getHistory.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, ListLeadHistoryResponse{Data: history})
+}
+
+// AddLeadNote godoc
+// @Summary Add lead note
+// @Description Leave a timestamped note on a lead on behalf of the current user
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param note body CreateLeadNoteRequest true "Note"
+// @Success 201 {object} entities.LeadNote
+// @Router /v1/leads/{id}/notes [post]
+// @Security     BearerAuth
+func (h *LeadHandler) AddLeadNote(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	var req CreateLeadNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	userID := c.GetInt64(middleware.ContextUserIDKey)
+	note, err := h.addNote.Execute(c.Request.Context(), id, userID, req.Body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, note)
+}
+
+// ListLeadNotes godoc
+// @Summary List lead notes
+// @Description List notes left on a lead and on the leads merged into it, oldest first
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} ListLeadNotesResponse
+// @Router /v1/leads/{id}/notes [get]
+// @Security     BearerAuth
+func (h *LeadHandler) ListLeadNotes(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	notes, err := h.listNotes.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, ListLeadNotesResponse{Data: notes})
+}
+
+// ListLeadDuplicates godoc
+// @Summary List likely duplicates of a lead
+// @Description List the other leads of the duplicate group of a lead: the lead it duplicates and the leads flagged against the same one, oldest first
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} ListLeadDuplicatesResponse
+// @Router /v1/leads/{id}/duplicates [get]
+// @Security     BearerAuth
+func (h *LeadHandler) ListLeadDuplicates(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	duplicates, err := h.listDuplicates.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, ListLeadDuplicatesResponse{Data: duplicates})
+}
+
+// MergeLeads godoc
+// @Summary Merge leads
+// @Description Merge the source leads into the lead. The lead keeps its data and takes the car and assignee from a source when it has none. Sources leave the lead list; their status history and notes are shown on the lead, and a note records the merge.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Target lead ID"
+// @Param merge body MergeLeadsRequest true "Leads to merge"
+// @Success 200 {object} entities.Lead
+// @Router /v1/leads/{id}/merge [post]
+// @Security     BearerAuth
+func (h *LeadHandler) MergeLeads(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid lead ID"))
+		return
+	}
+
+	var req MergeLeadsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	userID := c.GetInt64(middleware.ContextUserIDKey)
+	lead, err := h.mergeLeads.Execute(c.Request.Context(), id, req.SourceIDs, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, lead)
+}
+
+func parseLeadFilter(c *gin.Context) (ports.LeadFilter, error) {
+	filter := ports.LeadFilter{
+		Status: entities.LeadStatus(c.Query("status")),
+	}
+
+	if v := c.Query("assignee_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат assignee_id")
+		}
+		filter.AssigneeID = id
+		filter.Unassigned = id == 0
+	}
+
+	if v := c.Query("duplicates"); v != "" {
+		duplicates, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат duplicates")
+		}
+		filter.DuplicatesOnly = duplicates
+	}
+
+	if v := c.Query("created_from"); v != "" {
+		t, err := utils.ParseDateOrTime(v)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат created_from")
+		}
+		filter.CreatedFrom = t
+	}
+	if v := c.Query("created_to"); v != "" {
+		t, err := utils.ParseDateOrTime(v)
+		if err != nil {
+			return filter, errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат created_to")
+		}
+		// A plain date includes the whole day
+		if len(v) == len(time.DateOnly) {
+			t = t.AddDate(0, 0, 1)
+		}
+		filter.CreatedTo = t
+	}
+
+	return filter, nil
+}
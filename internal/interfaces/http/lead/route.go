@@ -2,17 +2,30 @@ package lead
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
 )
 
 func RegisterRoutes(router *gin.RouterGroup, handler *LeadHandler, tokenSvc ports.TokenService) {
+	router.POST("/v1/public/leads", handler.SubmitLead)
+
 	leads := router.Group("/v1/leads")
-	leads.Use(middleware.AuthMiddleware(tokenSvc))
+	leads.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin, entities.UserRoleManager),
+	)
 	{
 		leads.POST("", handler.CreateLead)
 		leads.GET("/:id", handler.GetLeadByID)
 		leads.GET("", handler.ListLeads)
 		leads.DELETE("/:id", handler.DeleteLead)
+		leads.PATCH("/:id/status", handler.UpdateLeadStatus)
+		leads.PATCH("/:id/assignee", handler.AssignLead)
+		leads.GET("/:id/history", handler.GetLeadHistory)
+		leads.POST("/:id/notes", handler.AddLeadNote)
+		leads.GET("/:id/notes", handler.ListLeadNotes)
+		leads.GET("/:id/duplicates", handler.ListLeadDuplicates)
+		leads.POST("/:id/merge", handler.MergeLeads)
 	}
 }
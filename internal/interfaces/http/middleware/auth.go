@@ -2,10 +2,16 @@ package middleware
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
 	"github.com/nomad-pixel/imperial/internal/domain/ports"
 	apperrors "github.com/nomad-pixel/imperial/pkg/errors"
 )
 
+const (
+	ContextUserIDKey   = "user_id"
+	ContextUserRoleKey = "user_role"
+)
+
 func AuthMiddleware(tokenSvc ports.TokenService) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
@@ -26,13 +32,38 @@ func AuthMiddleware(tokenSvc ports.TokenService) gin.HandlerFunc {
 			return
 		}
 
-		userID, err := tokenSvc.ValidateAccessToken(token)
+		claims, err := tokenSvc.ValidateAccessToken(token)
 		if err != nil {
 			_ = c.Error(err)
 			c.Abort()
 			return
 		}
-		c.Set("user_id", userID)
+		c.Set(ContextUserIDKey, claims.UserID)
+		c.Set(ContextUserRoleKey, claims.Role)
 		c.Next()
 	}
 }
+
+// RequireRoles allows the request through only if the authenticated user has
+// one of the given roles. It must be registered after AuthMiddleware.
+func RequireRoles(roles ...entities.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := c.Get(ContextUserRoleKey)
+		if !ok {
+			_ = c.Error(apperrors.ErrUnauthorized)
+			c.Abort()
+			return
+		}
+
+		userRole, _ := role.(entities.UserRole)
+		for _, allowed := range roles {
+			if userRole == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		_ = c.Error(apperrors.ErrForbidden)
+		c.Abort()
+	}
+}
@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+type SeasonalRateRequest struct {
+	Name             string    `json:"name" binding:"required" example:"Новогодние праздники"`
+	StartDate        time.Time `json:"start_date" binding:"required" example:"2026-12-28T00:00:00Z"`
+	EndDate          time.Time `json:"end_date" binding:"required" example:"2027-01-08T00:00:00Z"`
+	SurchargePercent int64     `json:"surcharge_percent" example:"30"`
+}
+
+type CreateRatePlanRequest struct {
+	Name                    string                `json:"name" binding:"required" example:"Бизнес-класс"`
+	CarID                   *int64                `json:"car_id" example:"1"`
+	CategoryID              *int64                `json:"category_id"`
+	ShortDailyRate          int64                 `json:"short_daily_rate" binding:"min=0" example:"15000"`
+	MediumDailyRate         int64                 `json:"medium_daily_rate" binding:"min=0" example:"13000"`
+	LongDailyRate           int64                 `json:"long_daily_rate" binding:"min=0" example:"11000"`
+	WeekendSurchargePercent int64                 `json:"weekend_surcharge_percent" binding:"min=0" example:"10"`
+	ChauffeurDailyRate      int64                 `json:"chauffeur_daily_rate" binding:"min=0" example:"5000"`
+	Seasons                 []SeasonalRateRequest `json:"seasons" binding:"dive"`
+}
+
+type UpdateRatePlanRequest struct {
+	Name                    string                `json:"name" binding:"required" example:"Бизнес-класс"`
+	ShortDailyRate          int64                 `json:"short_daily_rate" binding:"min=0" example:"15000"`
+	MediumDailyRate         int64                 `json:"medium_daily_rate" binding:"min=0" example:"13000"`
+	LongDailyRate           int64                 `json:"long_daily_rate" binding:"min=0" example:"11000"`
+	WeekendSurchargePercent int64                 `json:"weekend_surcharge_percent" binding:"min=0" example:"10"`
+	ChauffeurDailyRate      int64                 `json:"chauffeur_daily_rate" binding:"min=0" example:"5000"`
+	Seasons                 []SeasonalRateRequest `json:"seasons" binding:"dive"`
+}
+
+type ListRatePlansResponse struct {
+	Total int64                `json:"total"`
+	Data  []*entities.RatePlan `json:"data"`
+}
+
+func toSeasons(reqs []SeasonalRateRequest) []*entities.SeasonalRate {
+	seasons := make([]*entities.SeasonalRate, 0, len(reqs))
+	for _, req := range reqs {
+		seasons = append(seasons, &entities.SeasonalRate{
+			Name:             req.Name,
+			StartDate:        req.StartDate,
+			EndDate:          req.EndDate,
+			SurchargePercent: req.SurchargePercent,
+		})
+	}
+	return seasons
+}
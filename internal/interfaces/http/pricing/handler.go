@@ -0,0 +1,233 @@
+package pricing
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/pricing"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+	"github.com/nomad-pixel/imperial/pkg/utils"
+)
+
+type PricingHandler struct {
+	createRatePlan  usecasePorts.CreateRatePlanUsecase
+	getRatePlanById usecasePorts.GetRatePlanByIdUsecase
+	listRatePlans   usecasePorts.ListRatePlansUsecase
+	updateRatePlan  usecasePorts.UpdateRatePlanUsecase
+	deleteRatePlan  usecasePorts.DeleteRatePlanUsecase
+	getQuote        usecasePorts.GetPriceQuoteUsecase
+}
+
+func NewPricingHandler(
+	createRatePlan usecasePorts.CreateRatePlanUsecase,
+	getRatePlanById usecasePorts.GetRatePlanByIdUsecase,
+	listRatePlans usecasePorts.ListRatePlansUsecase,
+	updateRatePlan usecasePorts.UpdateRatePlanUsecase,
+	deleteRatePlan usecasePorts.DeleteRatePlanUsecase,
+	getQuote usecasePorts.GetPriceQuoteUsecase,
+) *PricingHandler {
+	return &PricingHandler{
+		createRatePlan:  createRatePlan,
+		getRatePlanById: getRatePlanById,
+		listRatePlans:   listRatePlans,
+		updateRatePlan:  updateRatePlan,
+		deleteRatePlan:  deleteRatePlan,
+		getQuote:        getQuote,
+	}
+}
+
+// CreateRatePlan godoc
+// @Summary      Создание тарифа
+// @Description  Создает тариф для автомобиля (car_id) или категории (category_id). Тариф автомобиля приоритетнее тарифа категории
+// @Tags         Pricing
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateRatePlanRequest true "Параметры тарифа"
+// @Success      201 {object}  entities.RatePlan  "Тариф создан"
+// @Security     BearerAuth
+// @Router       /v1/rate-plans [post]
+func (h *PricingHandler) CreateRatePlan(c *gin.Context) {
+	var req CreateRatePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	plan, err := h.createRatePlan.Execute(c.Request.Context(), usecasePorts.RatePlanInput{
+		Name:                    req.Name,
+		CarID:                   req.CarID,
+		CategoryID:              req.CategoryID,
+		ShortDailyRate:          req.ShortDailyRate,
+		MediumDailyRate:         req.MediumDailyRate,
+		LongDailyRate:           req.LongDailyRate,
+		WeekendSurchargePercent: req.WeekendSurchargePercent,
+		ChauffeurDailyRate:      req.ChauffeurDailyRate,
+		Seasons:                 toSeasons(req.Seasons),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, plan)
+}
+
+// GetRatePlanByID godoc
+// @Summary      Получение тарифа
+// @Tags         Pricing
+// @Produce      json
+// @Param        id path int true "ID тарифа"
+// @Success      200 {object}  entities.RatePlan  "Тариф"
+// @Security     BearerAuth
+// @Router       /v1/rate-plans/{id} [get]
+func (h *PricingHandler) GetRatePlanByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID тарифа"))
+		return
+	}
+
+	plan, err := h.getRatePlanById.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// ListRatePlans godoc
+// @Summary      Список тарифов
+// @Tags         Pricing
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  ListRatePlansResponse  "Список тарифов"
+// @Security     BearerAuth
+// @Router       /v1/rate-plans [get]
+func (h *PricingHandler) ListRatePlans(c *gin.Context) {
+	offset := int64(0)
+	limit := int64(20)
+
+	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil {
+		offset = o
+	}
+	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil {
+		limit = l
+	}
+
+	total, plans, err := h.listRatePlans.Execute(c.Request.Context(), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ListRatePlansResponse{
+		Total: total,
+		Data:  plans,
+	})
+}
+
+// UpdateRatePlan godoc
+// @Summary      Обновление тарифа
+// @Description  Заменяет ставки и сезоны тарифа. Привязку к автомобилю или категории изменить нельзя
+// @Tags         Pricing
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "ID тарифа"
+// @Param        request body UpdateRatePlanRequest true "Параметры тарифа"
+// @Success      200 {object}  entities.RatePlan  "Тариф обновлен"
+// @Security     BearerAuth
+// @Router       /v1/rate-plans/{id} [put]
+func (h *PricingHandler) UpdateRatePlan(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID тарифа"))
+		return
+	}
+
+	var req UpdateRatePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	plan, err := h.updateRatePlan.Execute(c.Request.Context(), id, usecasePorts.RatePlanInput{
+		Name:                    req.Name,
+		ShortDailyRate:          req.ShortDailyRate,
+		MediumDailyRate:         req.MediumDailyRate,
+		LongDailyRate:           req.LongDailyRate,
+		WeekendSurchargePercent: req.WeekendSurchargePercent,
+		ChauffeurDailyRate:      req.ChauffeurDailyRate,
+		Seasons:                 toSeasons(req.Seasons),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// DeleteRatePlan godoc
+// @Summary      Удаление тарифа
+// @Tags         Pricing
+// @Produce      json
+// @Param        id path int true "ID тарифа"
+// @Success      200 {object}  map[string]string  "Тариф удален"
+// @Security     BearerAuth
+// @Router       /v1/rate-plans/{id} [delete]
+func (h *PricingHandler) DeleteRatePlan(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID тарифа"))
+		return
+	}
+
+	if err := h.deleteRatePlan.Execute(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Тариф успешно удален"})
+}
+
+// GetQuote godoc
+// @Summary      Расчет стоимости аренды
+// @Description  Возвращает детализированную стоимость аренды автомобиля за период [start_date, end_date)
+// @Tags         Pricing
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        start_date query string true "Начало аренды (YYYY-MM-DD или RFC 3339)"
+// @Param        end_date query string true "Окончание аренды (YYYY-MM-DD или RFC 3339)"
+// @Param        with_driver query bool false "С водителем"
+// @Success      200 {object}  entities.PriceQuote  "Расчет стоимости"
+// @Router       /v1/public/cars/{id}/quote [get]
+func (h *PricingHandler) GetQuote(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите корректный ID автомобиля"))
+		return
+	}
+
+	startDate, err := utils.ParseDateOrTime(c.Query("start_date"))
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат start_date"))
+		return
+	}
+	endDate, err := utils.ParseDateOrTime(c.Query("end_date"))
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат end_date"))
+		return
+	}
+	withDriver, _ := strconv.ParseBool(c.DefaultQuery("with_driver", "false"))
+
+	quote, err := h.getQuote.Execute(c.Request.Context(), carID, startDate, endDate, withDriver)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quote)
+}
@@ -0,0 +1,25 @@
+package pricing
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+)
+
+func RegisterRoutes(router gin.IRouter, handler *PricingHandler, tokenSvc ports.TokenService) {
+	router.GET("/v1/public/cars/:id/quote", handler.GetQuote)
+
+	plans := router.Group("/v1/rate-plans")
+	plans.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin),
+	)
+	{
+		plans.POST("", handler.CreateRatePlan)
+		plans.GET("", handler.ListRatePlans)
+		plans.GET("/:id", handler.GetRatePlanByID)
+		plans.PUT("/:id", handler.UpdateRatePlan)
+		plans.DELETE("/:id", handler.DeleteRatePlan)
+	}
+}
@@ -0,0 +1,338 @@
+package public
+
+import (
+	"time"
+
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+)
+
+// Public DTOs expose only the fields the marketing site needs and hide
+// timestamps and internal identifiers.
+
+type CarMarkResponse struct {
+	ID   int64  `json:"id" example:"1"`
+	Name string `json:"name" example:"Mercedes-Benz"`
+}
+
+type CarCategoryResponse struct {
+	ID   int64  `json:"id" example:"1"`
+	Name string `json:"name" example:"Business"`
+}
+
+type CarTagResponse struct {
+	ID   int64  `json:"id" example:"1"`
+	Name string `json:"name" example:"Panoramic roof"`
+}
+
+// ImageURLFunc turns a stored image path into a URL the browser can load,
+// e.g. a presigned S3 link.
+type ImageURLFunc func(imagePath string) string
+
+type ImageVariantResponse struct {
+	entities.ImageVariant
+	URL string `json:"url" example:"http://localhost:8080/uploads/cars/1700000000_s-class_card.jpg"`
+}
+
+type CarImageResponse struct {
+	ID        int64                  `json:"id" example:"1"`
+	ImagePath string                 `json:"image_path" example:"cars/1700000000_s-class_full.jpg"`
+	ImageURL  string                 `json:"image_url" example:"http://localhost:8080/uploads/cars/1700000000_s-class_full.jpg"`
+	Variants  []ImageVariantResponse `json:"variants"`
+	Position  int                    `json:"position" example:"0"`
+	IsCover   bool                   `json:"is_cover" example:"true"`
+}
+
+// CarResponse is a car in the public catalog. PricePerDay is the car's
+// effective daily price, the one the catalog filters and sorts by.
+type CarResponse struct {
+	ID             int64                  `json:"id" example:"1"`
+	Name           string                 `json:"name" example:"Mercedes-Benz S-Class"`
+	OnlyWithDriver bool                   `json:"only_with_driver" example:"false"`
+	PricePerDay    int64                  `json:"price_per_day" example:"150000"`
+	Mark           *CarMarkResponse       `json:"mark"`
+	Category       *CarCategoryResponse   `json:"category"`
+	Specs          entities.CarSpecs      `json:"specs"`
+	Tags           []CarTagResponse       `json:"tags"`
+	Images         []CarImageResponse     `json:"images"`
+	Cover          *CarImageResponse      `json:"cover"`
+	Rating         entities.RatingSummary `json:"rating"`
+	RentedBy       []CarRenterResponse    `json:"rented_by"`
+}
+
+// CarRenterResponse is a celebrity in the "Rented by" block of a car
+type CarRenterResponse struct {
+	CelebrityID   int64                  `json:"celebrity_id" example:"1"`
+	Name          string                 `json:"name" example:"John Doe"`
+	ImageURL      string                 `json:"image_url" example:"http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"`
+	ImageVariants []ImageVariantResponse `json:"image_variants"`
+	Quote         string                 `json:"quote" example:"Лучший автомобиль для деловых встреч"`
+	RentedAt      *time.Time             `json:"rented_at,omitempty"`
+}
+
+// DriverResponse is the public driver profile. License details stay internal.
+type DriverResponse struct {
+	ID                int64                  `json:"id" example:"1"`
+	FullName          string                 `json:"full_name" example:"Ivan Petrov"`
+	About             string                 `json:"about" example:"Professional chauffeur"`
+	PhotoURL          string                 `json:"photo_url" example:"drivers/1700000000_ivan_full.jpg"`
+	PhotoLink         string                 `json:"photo_link" example:"http://localhost:8080/uploads/drivers/1700000000_ivan_full.jpg"`
+	PhotoVariants     []ImageVariantResponse `json:"photo_variants"`
+	ExperienceYears   string                 `json:"experience_years" example:"10 лет"`
+	DrivingExperience int64                  `json:"driving_experience" example:"10"`
+	Languages         []string               `json:"languages" example:"ru,en"`
+	CarIDs            []int64                `json:"car_ids"`
+	CategoryIDs       []int64                `json:"category_ids"`
+	Rating            entities.RatingSummary `json:"rating"`
+}
+
+// CelebrityResponse is the public celebrity profile. Images and Cars are
+// only filled for a single celebrity.
+type CelebrityResponse struct {
+	ID            int64                    `json:"id" example:"1"`
+	Name          string                   `json:"name" example:"John Doe"`
+	Profession    string                   `json:"profession" example:"Актёр"`
+	Bio           string                   `json:"bio" example:"Народный артист, постоянный клиент с 2019 года"`
+	SocialLinks   []entities.SocialLink    `json:"social_links"`
+	IsFeatured    bool                     `json:"is_featured" example:"true"`
+	Image         string                   `json:"image" example:"celebrities/1700000000_john_full.jpg"`
+	ImageURL      string                   `json:"image_url" example:"http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"`
+	ImageVariants []ImageVariantResponse   `json:"image_variants"`
+	Images        []CelebrityImageResponse `json:"images"`
+	Cars          []RentedCarResponse      `json:"cars"`
+}
+
+type CelebrityImageResponse struct {
+	ID        int64                  `json:"id" example:"1"`
+	ImagePath string                 `json:"image_path" example:"celebrities/1700000000_john_full.jpg"`
+	ImageURL  string                 `json:"image_url" example:"http://localhost:8080/uploads/celebrities/1700000000_john_full.jpg"`
+	Variants  []ImageVariantResponse `json:"variants"`
+	Position  int                    `json:"position" example:"0"`
+}
+
+// RentedCarResponse is a car in the "Their choice" block of a celebrity
+type RentedCarResponse struct {
+	CarID    int64             `json:"car_id" example:"1"`
+	Name     string            `json:"name" example:"Mercedes-Benz S-Class"`
+	Cover    *CarImageResponse `json:"cover"`
+	Quote    string            `json:"quote" example:"Лучший автомобиль для деловых встреч"`
+	RentedAt *time.Time        `json:"rented_at,omitempty"`
+}
+
+type ReviewPhotoResponse struct {
+	Path     string                 `json:"path" example:"reviews/1700000000_photo_full.jpg"`
+	URL      string                 `json:"url" example:"http://localhost:8080/uploads/reviews/1700000000_photo_full.jpg"`
+	Variants []ImageVariantResponse `json:"variants"`
+}
+
+// ReviewResponse is an approved review. The booking and moderation details
+// stay internal.
+type ReviewResponse struct {
+	ID           int64                 `json:"id" example:"1"`
+	CarID        int64                 `json:"car_id" example:"1"`
+	DriverID     *int64                `json:"driver_id,omitempty" example:"3"`
+	AuthorName   string                `json:"author_name" example:"Айгерим"`
+	Rating       int                   `json:"rating" example:"5"`
+	DriverRating *int                  `json:"driver_rating,omitempty" example:"5"`
+	Text         string                `json:"text" example:"Отличная машина и вежливый водитель"`
+	Photos       []ReviewPhotoResponse `json:"photos"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+type ListCarsResponse struct {
+	Total  int64               `json:"total"`
+	Data   []CarResponse       `json:"data"`
+	Facets *entities.CarFacets `json:"facets"`
+}
+
+type CarSearchResultResponse struct {
+	Car       CarResponse `json:"car"`
+	Rank      float64     `json:"rank" example:"0.82"`
+	Highlight string      `json:"highlight" example:"<mark>Mercedes</mark>-Benz S-Class"`
+}
+
+type SearchCarsResponse struct {
+	Total int64                     `json:"total"`
+	Data  []CarSearchResultResponse `json:"data"`
+}
+
+type ListCarMarksResponse struct {
+	Total int64             `json:"total"`
+	Data  []CarMarkResponse `json:"data"`
+}
+
+type ListCarCategoriesResponse struct {
+	Total int64                 `json:"total"`
+	Data  []CarCategoryResponse `json:"data"`
+}
+
+type ListCarTagsResponse struct {
+	Total int64            `json:"total"`
+	Data  []CarTagResponse `json:"data"`
+}
+
+type ListDriversResponse struct {
+	Total int64            `json:"total"`
+	Data  []DriverResponse `json:"data"`
+}
+
+type ListReviewsResponse struct {
+	Total int64            `json:"total"`
+	Data  []ReviewResponse `json:"data"`
+}
+
+type ListCelebritiesResponse struct {
+	Total int64               `json:"total"`
+	Data  []CelebrityResponse `json:"data"`
+}
+
+func ToCarMarkResponse(mark *entities.CarMark) *CarMarkResponse {
+	if mark == nil {
+		return nil
+	}
+	return &CarMarkResponse{ID: mark.ID, Name: mark.Name}
+}
+
+func ToCarCategoryResponse(category *entities.CarCategory) *CarCategoryResponse {
+	if category == nil {
+		return nil
+	}
+	return &CarCategoryResponse{ID: category.ID, Name: category.Name}
+}
+
+func ToCarTagResponse(tag *entities.CarTag) CarTagResponse {
+	return CarTagResponse{ID: tag.ID, Name: tag.Name}
+}
+
+func ToImageVariantResponses(variants []entities.ImageVariant, imageURL ImageURLFunc) []ImageVariantResponse {
+	response := make([]ImageVariantResponse, 0, len(variants))
+	for _, v := range variants {
+		response = append(response, ImageVariantResponse{ImageVariant: v, URL: imageURL(v.Path)})
+	}
+	return response
+}
+
+func ToCarResponse(car *entities.Car, imageURL ImageURLFunc) CarResponse {
+	response := CarResponse{
+		ID:             car.ID,
+		Name:           car.Name,
+		OnlyWithDriver: car.OnlyWithDriver,
+		PricePerDay:    car.EffectivePricePerDay,
+		Mark:           ToCarMarkResponse(car.Mark),
+		Category:       ToCarCategoryResponse(car.Category),
+		Specs:          car.Specs,
+		Tags:           make([]CarTagResponse, 0, len(car.Tags)),
+		Images:         make([]CarImageResponse, 0, len(car.Images)),
+	}
+	for _, tag := range car.Tags {
+		response.Tags = append(response.Tags, ToCarTagResponse(tag))
+	}
+	for _, image := range car.Images {
+		response.Images = append(response.Images, ToCarImageResponse(image, imageURL))
+	}
+	if car.Cover != nil {
+		cover := ToCarImageResponse(car.Cover, imageURL)
+		response.Cover = &cover
+	}
+	response.Rating = car.Rating
+	response.RentedBy = make([]CarRenterResponse, 0, len(car.RentedBy))
+	for _, renter := range car.RentedBy {
+		response.RentedBy = append(response.RentedBy, CarRenterResponse{
+			CelebrityID:   renter.CelebrityID,
+			Name:          renter.Name,
+			ImageURL:      imageURL(renter.Image),
+			ImageVariants: ToImageVariantResponses(renter.ImageVariants, imageURL),
+			Quote:         renter.Quote,
+			RentedAt:      renter.RentedAt,
+		})
+	}
+	return response
+}
+
+func ToCarImageResponse(image *entities.CarImage, imageURL ImageURLFunc) CarImageResponse {
+	return CarImageResponse{
+		ID:        image.ID,
+		ImagePath: image.ImagePath,
+		ImageURL:  imageURL(image.ImagePath),
+		Variants:  ToImageVariantResponses(image.Variants, imageURL),
+		Position:  image.Position,
+		IsCover:   image.IsCover,
+	}
+}
+
+func ToDriverResponse(driver *entities.Driver, imageURL ImageURLFunc) DriverResponse {
+	return DriverResponse{
+		ID:                driver.ID,
+		FullName:          driver.FullName,
+		About:             driver.About,
+		PhotoURL:          driver.PhotoURL,
+		PhotoLink:         imageURL(driver.PhotoURL),
+		PhotoVariants:     ToImageVariantResponses(driver.PhotoVariants, imageURL),
+		ExperienceYears:   driver.ExperienceYears,
+		DrivingExperience: driver.Qualifications.DrivingExperience,
+		Languages:         driver.Qualifications.Languages,
+		CarIDs:            driver.Qualifications.CarIDs,
+		CategoryIDs:       driver.Qualifications.CategoryIDs,
+		Rating:            driver.Rating,
+	}
+}
+
+func ToCelebrityResponse(celebrity *entities.Celebrity, imageURL ImageURLFunc) CelebrityResponse {
+	response := CelebrityResponse{
+		ID:            celebrity.ID,
+		Name:          celebrity.Name,
+		Profession:    celebrity.Profession,
+		Bio:           celebrity.Bio,
+		SocialLinks:   celebrity.SocialLinks,
+		IsFeatured:    celebrity.IsFeatured,
+		Image:         celebrity.Image,
+		ImageURL:      imageURL(celebrity.Image),
+		ImageVariants: ToImageVariantResponses(celebrity.ImageVariants, imageURL),
+		Images:        make([]CelebrityImageResponse, 0, len(celebrity.Images)),
+		Cars:          make([]RentedCarResponse, 0, len(celebrity.Cars)),
+	}
+	for _, image := range celebrity.Images {
+		response.Images = append(response.Images, CelebrityImageResponse{
+			ID:        image.ID,
+			ImagePath: image.ImagePath,
+			ImageURL:  imageURL(image.ImagePath),
+			Variants:  ToImageVariantResponses(image.Variants, imageURL),
+			Position:  image.Position,
+		})
+	}
+	for _, car := range celebrity.Cars {
+		rented := RentedCarResponse{
+			CarID:    car.CarID,
+			Name:     car.Name,
+			Quote:    car.Quote,
+			RentedAt: car.RentedAt,
+		}
+		if car.Cover != nil {
+			cover := ToCarImageResponse(car.Cover, imageURL)
+			rented.Cover = &cover
+		}
+		response.Cars = append(response.Cars, rented)
+	}
+	return response
+}
+
+func ToReviewResponse(review *entities.Review, imageURL ImageURLFunc) ReviewResponse {
+	response := ReviewResponse{
+		ID:           review.ID,
+		CarID:        review.CarID,
+		DriverID:     review.DriverID,
+		AuthorName:   review.AuthorName,
+		Rating:       review.Rating,
+		DriverRating: review.DriverRating,
+		Text:         review.Text,
+		Photos:       make([]ReviewPhotoResponse, 0, len(review.Photos)),
+		CreatedAt:    review.CreatedAt,
+	}
+	for _, photo := range review.Photos {
+		response.Photos = append(response.Photos, ReviewPhotoResponse{
+			Path:     photo.Path,
+			URL:      imageURL(photo.Path),
+			Variants: ToImageVariantResponses(photo.Variants, imageURL),
+		})
+	}
+	return response
+}
@@ -0,0 +1,501 @@
+package public
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	carUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/car"
+	celebrityUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/celebrity"
+	driverUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/driver"
+	reviewUsecases "github.com/nomad-pixel/imperial/internal/domain/usecases/review"
+	carHandler "github.com/nomad-pixel/imperial/internal/interfaces/http/car"
+	carAvailability "github.com/nomad-pixel/imperial/internal/interfaces/http/car/availability"
+	driverHandler "github.com/nomad-pixel/imperial/internal/interfaces/http/driver"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+const maxPublicLimit = 100
+
+type PublicHandler struct {
+	getCars          carUsecases.GetListCarsUsecase
+	getCarById       carUsecases.GetCarByIdUsecase
+	getCarMarks      carUsecases.GetCarMarksListUsecase
+	getCarCategories carUsecases.GetCarCategoriesListUsecase
+	getCarTags       carUsecases.GetCarTagsListUsecase
+	listDrivers      driverUsecases.ListDriversUsecase
+	getDriverById    driverUsecases.GetDriverByIdUsecase
+	listCelebrities  celebrityUsecases.ListCelebritiesUsecase
+	getCelebrityById celebrityUsecases.GetCelebrityByIdUsecase
+	listFeatured     celebrityUsecases.ListFeaturedCelebritiesUsecase
+	getCarCalendar   carUsecases.GetCarCalendarUsecase
+	searchCars       carUsecases.SearchCarsUsecase
+	getCarFacets     carUsecases.GetCarFacetsUsecase
+	listReviews      reviewUsecases.ListReviewsUsecase
+	imageService     ports.ImageService
+}
+
+func NewPublicHandler(
+	getCars carUsecases.GetListCarsUsecase,
+	getCarById carUsecases.GetCarByIdUsecase,
+	getCarMarks carUsecases.GetCarMarksListUsecase,
+	getCarCategories carUsecases.GetCarCategoriesListUsecase,
+	getCarTags carUsecases.GetCarTagsListUsecase,
+	listDrivers driverUsecases.ListDriversUsecase,
+	getDriverById driverUsecases.GetDriverByIdUsecase,
+	listCelebrities celebrityUsecases.ListCelebritiesUsecase,
+	getCelebrityById celebrityUsecases.GetCelebrityByIdUsecase,
+	listFeatured celebrityUsecases.ListFeaturedCelebritiesUsecase,
+	getCarCalendar carUsecases.GetCarCalendarUsecase,
+	searchCars carUsecases.SearchCarsUsecase,
+	getCarFacets carUsecases.GetCarFacetsUsecase,
+	listReviews reviewUsecases.ListReviewsUsecase,
+	imageService ports.ImageService,
+) *PublicHandler {
+	return &PublicHandler{
+		getCars:          getCars,
+		getCarById:       getCarById,
+		getCarMarks:      getCarMarks,
+		getCarCategories: getCarCategories,
+		getCarTags:       getCarTags,
+		listDrivers:      listDrivers,
+		getDriverById:    getDriverById,
+		listCelebrities:  listCelebrities,
+		getCelebrityById: getCelebrityById,
+		listFeatured:     listFeatured,
+		getCarCalendar:   getCarCalendar,
+		searchCars:       searchCars,
+		getCarFacets:     getCarFacets,
+		listReviews:      listReviews,
+		imageService:     imageService,
+	}
+}
+
+// ListCars godoc
+// @Summary      Публичный список автомобилей
+// @Description  Возвращает каталог автомобилей без авторизации
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Param        name query string false "Фильтр по названию автомобиля"
+// @Param        mark_id query int false "Фильтр по ID марки автомобиля"
+// @Param        category_id query int false "Фильтр по ID категории автомобиля"
+// @Param        available_from query string false "Свободен с (YYYY-MM-DD или RFC 3339)"
+// @Param        available_to query string false "Свободен до, не включительно (YYYY-MM-DD или RFC 3339)"
+// @Param        year_from query int false "Год выпуска от"
+// @Param        year_to query int false "Год выпуска до"
+// @Param        seats_from query int false "Количество мест от"
+// @Param        seats_to query int false "Количество мест до"
+// @Param        horsepower_from query int false "Мощность (л.с.) от"
+// @Param        horsepower_to query int false "Мощность (л.с.) до"
+// @Param        engine_volume_from query number false "Объем двигателя (л) от"
+// @Param        engine_volume_to query number false "Объем двигателя (л) до"
+// @Param        acceleration_to query number false "Разгон 0–100 км/ч (с) не более"
+// @Param        transmission query string false "Коробка передач через запятую (automatic, manual, robot, cvt)"
+// @Param        fuel_type query string false "Тип топлива через запятую (petrol, diesel, hybrid, electric)"
+// @Param        drive_type query string false "Привод через запятую (fwd, rwd, awd)"
+// @Param        color query string false "Цвет"
+// @Param        price_from query int false "Цена за день от"
+// @Param        price_to query int false "Цена за день до"
+// @Param        only_with_driver query bool false "Только с водителем (true) или без водителя (false)"
+// @Param        tag_ids query string false "ID тегов через запятую"
+// @Param        tag_match query string false "Сочетание тегов: any (любой) или all (все)" Enums(any, all) default(any)
+// @Param        sort query string false "Сортировка" Enums(newest, price_asc, price_desc, popular) default(newest)
+// @Success      200 {object}  ListCarsResponse  "Список автомобилей"
+// @Router       /v1/public/cars [get]
+func (h *PublicHandler) ListCars(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	filter, err := carHandler.ParseCarListFilter(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	total, cars, err := h.getCars.Execute(c.Request.Context(), offset, limit, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	facets, err := h.getCarFacets.Execute(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCarsResponse{
+		Total:  total,
+		Data:   make([]CarResponse, 0, len(cars)),
+		Facets: facets,
+	}
+	for _, car := range cars {
+		response.Data = append(response.Data, ToCarResponse(car, h.imageService.GetFullImagePath))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SearchCars godoc
+// @Summary      Публичный поиск автомобилей
+// @Description  Полнотекстовый поиск по каталогу на русском и английском с учетом опечаток. Кириллица также ищется в латинском написании. Highlight экранирован как HTML, совпадения выделены тегом <mark>
+// @Tags         Public
+// @Produce      json
+// @Param        q query string true "Поисковый запрос"
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  SearchCarsResponse  "Результаты поиска"
+// @Router       /v1/public/cars/search [get]
+func (h *PublicHandler) SearchCars(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, results, err := h.searchCars.Execute(c.Request.Context(), c.Query("q"), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := SearchCarsResponse{
+		Total: total,
+		Data:  make([]CarSearchResultResponse, 0, len(results)),
+	}
+	for _, result := range results {
+		response.Data = append(response.Data, CarSearchResultResponse{
+			Car:       ToCarResponse(result.Car, h.imageService.GetFullImagePath),
+			Rank:      result.Rank,
+			Highlight: result.Highlight,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCarByID godoc
+// @Summary      Публичная карточка автомобиля
+// @Description  Возвращает автомобиль с изображениями, тегами, маркой и категорией
+// @Tags         Public
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Success      200 {object}  CarResponse  "Информация об автомобиле"
+// @Router       /v1/public/cars/{id} [get]
+func (h *PublicHandler) GetCarByID(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите ID автомобиля"))
+		return
+	}
+
+	car, err := h.getCarById.Execute(c.Request.Context(), carID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToCarResponse(car, h.imageService.GetFullImagePath))
+}
+
+// GetCarCalendar godoc
+// @Summary      Публичный календарь занятости автомобиля
+// @Description  Возвращает занятые интервалы автомобиля за месяц для выбора дат на сайте
+// @Tags         Public
+// @Produce      json
+// @Param        id path int true "ID автомобиля"
+// @Param        month query string false "Месяц в формате YYYY-MM, по умолчанию текущий"
+// @Success      200 {object}  entities.CarCalendar  "Календарь занятости"
+// @Router       /v1/public/cars/{id}/calendar [get]
+func (h *PublicHandler) GetCarCalendar(c *gin.Context) {
+	carID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите ID автомобиля"))
+		return
+	}
+
+	month, err := carAvailability.ParseCalendarMonth(c.Query("month"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	calendar, err := h.getCarCalendar.Execute(c.Request.Context(), carID, month.Year(), month.Month())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
+
+// ListCarMarks godoc
+// @Summary      Публичный список марок
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  ListCarMarksResponse  "Список марок"
+// @Router       /v1/public/car-marks [get]
+func (h *PublicHandler) ListCarMarks(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, marks, err := h.getCarMarks.Execute(c.Request.Context(), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCarMarksResponse{
+		Total: total,
+		Data:  make([]CarMarkResponse, 0, len(marks)),
+	}
+	for _, mark := range marks {
+		response.Data = append(response.Data, *ToCarMarkResponse(mark))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListCarCategories godoc
+// @Summary      Публичный список категорий
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  ListCarCategoriesResponse  "Список категорий"
+// @Router       /v1/public/car-categories [get]
+func (h *PublicHandler) ListCarCategories(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, categories, err := h.getCarCategories.Execute(c.Request.Context(), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCarCategoriesResponse{
+		Total: total,
+		Data:  make([]CarCategoryResponse, 0, len(categories)),
+	}
+	for _, category := range categories {
+		response.Data = append(response.Data, *ToCarCategoryResponse(category))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListCarTags godoc
+// @Summary      Публичный список тегов
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  ListCarTagsResponse  "Список тегов"
+// @Router       /v1/public/car-tags [get]
+func (h *PublicHandler) ListCarTags(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, tags, err := h.getCarTags.Execute(c.Request.Context(), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCarTagsResponse{
+		Total: total,
+		Data:  make([]CarTagResponse, 0, len(tags)),
+	}
+	for _, tag := range tags {
+		response.Data = append(response.Data, ToCarTagResponse(tag))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListDrivers godoc
+// @Summary      Публичный список водителей
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Param        min_experience query int false "Минимальный стаж вождения, лет"
+// @Param        languages query string false "Языки через запятую (ISO 639-1), водитель должен владеть всеми, например en,ru"
+// @Param        license query string false "Действующая категория прав" Enums(A, B, BE, C, CE, D, DE, A1, B1, C1, C1E, D1, D1E)
+// @Param        car_id query int false "Допущен к автомобилю напрямую или через его категорию"
+// @Param        category_id query int false "Допущен к категории автомобилей"
+// @Success      200 {object}  ListDriversResponse  "Список водителей"
+// @Router       /v1/public/drivers [get]
+func (h *PublicHandler) ListDrivers(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, drivers, err := h.listDrivers.Execute(c.Request.Context(), offset, limit, driverHandler.ParseDriverFilter(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListDriversResponse{
+		Total: total,
+		Data:  make([]DriverResponse, 0, len(drivers)),
+	}
+	for _, driver := range drivers {
+		response.Data = append(response.Data, ToDriverResponse(driver, h.imageService.GetFullImagePath))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetDriverByID godoc
+// @Summary      Публичный профиль водителя
+// @Tags         Public
+// @Produce      json
+// @Param        id path int true "ID водителя"
+// @Success      200 {object}  DriverResponse  "Информация о водителе"
+// @Router       /v1/public/drivers/{id} [get]
+func (h *PublicHandler) GetDriverByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите ID водителя"))
+		return
+	}
+
+	driver, err := h.getDriverById.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToDriverResponse(driver, h.imageService.GetFullImagePath))
+}
+
+// ListCelebrities godoc
+// @Summary      Публичный список знаменитостей
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Success      200 {object}  ListCelebritiesResponse  "Список знаменитостей"
+// @Router       /v1/public/celebrities [get]
+func (h *PublicHandler) ListCelebrities(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	total, celebrities, err := h.listCelebrities.Execute(c.Request.Context(), offset, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCelebritiesResponse{
+		Total: total,
+		Data:  make([]CelebrityResponse, 0, len(celebrities)),
+	}
+	for _, celebrity := range celebrities {
+		response.Data = append(response.Data, ToCelebrityResponse(celebrity, h.imageService.GetFullImagePath))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListFeaturedCelebrities godoc
+// @Summary      Знаменитости для карусели на главной
+// @Description  Только отмеченные для главной страницы, в заданном вручную порядке
+// @Tags         Public
+// @Produce      json
+// @Success      200 {object}  ListCelebritiesResponse  "Список знаменитостей"
+// @Router       /v1/public/celebrities/featured [get]
+func (h *PublicHandler) ListFeaturedCelebrities(c *gin.Context) {
+	celebrities, err := h.listFeatured.Execute(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListCelebritiesResponse{
+		Total: int64(len(celebrities)),
+		Data:  make([]CelebrityResponse, 0, len(celebrities)),
+	}
+	for _, celebrity := range celebrities {
+		response.Data = append(response.Data, ToCelebrityResponse(celebrity, h.imageService.GetFullImagePath))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCelebrityByID godoc
+// @Summary      Публичный профиль знаменитости
+// @Tags         Public
+// @Produce      json
+// @Param        id path int true "ID знаменитости"
+// @Success      200 {object}  CelebrityResponse  "Информация о знаменитости"
+// @Router       /v1/public/celebrities/{id} [get]
+func (h *PublicHandler) GetCelebrityByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.New(errors.ErrCodeValidation, "Укажите ID знаменитости"))
+		return
+	}
+
+	celebrity, err := h.getCelebrityById.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToCelebrityResponse(celebrity, h.imageService.GetFullImagePath))
+}
+
+// ListReviews godoc
+// @Summary      Одобренные отзывы клиентов
+// @Description  Только прошедшие модерацию отзывы, новые первыми
+// @Tags         Public
+// @Produce      json
+// @Param        offset query int false "Смещение для пагинации" default(0)
+// @Param        limit query int false "Лимит для пагинации" default(20)
+// @Param        car_id query int false "Отзывы об автомобиле"
+// @Param        driver_id query int false "Отзывы о водителе"
+// @Success      200 {object}  ListReviewsResponse  "Список отзывов"
+// @Router       /v1/public/reviews [get]
+func (h *PublicHandler) ListReviews(c *gin.Context) {
+	offset, limit := parsePagination(c)
+
+	filter := ports.ReviewFilter{Status: entities.ReviewStatusApproved}
+	if carID, err := strconv.ParseInt(c.Query("car_id"), 10, 64); err == nil {
+		filter.CarID = carID
+	}
+	if driverID, err := strconv.ParseInt(c.Query("driver_id"), 10, 64); err == nil {
+		filter.DriverID = driverID
+	}
+
+	total, reviews, err := h.listReviews.Execute(c.Request.Context(), offset, limit, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ListReviewsResponse{
+		Total: total,
+		Data:  make([]ReviewResponse, 0, len(reviews)),
+	}
+	for _, review := range reviews {
+		response.Data = append(response.Data, ToReviewResponse(review, h.imageService.GetFullImagePath))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parsePagination(c *gin.Context) (int64, int64) {
+	offset := int64(0)
+	limit := int64(20)
+
+	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil && o >= 0 {
+		offset = o
+	}
+	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > maxPublicLimit {
+		limit = maxPublicLimit
+	}
+
+	return offset, limit
+}
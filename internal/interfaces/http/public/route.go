@@ -0,0 +1,24 @@
+package public
+
+import "github.com/gin-gonic/gin"
+
+// RegisterRoutes mounts the unauthenticated, read-only catalog used by the
+// marketing site.
+func RegisterRoutes(router gin.IRouter, handler *PublicHandler) {
+	api := router.Group("/v1/public")
+	{
+		api.GET("/cars", handler.ListCars)
+		api.GET("/cars/search", handler.SearchCars)
+		api.GET("/cars/:id", handler.GetCarByID)
+		api.GET("/cars/:id/calendar", handler.GetCarCalendar)
+		api.GET("/car-marks", handler.ListCarMarks)
+		api.GET("/car-categories", handler.ListCarCategories)
+		api.GET("/car-tags", handler.ListCarTags)
+		api.GET("/drivers", handler.ListDrivers)
+		api.GET("/drivers/:id", handler.GetDriverByID)
+		api.GET("/celebrities", handler.ListCelebrities)
+		api.GET("/celebrities/featured", handler.ListFeaturedCelebrities)
+		api.GET("/celebrities/:id", handler.GetCelebrityByID)
+		api.GET("/reviews", handler.ListReviews)
+	}
+}
@@ -0,0 +1,31 @@
+package review
+
+// SubmitReviewRequest is the multipart form of the public review form.
+// Photos are sent as repeated "photos" file fields.
+type SubmitReviewRequest struct {
+	BookingID int64 `form:"booking_id" binding:"required"`
+	// Phone must match the customer phone on the booking
+	Phone        string `form:"phone" binding:"required"`
+	AuthorName   string `form:"author_name"`
+	Rating       int    `form:"rating" binding:"required,min=1,max=5"`
+	DriverRating *int   `form:"driver_rating" binding:"omitempty,min=1,max=5"`
+	Text         string `form:"text"`
+	CaptchaToken string `form:"captcha_token"`
+	// Website is a honeypot: the form hides it, so only bots fill it in
+	Website string `form:"website"`
+}
+
+type SubmitReviewResponse struct {
+	Message string `json:"message"`
+}
+
+type ModerateReviewRequest struct {
+	Status string `json:"status" binding:"required,oneof=approved rejected" example:"approved"`
+	// Reason is required when the review is rejected
+	Reason string `json:"reason" example:"Нецензурная лексика"`
+}
+
+type ListReviewsResponse struct {
+	Total int64       `json:"total"`
+	Data  interface{} `json:"data"`
+}
@@ -0,0 +1,234 @@
+package review
+
+import (
+	"mime/multipart"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	usecasePorts "github.com/nomad-pixel/imperial/internal/domain/usecases/review"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+	"github.com/nomad-pixel/imperial/pkg/errors"
+)
+
+type ReviewHandler struct {
+	submitReviewUsecase   usecasePorts.SubmitReviewUsecase
+	listReviewsUsecase    usecasePorts.ListReviewsUsecase
+	getReviewByIdUsecase  usecasePorts.GetReviewByIdUsecase
+	moderateReviewUsecase usecasePorts.ModerateReviewUsecase
+	deleteReviewUsecase   usecasePorts.DeleteReviewUsecase
+}
+
+func NewReviewHandler(
+	submitReviewUsecase usecasePorts.SubmitReviewUsecase,
+	listReviewsUsecase usecasePorts.ListReviewsUsecase,
+	getReviewByIdUsecase usecasePorts.GetReviewByIdUsecase,
+	moderateReviewUsecase usecasePorts.ModerateReviewUsecase,
+	deleteReviewUsecase usecasePorts.DeleteReviewUsecase,
+) *ReviewHandler {
+	return &ReviewHandler{
+		submitReviewUsecase:   submitReviewUsecase,
+		listReviewsUsecase:    listReviewsUsecase,
+		getReviewByIdUsecase:  getReviewByIdUsecase,
+		moderateReviewUsecase: moderateReviewUsecase,
+		deleteReviewUsecase:   deleteReviewUsecase,
+	}
+}
+
+// SubmitReview godoc
+// @Summary Submit a review
+// @Description Anonymous feedback on a completed booking, identified by the booking ID and the customer phone. Up to 5 photos can be attached. The review is published once a manager approves it. Protected by captcha, a honeypot field and rate limits per IP and per booking
+// @Tags Reviews
+// @Accept multipart/form-data
+// @Produce json
+// @Param booking_id formData int true "Booking ID"
+// @Param phone formData string true "Customer phone from the booking"
+// @Param author_name formData string false "Name shown with the review, defaults to the booking customer name"
+// @Param rating formData int true "Rating of the rental and the car, 1-5"
+// @Param driver_rating formData int false "Rating of the driver, 1-5; only for bookings with a driver"
+// @Param text formData string false "Review text"
+// @Param captcha_token formData string false "Captcha token"
+// @Param website formData string false "Honeypot, leave empty"
+// @Param photos formData file false "Photos, repeat the field for each file"
+// @Success 201 {object} SubmitReviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /v1/public/reviews [post]
+func (h *ReviewHandler) SubmitReview(c *gin.Context) {
+	var req SubmitReviewRequest
+	if err := c.ShouldBind(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	var files []*multipart.FileHeader
+	if form, err := c.MultipartForm(); err == nil {
+		files = form.File["photos"]
+	}
+
+	photos := make([]usecasePorts.ReviewPhotoUpload, 0, len(files))
+	for _, file := range files {
+		fileData, err := file.Open()
+		if err != nil {
+			_ = c.Error(errors.Wrap(err, errors.ErrCodeInternal, "Failed to open photo file"))
+			return
+		}
+		defer fileData.Close()
+		photos = append(photos, usecasePorts.ReviewPhotoUpload{File: fileData, FileName: file.Filename})
+	}
+
+	_, err := h.submitReviewUsecase.Execute(c.Request.Context(), usecasePorts.SubmitReviewInput{
+		BookingID:    req.BookingID,
+		Phone:        req.Phone,
+		AuthorName:   req.AuthorName,
+		Rating:       req.Rating,
+		DriverRating: req.DriverRating,
+		Text:         req.Text,
+		Photos:       photos,
+		CaptchaToken: req.CaptchaToken,
+		Honeypot:     req.Website,
+		RemoteIP:     c.ClientIP(),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(201, SubmitReviewResponse{Message: "Спасибо за отзыв! Он появится на сайте после проверки"})
+}
+
+// ListReviews godoc
+// @Summary List reviews
+// @Description Get a paginated list of reviews, newest first, optionally filtered by moderation status, car or driver
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param offset query int false "Offset for pagination" default(0)
+// @Param limit query int false "Limit for pagination" default(20)
+// @Param status query string false "Review status" Enums(pending, approved, rejected)
+// @Param car_id query int false "Filter by car ID"
+// @Param driver_id query int false "Filter by driver ID"
+// @Success 200 {object} ListReviewsResponse
+// @Router /v1/reviews [get]
+// @Security     BearerAuth
+func (h *ReviewHandler) ListReviews(c *gin.Context) {
+	offset := int64(0)
+	limit := int64(20)
+
+	if o, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64); err == nil {
+		offset = o
+	}
+	if l, err := strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64); err == nil {
+		limit = l
+	}
+
+	filter := ports.ReviewFilter{
+		Status: entities.ReviewStatus(c.Query("status")),
+	}
+	if v, err := strconv.ParseInt(c.Query("car_id"), 10, 64); err == nil {
+		filter.CarID = v
+	}
+	if v, err := strconv.ParseInt(c.Query("driver_id"), 10, 64); err == nil {
+		filter.DriverID = v
+	}
+
+	total, reviews, err := h.listReviewsUsecase.Execute(c.Request.Context(), offset, limit, filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, ListReviewsResponse{
+		Total: total,
+		Data:  reviews,
+	})
+}
+
+// GetReviewByID godoc
+// @Summary Get review by ID
+// @Description Get a review with its moderation details
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200 {object} entities.Review
+// @Router /v1/reviews/{id} [get]
+// @Security     BearerAuth
+func (h *ReviewHandler) GetReviewByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid review ID"))
+		return
+	}
+
+	review, err := h.getReviewByIdUsecase.Execute(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, review)
+}
+
+// ModerateReview godoc
+// @Summary Moderate review
+// @Description Approve or reject a review. Rejecting requires a reason, which is never shown publicly. Approved reviews count towards the car and driver rating
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param status body ModerateReviewRequest true "Moderation decision"
+// @Success 200 {object} entities.Review
+// @Router /v1/reviews/{id}/status [patch]
+// @Security     BearerAuth
+func (h *ReviewHandler) ModerateReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid review ID"))
+		return
+	}
+
+	var req ModerateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Неверный формат данных"))
+		return
+	}
+
+	userID := c.GetInt64(middleware.ContextUserIDKey)
+	review, err := h.moderateReviewUsecase.Execute(c.Request.Context(), id, entities.ReviewStatus(req.Status), req.Reason, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, review)
+}
+
+// DeleteReview godoc
+// @Summary Delete review
+// @Description Delete a review and its photos
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200 {object} map[string]string
+// @Router /v1/reviews/{id} [delete]
+// @Security     BearerAuth
+func (h *ReviewHandler) DeleteReview(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Wrap(err, errors.ErrCodeValidation, "Invalid review ID"))
+		return
+	}
+
+	if err := h.deleteReviewUsecase.Execute(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(200, gin.H{"message": "Review deleted successfully"})
+}
@@ -0,0 +1,24 @@
+package review
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nomad-pixel/imperial/internal/domain/entities"
+	"github.com/nomad-pixel/imperial/internal/domain/ports"
+	"github.com/nomad-pixel/imperial/internal/interfaces/http/middleware"
+)
+
+func RegisterRoutes(router *gin.RouterGroup, handler *ReviewHandler, tokenSvc ports.TokenService) {
+	router.POST("/v1/public/reviews", handler.SubmitReview)
+
+	reviews := router.Group("/v1/reviews")
+	reviews.Use(
+		middleware.AuthMiddleware(tokenSvc),
+		middleware.RequireRoles(entities.UserRoleAdmin, entities.UserRoleManager),
+	)
+	{
+		reviews.GET("", handler.ListReviews)
+		reviews.GET("/:id", handler.GetReviewByID)
+		reviews.PATCH("/:id/status", handler.ModerateReview)
+		reviews.DELETE("/:id", handler.DeleteReview)
+	}
+}
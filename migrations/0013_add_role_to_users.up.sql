@@ -0,0 +1,9 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'user_role') THEN
+        CREATE TYPE user_role AS ENUM ('admin', 'manager', 'customer');
+    END IF;
+END$$;
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS role user_role NOT NULL DEFAULT 'customer';
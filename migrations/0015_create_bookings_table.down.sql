@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bookings;
+DROP TYPE IF EXISTS booking_status;
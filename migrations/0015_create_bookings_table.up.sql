@@ -0,0 +1,33 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'booking_status') THEN
+        CREATE TYPE booking_status AS ENUM ('pending', 'confirmed', 'active', 'completed', 'cancelled');
+    END IF;
+END$$;
+
+CREATE TABLE IF NOT EXISTS bookings (
+    id SERIAL PRIMARY KEY,
+    car_id INT NOT NULL REFERENCES cars(id) ON DELETE RESTRICT,
+    driver_id INT REFERENCES drivers(id) ON DELETE SET NULL,
+    lead_id INT REFERENCES leads(id) ON DELETE SET NULL,
+    customer_name VARCHAR(100) NOT NULL,
+    customer_phone VARCHAR(32) NOT NULL,
+    period tstzrange NOT NULL,
+    status booking_status NOT NULL DEFAULT 'pending',
+    comment VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT bookings_period_not_empty CHECK (NOT isempty(period)),
+    -- A car cannot be held by two live bookings at the same time
+    CONSTRAINT bookings_car_period_excl EXCLUDE USING gist (
+        car_id WITH =,
+        period WITH &&
+    ) WHERE (status <> 'cancelled')
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_car_id ON bookings(car_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_driver_id ON bookings(driver_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_lead_id ON bookings(lead_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_status ON bookings(status);
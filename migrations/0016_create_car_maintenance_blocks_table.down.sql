@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bookings_period;
+DROP TABLE IF EXISTS car_maintenance_blocks;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS car_maintenance_blocks (
+    id SERIAL PRIMARY KEY,
+    car_id INT NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    period tstzrange NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT car_maintenance_blocks_period_not_empty CHECK (NOT isempty(period)),
+    CONSTRAINT car_maintenance_blocks_car_period_excl EXCLUDE USING gist (
+        car_id WITH =,
+        period WITH &&
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_period ON bookings USING gist (period);
@@ -0,0 +1,11 @@
+ALTER TABLE bookings
+DROP COLUMN IF EXISTS price_quote,
+DROP COLUMN IF EXISTS total_price;
+
+ALTER TABLE leads
+DROP COLUMN IF EXISTS price_quote,
+DROP COLUMN IF EXISTS with_driver,
+DROP COLUMN IF EXISTS car_id;
+
+DROP TABLE IF EXISTS seasonal_rates;
+DROP TABLE IF EXISTS rate_plans;
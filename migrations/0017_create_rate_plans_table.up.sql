@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS rate_plans (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    car_id INT UNIQUE REFERENCES cars(id) ON DELETE CASCADE,
+    car_category_id INT UNIQUE REFERENCES car_categories(id) ON DELETE CASCADE,
+    short_daily_rate BIGINT NOT NULL CHECK (short_daily_rate >= 0),
+    medium_daily_rate BIGINT NOT NULL CHECK (medium_daily_rate >= 0),
+    long_daily_rate BIGINT NOT NULL CHECK (long_daily_rate >= 0),
+    weekend_surcharge_percent BIGINT NOT NULL DEFAULT 0,
+    chauffeur_daily_rate BIGINT NOT NULL DEFAULT 0 CHECK (chauffeur_daily_rate >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT rate_plans_target_check CHECK ((car_id IS NULL) <> (car_category_id IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS seasonal_rates (
+    id SERIAL PRIMARY KEY,
+    rate_plan_id INT NOT NULL REFERENCES rate_plans(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    surcharge_percent BIGINT NOT NULL,
+    CONSTRAINT seasonal_rates_dates_check CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seasonal_rates_rate_plan_id ON seasonal_rates(rate_plan_id);
+
+ALTER TABLE leads
+ADD COLUMN IF NOT EXISTS car_id INT REFERENCES cars(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS with_driver BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS price_quote JSONB;
+
+ALTER TABLE bookings
+ADD COLUMN IF NOT EXISTS total_price BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS price_quote JSONB;
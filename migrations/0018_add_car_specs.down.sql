@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS idx_cars_horsepower;
+DROP INDEX IF EXISTS idx_cars_seats;
+DROP INDEX IF EXISTS idx_cars_year;
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS drive_type,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS acceleration,
+    DROP COLUMN IF EXISTS horsepower,
+    DROP COLUMN IF EXISTS engine_volume,
+    DROP COLUMN IF EXISTS fuel_type,
+    DROP COLUMN IF EXISTS transmission,
+    DROP COLUMN IF EXISTS seats,
+    DROP COLUMN IF EXISTS year;
+
+DROP TYPE IF EXISTS car_drive_type;
+DROP TYPE IF EXISTS car_fuel_type;
+DROP TYPE IF EXISTS car_transmission;
@@ -0,0 +1,27 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'car_transmission') THEN
+        CREATE TYPE car_transmission AS ENUM ('automatic', 'manual', 'robot', 'cvt');
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'car_fuel_type') THEN
+        CREATE TYPE car_fuel_type AS ENUM ('petrol', 'diesel', 'hybrid', 'electric');
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'car_drive_type') THEN
+        CREATE TYPE car_drive_type AS ENUM ('fwd', 'rwd', 'awd');
+    END IF;
+END$$;
+
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS year SMALLINT CHECK (year >= 1900),
+    ADD COLUMN IF NOT EXISTS seats SMALLINT CHECK (seats > 0),
+    ADD COLUMN IF NOT EXISTS transmission car_transmission,
+    ADD COLUMN IF NOT EXISTS fuel_type car_fuel_type,
+    ADD COLUMN IF NOT EXISTS engine_volume NUMERIC(3, 1) CHECK (engine_volume > 0),
+    ADD COLUMN IF NOT EXISTS horsepower INT CHECK (horsepower > 0),
+    ADD COLUMN IF NOT EXISTS acceleration NUMERIC(4, 1) CHECK (acceleration > 0),
+    ADD COLUMN IF NOT EXISTS color VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS drive_type car_drive_type;
+
+CREATE INDEX IF NOT EXISTS idx_cars_year ON cars (year);
+CREATE INDEX IF NOT EXISTS idx_cars_seats ON cars (seats);
+CREATE INDEX IF NOT EXISTS idx_cars_horsepower ON cars (horsepower);
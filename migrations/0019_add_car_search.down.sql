@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_cars_search_document_trgm;
+DROP INDEX IF EXISTS idx_cars_search_vector;
+
+DROP TRIGGER IF EXISTS car_tags_search_refresh ON car_tags;
+DROP TRIGGER IF EXISTS car_categories_search_refresh ON car_categories;
+DROP TRIGGER IF EXISTS car_marks_search_refresh ON car_marks;
+DROP TRIGGER IF EXISTS car_car_tags_search_refresh ON car_car_tags;
+DROP TRIGGER IF EXISTS cars_search_refresh ON cars;
+
+DROP FUNCTION IF EXISTS car_dictionary_search_trigger();
+DROP FUNCTION IF EXISTS car_car_tags_search_trigger();
+DROP FUNCTION IF EXISTS cars_search_trigger();
+DROP FUNCTION IF EXISTS refresh_car_search(INT);
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS search_document;
@@ -0,0 +1,126 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS search_document TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS search_vector tsvector NOT NULL DEFAULT ''::tsvector;
+
+-- refresh_car_search rebuilds the search columns of one car from its own
+-- fields and the names of its mark, category and tags. Names are indexed with
+-- both the russian and english configurations so that "мерседес" and
+-- "mercedes" style queries stem correctly.
+CREATE OR REPLACE FUNCTION refresh_car_search(p_car_id INT) RETURNS VOID AS $$
+DECLARE
+    v_name TEXT;
+    v_mark TEXT;
+    v_category TEXT;
+    v_tags TEXT;
+    v_specs TEXT;
+BEGIN
+    SELECT
+        c.name,
+        COALESCE(cm.name, ''),
+        COALESCE(cc.name, ''),
+        concat_ws(' ',
+            c.year::text,
+            c.transmission::text,
+            c.fuel_type::text,
+            c.drive_type::text,
+            c.color,
+            CASE WHEN c.horsepower IS NOT NULL THEN c.horsepower::text || ' hp' END,
+            CASE WHEN c.engine_volume IS NOT NULL THEN c.engine_volume::text || ' l' END
+        )
+    INTO v_name, v_mark, v_category, v_specs
+    FROM cars c
+    LEFT JOIN car_marks cm ON c.car_mark_id = cm.id
+    LEFT JOIN car_categories cc ON c.car_category_id = cc.id
+    WHERE c.id = p_car_id;
+
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    SELECT COALESCE(string_agg(ct.name, ' '), '')
+    INTO v_tags
+    FROM car_tags ct
+    JOIN car_car_tags cct ON ct.id = cct.car_tag_id
+    WHERE cct.car_id = p_car_id;
+
+    UPDATE cars
+    SET
+        search_document = concat_ws(' ', v_name, v_mark, v_category, v_tags, v_specs),
+        search_vector =
+            setweight(to_tsvector('russian', v_name || ' ' || v_mark), 'A') ||
+            setweight(to_tsvector('english', v_name || ' ' || v_mark), 'A') ||
+            setweight(to_tsvector('russian', v_category), 'B') ||
+            setweight(to_tsvector('english', v_category), 'B') ||
+            setweight(to_tsvector('russian', v_tags), 'C') ||
+            setweight(to_tsvector('english', v_tags), 'C') ||
+            setweight(to_tsvector('simple', v_specs), 'D')
+    WHERE id = p_car_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION cars_search_trigger() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_car_search(NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION car_car_tags_search_trigger() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_car_search(OLD.car_id);
+    ELSE
+        PERFORM refresh_car_search(NEW.car_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION car_dictionary_search_trigger() RETURNS TRIGGER AS $$
+DECLARE
+    v_car_id INT;
+BEGIN
+    FOR v_car_id IN
+        SELECT c.id FROM cars c
+        WHERE (TG_TABLE_NAME = 'car_marks' AND c.car_mark_id = NEW.id)
+            OR (TG_TABLE_NAME = 'car_categories' AND c.car_category_id = NEW.id)
+            OR (TG_TABLE_NAME = 'car_tags' AND EXISTS (
+                SELECT 1 FROM car_car_tags cct WHERE cct.car_id = c.id AND cct.car_tag_id = NEW.id
+            ))
+    LOOP
+        PERFORM refresh_car_search(v_car_id);
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- search_document and search_vector are not in the column list, so the
+-- UPDATE issued by refresh_car_search does not fire the trigger again.
+CREATE TRIGGER cars_search_refresh
+    AFTER INSERT OR UPDATE OF name, car_mark_id, car_category_id, year, transmission,
+        fuel_type, drive_type, color, horsepower, engine_volume
+    ON cars
+    FOR EACH ROW EXECUTE FUNCTION cars_search_trigger();
+
+CREATE TRIGGER car_car_tags_search_refresh
+    AFTER INSERT OR DELETE ON car_car_tags
+    FOR EACH ROW EXECUTE FUNCTION car_car_tags_search_trigger();
+
+CREATE TRIGGER car_marks_search_refresh
+    AFTER UPDATE OF name ON car_marks
+    FOR EACH ROW EXECUTE FUNCTION car_dictionary_search_trigger();
+
+CREATE TRIGGER car_categories_search_refresh
+    AFTER UPDATE OF name ON car_categories
+    FOR EACH ROW EXECUTE FUNCTION car_dictionary_search_trigger();
+
+CREATE TRIGGER car_tags_search_refresh
+    AFTER UPDATE OF name ON car_tags
+    FOR EACH ROW EXECUTE FUNCTION car_dictionary_search_trigger();
+
+SELECT refresh_car_search(id) FROM cars;
+
+CREATE INDEX IF NOT EXISTS idx_cars_search_vector ON cars USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_cars_search_document_trgm ON cars USING gin (search_document gin_trgm_ops);
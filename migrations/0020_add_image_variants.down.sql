@@ -0,0 +1,3 @@
+ALTER TABLE celebrities DROP COLUMN IF EXISTS image_variants;
+ALTER TABLE drivers DROP COLUMN IF EXISTS photo_variants;
+ALTER TABLE car_images DROP COLUMN IF EXISTS variants;
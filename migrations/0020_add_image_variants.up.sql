@@ -0,0 +1,8 @@
+ALTER TABLE car_images
+    ADD COLUMN IF NOT EXISTS variants JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE drivers
+    ADD COLUMN IF NOT EXISTS photo_variants JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE celebrities
+    ADD COLUMN IF NOT EXISTS image_variants JSONB NOT NULL DEFAULT '[]'::jsonb;
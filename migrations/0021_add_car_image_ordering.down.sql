@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_car_images_one_cover;
+DROP INDEX IF EXISTS idx_car_images_car_id_position;
+
+ALTER TABLE car_images
+    DROP COLUMN IF EXISTS is_cover,
+    DROP COLUMN IF EXISTS position;
@@ -0,0 +1,17 @@
+ALTER TABLE car_images
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS is_cover BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing galleries keep their upload order and the oldest photo becomes
+-- the cover.
+UPDATE car_images ci
+SET position = ordered.rn - 1,
+    is_cover = ordered.rn = 1
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY car_id ORDER BY created_at, id) AS rn
+    FROM car_images
+) ordered
+WHERE ci.id = ordered.id;
+
+CREATE INDEX IF NOT EXISTS idx_car_images_car_id_position ON car_images(car_id, position);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_car_images_one_cover ON car_images(car_id) WHERE is_cover;
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS lead_notes;
+DROP TABLE IF EXISTS lead_status_history;
+
+DROP INDEX IF EXISTS idx_leads_created_at;
+DROP INDEX IF EXISTS idx_leads_assignee_id;
+DROP INDEX IF EXISTS idx_leads_status;
+
+ALTER TABLE leads
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS assignee_id,
+    DROP COLUMN IF EXISTS lost_reason,
+    DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS lead_status;
@@ -0,0 +1,40 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'lead_status') THEN
+        CREATE TYPE lead_status AS ENUM ('new', 'contacted', 'quoted', 'won', 'lost');
+    END IF;
+END$$;
+
+ALTER TABLE leads
+    ADD COLUMN IF NOT EXISTS status lead_status NOT NULL DEFAULT 'new',
+    ADD COLUMN IF NOT EXISTS lost_reason VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS assignee_id INT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE leads SET updated_at = created_at;
+
+CREATE INDEX IF NOT EXISTS idx_leads_status ON leads(status);
+CREATE INDEX IF NOT EXISTS idx_leads_assignee_id ON leads(assignee_id);
+CREATE INDEX IF NOT EXISTS idx_leads_created_at ON leads(created_at);
+
+CREATE TABLE IF NOT EXISTS lead_status_history (
+    id SERIAL PRIMARY KEY,
+    lead_id INT NOT NULL REFERENCES leads(id) ON DELETE CASCADE,
+    from_status lead_status NOT NULL,
+    to_status lead_status NOT NULL,
+    reason VARCHAR(500),
+    changed_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_lead_status_history_lead_id ON lead_status_history(lead_id);
+
+CREATE TABLE IF NOT EXISTS lead_notes (
+    id SERIAL PRIMARY KEY,
+    lead_id INT NOT NULL REFERENCES leads(id) ON DELETE CASCADE,
+    author_id INT REFERENCES users(id) ON DELETE SET NULL,
+    body VARCHAR(2000) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_lead_notes_lead_id ON lead_notes(lead_id);
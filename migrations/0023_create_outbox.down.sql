@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS outbox_messages;
+DROP TYPE IF EXISTS outbox_status;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_leads_phone;
+
+UPDATE leads SET phone = phone_raw WHERE phone_raw <> '';
+
+ALTER TABLE leads DROP COLUMN IF EXISTS phone_raw;
@@ -0,0 +1,11 @@
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS phone_raw VARCHAR(32);
+
+-- Existing numbers are kept as typed; `make normalize-phones` rewrites
+-- phone to E.164 afterwards
+UPDATE leads SET phone_raw = COALESCE(phone, '') WHERE phone_raw IS NULL;
+
+ALTER TABLE leads
+    ALTER COLUMN phone_raw SET DEFAULT '',
+    ALTER COLUMN phone_raw SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_leads_phone ON leads(phone);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_leads_merged_into_id;
+DROP INDEX IF EXISTS idx_leads_duplicate_of_id;
+
+ALTER TABLE leads
+    DROP COLUMN IF EXISTS merged_into_id,
+    DROP COLUMN IF EXISTS duplicate_of_id;
@@ -0,0 +1,6 @@
+ALTER TABLE leads
+    ADD COLUMN IF NOT EXISTS duplicate_of_id INT REFERENCES leads(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS merged_into_id INT REFERENCES leads(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_leads_duplicate_of_id ON leads(duplicate_of_id);
+CREATE INDEX IF NOT EXISTS idx_leads_merged_into_id ON leads(merged_into_id);
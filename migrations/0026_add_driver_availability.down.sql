@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_driver_period_excl;
+DROP TABLE IF EXISTS driver_days_off;
+DROP TABLE IF EXISTS driver_working_hours;
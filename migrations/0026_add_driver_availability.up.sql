@@ -0,0 +1,30 @@
+-- Weekly working hours, one shift per weekday (0 = Sunday, as in Go's time.Weekday)
+CREATE TABLE IF NOT EXISTS driver_working_hours (
+    driver_id INT NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    PRIMARY KEY (driver_id, weekday),
+    CONSTRAINT driver_working_hours_weekday_check CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT driver_working_hours_time_check CHECK (end_time > start_time)
+);
+
+CREATE TABLE IF NOT EXISTS driver_days_off (
+    id SERIAL PRIMARY KEY,
+    driver_id INT NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    period tstzrange NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT driver_days_off_period_not_empty CHECK (NOT isempty(period)),
+    CONSTRAINT driver_days_off_driver_period_excl EXCLUDE USING gist (
+        driver_id WITH =,
+        period WITH &&
+    )
+);
+
+-- A driver cannot be assigned to two live bookings at the same time
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_driver_period_excl;
+ALTER TABLE bookings ADD CONSTRAINT bookings_driver_period_excl EXCLUDE USING gist (
+    driver_id WITH =,
+    period WITH &&
+) WHERE (status <> 'cancelled' AND driver_id IS NOT NULL);
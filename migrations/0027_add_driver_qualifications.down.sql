@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS driver_car_categories;
+DROP TABLE IF EXISTS driver_cars;
+DROP TABLE IF EXISTS driver_licenses;
+
+DROP INDEX IF EXISTS idx_drivers_languages;
+DROP INDEX IF EXISTS idx_drivers_driving_experience;
+
+ALTER TABLE drivers
+    DROP CONSTRAINT IF EXISTS drivers_driving_experience_check,
+    DROP COLUMN IF EXISTS languages,
+    DROP COLUMN IF EXISTS driving_experience;
@@ -0,0 +1,41 @@
+ALTER TABLE drivers
+    ADD COLUMN IF NOT EXISTS driving_experience SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS languages TEXT[] NOT NULL DEFAULT '{}';
+
+-- Carry over the number from the free-text experience, e.g. "15 лет за рулем"
+UPDATE drivers
+SET driving_experience = substring(experience_years FROM '\d+')::NUMERIC
+WHERE driving_experience = 0
+    AND substring(experience_years FROM '\d+')::NUMERIC <= 70;
+
+ALTER TABLE drivers DROP CONSTRAINT IF EXISTS drivers_driving_experience_check;
+ALTER TABLE drivers ADD CONSTRAINT drivers_driving_experience_check CHECK (driving_experience BETWEEN 0 AND 70);
+
+CREATE INDEX IF NOT EXISTS idx_drivers_driving_experience ON drivers(driving_experience);
+CREATE INDEX IF NOT EXISTS idx_drivers_languages ON drivers USING gin (languages);
+
+CREATE TABLE IF NOT EXISTS driver_licenses (
+    driver_id INT NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    category VARCHAR(3) NOT NULL,
+    expires_at DATE NOT NULL,
+    PRIMARY KEY (driver_id, category)
+);
+
+CREATE INDEX IF NOT EXISTS idx_driver_licenses_category ON driver_licenses(category, expires_at);
+
+-- Cars and car categories a driver is certified for
+CREATE TABLE IF NOT EXISTS driver_cars (
+    driver_id INT NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    car_id INT NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    PRIMARY KEY (driver_id, car_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_driver_cars_car_id ON driver_cars(car_id);
+
+CREATE TABLE IF NOT EXISTS driver_car_categories (
+    driver_id INT NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    category_id INT NOT NULL REFERENCES car_categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (driver_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_driver_car_categories_category_id ON driver_car_categories(category_id);
@@ -0,0 +1,10 @@
+ALTER TABLE drivers
+    DROP COLUMN IF EXISTS review_count,
+    DROP COLUMN IF EXISTS rating_average;
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS review_count,
+    DROP COLUMN IF EXISTS rating_average;
+
+DROP TABLE IF EXISTS reviews;
+DROP TYPE IF EXISTS review_status;
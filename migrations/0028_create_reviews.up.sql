@@ -0,0 +1,41 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'review_status') THEN
+        CREATE TYPE review_status AS ENUM ('pending', 'approved', 'rejected');
+    END IF;
+END$$;
+
+-- One review per completed booking. The car rating covers the rental as a
+-- whole; driver_rating is only set when the booking had a chauffeur.
+CREATE TABLE IF NOT EXISTS reviews (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    car_id INT NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    driver_id INT REFERENCES drivers(id) ON DELETE SET NULL,
+    author_name VARCHAR(100) NOT NULL,
+    rating SMALLINT NOT NULL,
+    driver_rating SMALLINT,
+    text VARCHAR(2000) NOT NULL DEFAULT '',
+    photos JSONB NOT NULL DEFAULT '[]',
+    status review_status NOT NULL DEFAULT 'pending',
+    rejection_reason VARCHAR(500) NOT NULL DEFAULT '',
+    moderated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT reviews_rating_check CHECK (rating BETWEEN 1 AND 5),
+    CONSTRAINT reviews_driver_rating_check CHECK (driver_rating BETWEEN 1 AND 5)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_car_id_status ON reviews(car_id, status);
+CREATE INDEX IF NOT EXISTS idx_reviews_driver_id_status ON reviews(driver_id, status);
+CREATE INDEX IF NOT EXISTS idx_reviews_status_created_at ON reviews(status, created_at DESC);
+
+-- Aggregates over approved reviews, refreshed on every moderation decision
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS rating_average NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS review_count INT NOT NULL DEFAULT 0;
+
+ALTER TABLE drivers
+    ADD COLUMN IF NOT EXISTS rating_average NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS review_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,15 @@
+-- Cars a celebrity rented, shown as "Rented by" on the car page and "Their
+-- choice" on the celebrity page. Each page has its own manual order.
+CREATE TABLE IF NOT EXISTS celebrity_cars (
+    celebrity_id INT NOT NULL REFERENCES celebrities(id) ON DELETE CASCADE,
+    car_id INT NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    quote VARCHAR(500) NOT NULL DEFAULT '',
+    rented_at DATE,
+    celebrity_position INT NOT NULL DEFAULT 0,
+    car_position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (celebrity_id, car_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_celebrity_cars_car_id ON celebrity_cars(car_id, car_position);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS celebrity_images;
+
+DROP INDEX IF EXISTS idx_celebrities_featured;
+
+ALTER TABLE celebrities
+    DROP COLUMN IF EXISTS display_order,
+    DROP COLUMN IF EXISTS is_featured,
+    DROP COLUMN IF EXISTS social_links,
+    DROP COLUMN IF EXISTS profession,
+    DROP COLUMN IF EXISTS bio;
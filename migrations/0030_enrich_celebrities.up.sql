@@ -0,0 +1,22 @@
+-- Marketing profile of a celebrity. display_order is the manual order of the
+-- featured carousel on the homepage.
+ALTER TABLE celebrities
+    ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS profession VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS social_links JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN IF NOT EXISTS is_featured BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS display_order INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_celebrities_featured ON celebrities(display_order) WHERE is_featured;
+
+-- Extra photos of a celebrity. The main photo stays in celebrities.image.
+CREATE TABLE IF NOT EXISTS celebrity_images (
+    id BIGSERIAL PRIMARY KEY,
+    celebrity_id INT NOT NULL REFERENCES celebrities(id) ON DELETE CASCADE,
+    image_path VARCHAR(512) NOT NULL,
+    variants JSONB NOT NULL DEFAULT '[]'::jsonb,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_celebrity_images_celebrity_id ON celebrity_images(celebrity_id, position);
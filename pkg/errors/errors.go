@@ -14,6 +14,7 @@ const (
 	ErrCodeForbidden    ErrorCode = "FORBIDDEN"              // HTTP 403
 	ErrCodeNotFound     ErrorCode = "NOT_FOUND"              // HTTP 404
 	ErrCodeConflict     ErrorCode = "CONFLICT"               // HTTP 409
+	ErrCodeTooMany      ErrorCode = "TOO_MANY_REQUESTS"      // HTTP 429
 	ErrCodeValidation   ErrorCode = "VALIDATION_ERROR"       // HTTP 400
 	ErrCodeInvalidInput ErrorCode = "INVALID_INPUT"          // HTTP 400
 	ErrCodeInternal     ErrorCode = "INTERNAL_ERROR"         // HTTP 500
@@ -66,6 +67,12 @@ func (e *AppError) WithDetails(key string, value interface{}) *AppError {
 	return e
 }
 
+// NewFieldErrors reports input rejected field by field. fields maps a request
+// field name to the reason and is returned in details.fields.
+func NewFieldErrors(fields map[string]string) *AppError {
+	return New(ErrCodeValidation, "Проверьте правильность заполнения полей").WithDetails("fields", fields)
+}
+
 func getStatusCode(code ErrorCode) int {
 	switch code {
 	case ErrCodeBadRequest, ErrCodeValidation, ErrCodeInvalidInput:
@@ -78,6 +85,8 @@ func getStatusCode(code ErrorCode) int {
 		return http.StatusNotFound
 	case ErrCodeConflict:
 		return http.StatusConflict
+	case ErrCodeTooMany:
+		return http.StatusTooManyRequests
 	case ErrCodeDatabase, ErrCodeInternal, ErrCodeExternal:
 		return http.StatusInternalServerError
 	default:
@@ -94,15 +103,38 @@ func AsAppError(err error) (*AppError, bool) {
 }
 
 var (
-	ErrUserNotFound          = New(ErrCodeNotFound, "Пользователь не найден")
-	ErrUserAlreadyExists     = New(ErrCodeConflict, "Пользователь уже существует")
-	ErrInvalidCredentials    = New(ErrCodeUnauthorized, "Неверные учетные данные")
-	ErrInvalidEmail          = New(ErrCodeValidation, "Неверный формат email")
-	ErrPasswordTooShort      = New(ErrCodeValidation, "Пароль слишком короткий")
-	ErrUnauthorized          = New(ErrCodeUnauthorized, "Требуется авторизация")
-	ErrForbidden             = New(ErrCodeForbidden, "Доступ запрещен")
-	ErrVerifyCodeNotFound    = New(ErrCodeNotFound, "Код верификации не найден")
-	ErrVerifyCodeAlreadyUsed = New(ErrCodeConflict, "Код верификации уже использован")
-	ErrVerifyCodeExpired     = New(ErrCodeValidation, "Код верификации истёк")
-	ErrUserNotVerified       = New(ErrCodeUnauthorized, "Пользователь не верифицирован")
+	ErrUserNotFound           = New(ErrCodeNotFound, "Пользователь не найден")
+	ErrUserAlreadyExists      = New(ErrCodeConflict, "Пользователь уже существует")
+	ErrInvalidCredentials     = New(ErrCodeUnauthorized, "Неверные учетные данные")
+	ErrInvalidEmail           = New(ErrCodeValidation, "Неверный формат email")
+	ErrPasswordTooShort       = New(ErrCodeValidation, "Пароль слишком короткий")
+	ErrUnauthorized           = New(ErrCodeUnauthorized, "Требуется авторизация")
+	ErrForbidden              = New(ErrCodeForbidden, "Доступ запрещен")
+	ErrVerifyCodeNotFound     = New(ErrCodeNotFound, "Код верификации не найден")
+	ErrVerifyCodeAlreadyUsed  = New(ErrCodeConflict, "Код верификации уже использован")
+	ErrVerifyCodeExpired      = New(ErrCodeValidation, "Код верификации истёк")
+	ErrUserNotVerified        = New(ErrCodeUnauthorized, "Пользователь не верифицирован")
+	ErrSessionNotFound        = New(ErrCodeUnauthorized, "Сессия не найдена")
+	ErrSessionRevoked         = New(ErrCodeUnauthorized, "Сессия завершена")
+	ErrRefreshTokenReused     = New(ErrCodeUnauthorized, "Refresh токен уже использован, все сессии этого входа завершены")
+	ErrCarNotFound            = New(ErrCodeNotFound, "Автомобиль не найден")
+	ErrDriverNotFound         = New(ErrCodeNotFound, "Водитель не найден")
+	ErrCelebrityNotFound      = New(ErrCodeNotFound, "Знаменитость не найдена")
+	ErrBookingNotFound        = New(ErrCodeNotFound, "Бронирование не найдено")
+	ErrLeadNotFound           = New(ErrCodeNotFound, "Заявка не найдена")
+	ErrCarAlreadyBooked       = New(ErrCodeConflict, "Автомобиль уже забронирован на выбранные даты")
+	ErrCarUnavailable         = New(ErrCodeConflict, "Автомобиль недоступен на выбранные даты")
+	ErrCarHasBookings         = New(ErrCodeConflict, "Нельзя удалить автомобиль, у которого есть бронирования")
+	ErrMaintenanceNotFound    = New(ErrCodeNotFound, "Период обслуживания не найден")
+	ErrRatePlanNotFound       = New(ErrCodeNotFound, "Тариф не найден")
+	ErrDayOffNotFound         = New(ErrCodeNotFound, "Выходной водителя не найден")
+	ErrReviewNotFound         = New(ErrCodeNotFound, "Отзыв не найден")
+	ErrReviewAlreadyExists    = New(ErrCodeConflict, "Отзыв на это бронирование уже оставлен")
+	ErrCelebrityCarNotFound   = New(ErrCodeNotFound, "Автомобиль знаменитости не найден")
+	ErrCelebrityCarExists     = New(ErrCodeConflict, "Автомобиль уже добавлен знаменитости")
+	ErrCelebrityImageNotFound = New(ErrCodeNotFound, "Фото знаменитости не найдено")
+	ErrDriverAlreadyBooked    = New(ErrCodeConflict, "Водитель уже назначен на пересекающийся период")
+	ErrDriverUnavailable      = New(ErrCodeConflict, "Водитель не работает в выбранные даты")
+	ErrTooManyRequests        = New(ErrCodeTooMany, "Слишком много запросов, попробуйте позже")
+	ErrCaptchaFailed          = New(ErrCodeForbidden, "Проверка капчи не пройдена")
 )
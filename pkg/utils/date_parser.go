@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseDateOrTime accepts either a plain date (2006-01-02, read as UTC
+// midnight) or a full RFC 3339 timestamp.
+func ParseDateOrTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or RFC 3339", value)
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// IsSupportedPhoneRegion reports whether region can be passed to NormalizePhone
+func IsSupportedPhoneRegion(region string) bool {
+	return phonenumbers.GetCountryCodeForRegion(strings.ToUpper(region)) != 0
+}
+
+// NormalizePhone returns the E.164 form (+77011234567) of a phone number as
+// typed by a person. Spaces, dashes, dots, slashes and brackets are ignored.
+// Numbers starting with + or 00 are international; anything else is read as a
+// national number of defaultRegion, with or without the trunk prefix or the
+// country code. Parsing and validation follow libphonenumber.
+func NormalizePhone(raw, defaultRegion string) (string, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return "", errors.New("phone cannot be empty")
+	}
+
+	international := false
+	if rest, ok := strings.CutPrefix(s, "+"); ok {
+		s, international = rest, true
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune(" -(). /", r):
+		default:
+			return "", errors.New("phone contains invalid characters")
+		}
+	}
+	digits := b.String()
+
+	// 00 is the international prefix in most of the world, but libphonenumber
+	// only knows the one of the default region
+	if rest, ok := strings.CutPrefix(digits, "00"); ok && !international {
+		digits, international = rest, true
+	}
+	if digits == "" {
+		return "", errors.New("phone cannot be empty")
+	}
+
+	region := strings.ToUpper(defaultRegion)
+	if international {
+		digits = "+" + digits
+	} else if !IsSupportedPhoneRegion(region) {
+		return "", fmt.Errorf("unsupported phone region %q", defaultRegion)
+	}
+
+	number, err := phonenumbers.Parse(digits, region)
+	if err != nil {
+		return "", errors.New("phone number is invalid")
+	}
+	if !phonenumbers.IsValidNumber(number) {
+		return "", errors.New("phone number is invalid")
+	}
+	return phonenumbers.Format(number, phonenumbers.E164), nil
+}
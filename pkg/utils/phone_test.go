@@ -0,0 +1,79 @@
+package utils
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		region string
+		want   string
+	}{
+		{name: "KZ with trunk prefix", raw: "8 (701) 123-45-67", region: "KZ", want: "+77011234567"},
+		{name: "KZ with country code", raw: "7 701 123 45 67", region: "KZ", want: "+77011234567"},
+		{name: "KZ national", raw: "7011234567", region: "kz", want: "+77011234567"},
+		{name: "RU with trunk prefix", raw: "8 912 345-67-89", region: "RU", want: "+79123456789"},
+		{name: "KG with trunk prefix", raw: "0555 123 456", region: "KG", want: "+996555123456"},
+		{name: "UZ national", raw: "90 123 45 67", region: "UZ", want: "+998901234567"},
+		{name: "AE mobile", raw: "050 123 4567", region: "AE", want: "+971501234567"},
+		{name: "AE landline", raw: "04 234 5678", region: "AE", want: "+97142345678"},
+		{name: "TR with trunk prefix", raw: "0532 123 45 67", region: "TR", want: "+905321234567"},
+		{name: "GB with trunk prefix", raw: "07400 123456", region: "GB", want: "+447400123456"},
+		{name: "US national", raw: "(201) 555-0123", region: "US", want: "+12015550123"},
+		{name: "US with trunk prefix", raw: "1 201 555 0123", region: "US", want: "+12015550123"},
+		{name: "international with plus", raw: "+49 30 123456", region: "KZ", want: "+4930123456"},
+		{name: "international with 00", raw: "00 49 30 123456", region: "KZ", want: "+4930123456"},
+		{name: "international region outside the old list", raw: "+33 6 12 34 56 78", region: "KZ", want: "+33612345678"},
+		{name: "national number of a region outside the old list", raw: "06 12 34 56 78", region: "FR", want: "+33612345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhone(tt.raw, tt.region)
+			if err != nil {
+				t.Fatalf("NormalizePhone(%q, %q): %v", tt.raw, tt.region, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhone(%q, %q) = %q, want %q", tt.raw, tt.region, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePhoneRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		region string
+	}{
+		{name: "empty", raw: "   ", region: "KZ"},
+		{name: "only formatting", raw: "+ ( ) -", region: "KZ"},
+		{name: "letters", raw: "8 701 CALL NOW", region: "KZ"},
+		{name: "too short", raw: "8 701 123", region: "KZ"},
+		{name: "too long", raw: "8 701 123 45 67 89", region: "KZ"},
+		{name: "unknown country code", raw: "+999 123 456 789", region: "KZ"},
+		{name: "invalid length for the country code", raw: "+7 701 123 45", region: "KZ"},
+		{name: "unsupported default region", raw: "7011234567", region: "XX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := NormalizePhone(tt.raw, tt.region); err == nil {
+				t.Errorf("NormalizePhone(%q, %q) = %q, want an error", tt.raw, tt.region, got)
+			}
+		})
+	}
+}
+
+func TestIsSupportedPhoneRegion(t *testing.T) {
+	for _, region := range []string{"KZ", "RU", "KG", "UZ", "AE", "TR", "GB", "US", "fr"} {
+		if !IsSupportedPhoneRegion(region) {
+			t.Errorf("IsSupportedPhoneRegion(%q) = false, want true", region)
+		}
+	}
+	for _, region := range []string{"", "XX", "KAZ"} {
+		if IsSupportedPhoneRegion(region) {
+			t.Errorf("IsSupportedPhoneRegion(%q) = true, want false", region)
+		}
+	}
+}